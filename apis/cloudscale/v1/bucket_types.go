@@ -28,11 +28,10 @@ type BucketDeletionPolicy string
 
 // BucketParameters are the configurable fields of a Bucket.
 type BucketParameters struct {
-	// +kubebuilder:validation:Required
-
 	// CredentialsSecretRef contains the reference of the Secret where the credentials of the S3 user are stored.
 	// The secret must contain the keys `AWS_ACCESS_KEY_ID` and `AWS_SECRET_ACCESS_KEY`.
-	CredentialsSecretRef corev1.SecretReference `json:"credentialsSecretRef"`
+	// If unset, the bucket is managed with the credentials of an objects user that the provider creates for the referenced ProviderConfig.
+	CredentialsSecretRef corev1.SecretReference `json:"credentialsSecretRef,omitempty"`
 
 	// Deprecated: Only here for compatibility with legacy Bucket objects
 	EndpointURL string `json:"endpointURL,omitempty"`
@@ -71,6 +70,21 @@ type BucketSpec struct {
 type BucketObservation struct {
 	// BucketName is the name of the actual bucket.
 	BucketName string `json:"bucketName,omitempty"`
+	// Usage contains the storage usage of the bucket as reported by the cloudscale.ch metrics API.
+	// It is only available if the referenced ProviderConfig has valid credentials.
+	Usage *BucketUsage `json:"usage,omitempty"`
+	// Project is the label of the cloudscale.ch project as given in the referenced ProviderConfig.
+	Project string `json:"project,omitempty"`
+}
+
+// BucketUsage contains the storage usage of a bucket.
+type BucketUsage struct {
+	// StorageBytes is the amount of bytes stored in the bucket.
+	StorageBytes int64 `json:"storageBytes"`
+	// ObjectCount is the number of objects stored in the bucket.
+	ObjectCount int64 `json:"objectCount"`
+	// LastSampled is the time when the usage has last been fetched from the metrics API.
+	LastSampled metav1.Time `json:"lastSampled,omitempty"`
 }
 
 // BucketStatus represents the observed state of a Bucket.
@@ -89,9 +103,10 @@ type BucketStatus struct {
 // +kubebuilder:printcolumn:name="Endpoint",type="string",JSONPath=".spec.forProvider.endpointURL"
 // +kubebuilder:printcolumn:name="Bucket Name",type="string",JSONPath=".status.atProvider.bucketName"
 // +kubebuilder:printcolumn:name="Region",type="string",JSONPath=".spec.forProvider.region"
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".status.atProvider.project"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,cloudscale}
-// +kubebuilder:webhook:verbs=update,path=/validate-cloudscale-crossplane-io-v1-bucket,mutating=false,failurePolicy=fail,groups=cloudscale.crossplane.io,resources=buckets,versions=v1,name=buckets.cloudscale.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+// +kubebuilder:webhook:verbs=create;update,path=/validate-cloudscale-crossplane-io-v1-bucket,mutating=false,failurePolicy=fail,groups=cloudscale.crossplane.io,resources=buckets,versions=v1,name=buckets.cloudscale.crossplane.io,sideEffects=None,admissionReviewVersions=v1
 
 // Bucket is the API for creating S3 buckets.
 type Bucket struct {
@@ -0,0 +1,169 @@
+package v1
+
+import (
+	"reflect"
+	"slices"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// CatalogParameters are the configurable fields of a Catalog.
+// A Catalog only observes cloudscale.ch, there is nothing to configure.
+type CatalogParameters struct{}
+
+// CatalogSpec defines the desired state of a Catalog.
+type CatalogSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CatalogParameters `json:"forProvider,omitempty"`
+}
+
+// CatalogStatus represents the observed state of a Catalog.
+type CatalogStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+
+	AtProvider CatalogObservation `json:"atProvider,omitempty"`
+}
+
+// CatalogObservation contains the regions, zones, flavors and public images offered by cloudscale.ch.
+type CatalogObservation struct {
+	// Regions are the regions with their zones.
+	Regions []CatalogRegion `json:"regions,omitempty"`
+	// Zones are the slugs of the zones of all regions, e.g. `rma1`.
+	Zones []string `json:"zones,omitempty"`
+	// Flavors are the flavors of servers.
+	Flavors []CatalogFlavor `json:"flavors,omitempty"`
+	// Images are the public images servers are installed from.
+	Images []CatalogImage `json:"images,omitempty"`
+	// Project is the label of the cloudscale.ch project as given in the referenced ProviderConfig.
+	Project string `json:"project,omitempty"`
+}
+
+// CatalogRegion is a region offered by cloudscale.ch.
+type CatalogRegion struct {
+	// Slug identifies the region, e.g. `rma`.
+	Slug string `json:"slug"`
+	// Zones are the slugs of the zones in the region, e.g. `rma1`.
+	Zones []string `json:"zones,omitempty"`
+}
+
+// CatalogFlavor is a flavor of servers offered by cloudscale.ch.
+type CatalogFlavor struct {
+	// Slug identifies the flavor, e.g. `flex-4-2`.
+	Slug string `json:"slug"`
+	// Name is the human-readable name of the flavor.
+	Name string `json:"name,omitempty"`
+	// VCPUCount is the number of virtual CPUs.
+	VCPUCount int `json:"vcpuCount,omitempty"`
+	// MemoryGB is the memory in GB.
+	MemoryGB int `json:"memoryGB,omitempty"`
+	// Zones are the slugs of the zones in which the flavor is available.
+	Zones []string `json:"zones,omitempty"`
+}
+
+// CatalogImage is a public image offered by cloudscale.ch.
+type CatalogImage struct {
+	// Slug identifies the image, e.g. `debian-12`.
+	Slug string `json:"slug"`
+	// Name is the human-readable name of the image.
+	Name string `json:"name,omitempty"`
+	// OperatingSystem is the name of the operating system, e.g. `Debian`.
+	OperatingSystem string `json:"operatingSystem,omitempty"`
+	// DefaultUsername is the user the SSH keys are installed for, e.g. `debian`.
+	DefaultUsername string `json:"defaultUsername,omitempty"`
+	// Zones are the slugs of the zones in which the image is available.
+	Zones []string `json:"zones,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="Synced",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".status.atProvider.project"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,cloudscale}
+
+// Catalog is the API for discovering the regions, zones, flavors and public images offered by cloudscale.ch.
+// It only observes cloudscale.ch and never creates, changes or deletes anything.
+type Catalog struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CatalogSpec   `json:"spec"`
+	Status CatalogStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CatalogList contains a list of Catalog
+type CatalogList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Catalog `json:"items"`
+}
+
+// RegionSlugs returns the sorted slugs of the regions observed by all Catalogs in the list.
+// It returns an empty slice if no Catalog has been observed yet.
+func (in *CatalogList) RegionSlugs() []string {
+	return in.slugs(func(obs CatalogObservation) []string {
+		slugs := make([]string, 0, len(obs.Regions))
+		for _, region := range obs.Regions {
+			slugs = append(slugs, region.Slug)
+		}
+		return slugs
+	})
+}
+
+// ZoneSlugs returns the sorted slugs of the zones observed by all Catalogs in the list.
+// It returns an empty slice if no Catalog has been observed yet.
+func (in *CatalogList) ZoneSlugs() []string {
+	return in.slugs(func(obs CatalogObservation) []string {
+		return obs.Zones
+	})
+}
+
+// FlavorSlugs returns the sorted slugs of the flavors observed by all Catalogs in the list.
+// It returns an empty slice if no Catalog has been observed yet.
+func (in *CatalogList) FlavorSlugs() []string {
+	return in.slugs(func(obs CatalogObservation) []string {
+		slugs := make([]string, 0, len(obs.Flavors))
+		for _, flavor := range obs.Flavors {
+			slugs = append(slugs, flavor.Slug)
+		}
+		return slugs
+	})
+}
+
+// ImageSlugs returns the sorted slugs of the public images observed by all Catalogs in the list.
+// It returns an empty slice if no Catalog has been observed yet.
+func (in *CatalogList) ImageSlugs() []string {
+	return in.slugs(func(obs CatalogObservation) []string {
+		slugs := make([]string, 0, len(obs.Images))
+		for _, image := range obs.Images {
+			slugs = append(slugs, image.Slug)
+		}
+		return slugs
+	})
+}
+
+func (in *CatalogList) slugs(fn func(CatalogObservation) []string) []string {
+	slugs := []string{}
+	for _, catalog := range in.Items {
+		slugs = append(slugs, fn(catalog.Status.AtProvider)...)
+	}
+	slices.Sort(slugs)
+	return slices.Compact(slugs)
+}
+
+// Catalog type metadata.
+var (
+	CatalogKind             = reflect.TypeOf(Catalog{}).Name()
+	CatalogGroupKind        = schema.GroupKind{Group: Group, Kind: CatalogKind}.String()
+	CatalogKindAPIVersion   = CatalogKind + "." + SchemeGroupVersion.String()
+	CatalogGroupVersionKind = SchemeGroupVersion.WithKind(CatalogKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Catalog{}, &CatalogList{})
+}
@@ -0,0 +1,172 @@
+package v1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// CustomImageParameters are the configurable fields of a CustomImage.
+type CustomImageParameters struct {
+	// CustomImageName is the name of the image as presented in the cloudscale.ch UI.
+	// If empty, the value of `.metadata.annotations."crossplane.io/external-name"` is used.
+	CustomImageName string `json:"customImageName,omitempty"`
+
+	// +kubebuilder:validation:Required
+
+	// URL is the HTTP(S) URL from which the image is downloaded.
+	// Cannot be changed after the import has succeeded, changing it retries a failed import.
+	URL string `json:"url"`
+
+	// +kubebuilder:validation:Required
+
+	// Slug identifies the image, servers are created from it with the image slug `custom:<slug>`.
+	Slug string `json:"slug"`
+
+	// +kubebuilder:validation:Enum=pass-through;extend-cloud-config
+	// +kubebuilder:default=pass-through
+
+	// UserDataHandling defines how the user data of a server is given to the image.
+	// `pass-through` passes the user data unchanged, `extend-cloud-config` adds the cloud-config that sets up the SSH keys.
+	UserDataHandling string `json:"userDataHandling,omitempty"`
+
+	// +kubebuilder:validation:Enum=bios;uefi
+
+	// FirmwareType is the firmware the servers boot the image with.
+	// If empty, cloudscale.ch uses `bios`.
+	// Cannot be changed after the import has succeeded, changing it retries a failed import.
+	FirmwareType string `json:"firmwareType,omitempty"`
+
+	// +kubebuilder:validation:Enum=raw
+
+	// SourceFormat is the format of the image at the URL.
+	// If empty, cloudscale.ch uses `raw`.
+	// Cannot be changed after the import has succeeded, changing it retries a failed import.
+	SourceFormat string `json:"sourceFormat,omitempty"`
+
+	// Zones are the slugs of the zones in which the image is available, e.g. `rma1`.
+	// If empty, the image is available in all zones.
+	// Cannot be changed after the import has succeeded, changing it retries a failed import.
+	Zones []string `json:"zones,omitempty"`
+
+	// SHA256Checksum is the expected SHA-256 checksum of the imported image.
+	// If set, the CustomImage only becomes ready if the checksum calculated by cloudscale.ch matches.
+	SHA256Checksum string `json:"sha256Checksum,omitempty"`
+
+	// Tags contain additional key-value information of a CustomImage.
+	// The tag `cloudscale.crossplane.io/owner-uid` is reserved, it is managed by the provider to identify the import and the image.
+	Tags Tags `json:"tags,omitempty"`
+}
+
+// CustomImageSpec defines the desired state of a CustomImage.
+type CustomImageSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CustomImageParameters `json:"forProvider"`
+}
+
+// CustomImageStatus represents the observed state of a CustomImage.
+type CustomImageStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+
+	AtProvider CustomImageObservation `json:"atProvider,omitempty"`
+}
+
+// CustomImageObservation contains the observed fields of a CustomImage.
+type CustomImageObservation struct {
+	// CustomImageUUID is the unique ID of the image as generated by cloudscale.ch.
+	// It is empty until the import has succeeded.
+	CustomImageUUID string `json:"customImageUUID,omitempty"`
+	// ImportUUID is the unique ID of the import as generated by cloudscale.ch.
+	ImportUUID string `json:"importUUID,omitempty"`
+	// ImportStatus is the observed status of the import, e.g. `in_progress`, `success` or `failed`.
+	ImportStatus string `json:"importStatus,omitempty"`
+	// CustomImageName is the observed name of the image.
+	CustomImageName string `json:"customImageName,omitempty"`
+	// Slug is the observed slug of the image.
+	Slug string `json:"slug,omitempty"`
+	// ImageSlug is the slug with which servers are created from the image, e.g. `custom:my-image`.
+	// It is empty until the import has succeeded.
+	ImageSlug string `json:"imageSlug,omitempty"`
+	// SizeGB is the size of the image in GB.
+	SizeGB int `json:"sizeGB,omitempty"`
+	// Checksums are the checksums of the image by algorithm, e.g. `sha256`.
+	Checksums map[string]string `json:"checksums,omitempty"`
+	// UserDataHandling is the observed user data handling of the image.
+	UserDataHandling string `json:"userDataHandling,omitempty"`
+	// FirmwareType is the observed firmware type of the image.
+	FirmwareType string `json:"firmwareType,omitempty"`
+	// Zones are the slugs of the zones in which the image is available.
+	Zones []string `json:"zones,omitempty"`
+	// Tags contains the key-value map as observed in cloudscale.ch.
+	Tags Tags `json:"tags,omitempty"`
+	// Project is the label of the cloudscale.ch project as given in the referenced ProviderConfig.
+	Project string `json:"project,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="Synced",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="External Name",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="Import",type="string",JSONPath=".status.atProvider.importStatus"
+// +kubebuilder:printcolumn:name="Image Slug",type="string",JSONPath=".status.atProvider.imageSlug"
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".status.atProvider.project"
+// +kubebuilder:printcolumn:name="Image UUID",type="string",JSONPath=".status.atProvider.customImageUUID",priority=1
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,cloudscale}
+
+// CustomImage is the API for importing custom images into cloudscale.ch.
+type CustomImage struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CustomImageSpec   `json:"spec"`
+	Status CustomImageStatus `json:"status,omitempty"`
+}
+
+// GetCustomImageName returns the CustomImage name in the following precedence:
+//
+//	.spec.forProvider.customImageName
+//	.metadata.annotations."crossplane.io/external-name"
+//	.metadata.name
+func (in *CustomImage) GetCustomImageName() string {
+	if in.Spec.ForProvider.CustomImageName != "" {
+		return in.Spec.ForProvider.CustomImageName
+	}
+	if name := meta.GetExternalName(in); name != "" {
+		return name
+	}
+	return in.Name
+}
+
+// GetUserDataHandling returns the user data handling of the CustomImage, which defaults to `pass-through`.
+func (in *CustomImage) GetUserDataHandling() string {
+	if in.Spec.ForProvider.UserDataHandling != "" {
+		return in.Spec.ForProvider.UserDataHandling
+	}
+	return "pass-through"
+}
+
+// +kubebuilder:object:root=true
+
+// CustomImageList contains a list of CustomImage
+type CustomImageList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CustomImage `json:"items"`
+}
+
+// CustomImage type metadata.
+var (
+	CustomImageKind             = reflect.TypeOf(CustomImage{}).Name()
+	CustomImageGroupKind        = schema.GroupKind{Group: Group, Kind: CustomImageKind}.String()
+	CustomImageKindAPIVersion   = CustomImageKind + "." + SchemeGroupVersion.String()
+	CustomImageGroupVersionKind = SchemeGroupVersion.WithKind(CustomImageKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&CustomImage{}, &CustomImageList{})
+}
@@ -0,0 +1,164 @@
+package v1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// FloatingIPAddressName is the connection detail key of the floating IP address.
+	FloatingIPAddressName = "FLOATING_IP"
+	// FloatingIPNetworkName is the connection detail key of the floating IP network in CIDR notation.
+	FloatingIPNetworkName = "FLOATING_IP_NETWORK"
+)
+
+// FloatingIPType is the scope of a FloatingIP.
+type FloatingIPType string
+
+const (
+	// FloatingIPRegional is a floating IP that can be assigned within its region.
+	FloatingIPRegional FloatingIPType = "regional"
+	// FloatingIPGlobal is a floating IP that can be assigned in all regions.
+	FloatingIPGlobal FloatingIPType = "global"
+)
+
+// FloatingIPParameters are the configurable fields of a FloatingIP.
+type FloatingIPParameters struct {
+	// +kubebuilder:validation:Enum=4;6
+	// +kubebuilder:default=4
+
+	// IPVersion is either `4` for an IPv4 address or `6` for an IPv6 network.
+	// Cannot be changed after the floating IP is created.
+	IPVersion int `json:"ipVersion,omitempty"`
+
+	// PrefixLength is the prefix length of an IPv6 network, e.g. `56`.
+	// If empty, a single address is allocated.
+	// Cannot be changed after the floating IP is created.
+	PrefixLength int `json:"prefixLength,omitempty"`
+
+	// +kubebuilder:validation:Enum=regional;global
+	// +kubebuilder:default="regional"
+
+	// Type is either `regional` or `global`.
+	// Cannot be changed after the floating IP is created.
+	Type FloatingIPType `json:"type,omitempty"`
+
+	// Region is the slug of the region of a regional floating IP, e.g. `lpg`.
+	// If empty, the region of the assigned server or load balancer is used.
+	// Cannot be changed after the floating IP is created.
+	Region string `json:"region,omitempty"`
+
+	// ReversePointer is the PTR record of the floating IP.
+	ReversePointer string `json:"reversePointer,omitempty"`
+
+	// ServerUUID is the UUID of the server the floating IP is assigned to.
+	// Changing the server reassigns the floating IP.
+	ServerUUID string `json:"serverUUID,omitempty"`
+
+	// ServerRef references the Server the floating IP is assigned to.
+	// The UUID of the referenced Server is resolved into ServerUUID.
+	// Changing the reference reassigns the floating IP.
+	ServerRef *xpv1.Reference `json:"serverRef,omitempty"`
+
+	// ServerSelector selects the Server the floating IP is assigned to.
+	ServerSelector *xpv1.Selector `json:"serverSelector,omitempty"`
+
+	// LoadBalancerUUID is the UUID of the load balancer the floating IP is assigned to.
+	// Changing the load balancer reassigns the floating IP.
+	// Either a server or a load balancer can be given.
+	LoadBalancerUUID string `json:"loadBalancerUUID,omitempty"`
+
+	// LoadBalancerRef references the LoadBalancer the floating IP is assigned to.
+	// The UUID of the referenced LoadBalancer is resolved into LoadBalancerUUID.
+	// Changing the reference reassigns the floating IP.
+	LoadBalancerRef *xpv1.Reference `json:"loadBalancerRef,omitempty"`
+
+	// LoadBalancerSelector selects the LoadBalancer the floating IP is assigned to.
+	LoadBalancerSelector *xpv1.Selector `json:"loadBalancerSelector,omitempty"`
+
+	// Tags contain additional key-value information of a FloatingIP.
+	// The tag `cloudscale.crossplane.io/owner-uid` is reserved, it is managed by the provider to identify the floating IP.
+	Tags Tags `json:"tags,omitempty"`
+}
+
+// FloatingIPSpec defines the desired state of a FloatingIP.
+type FloatingIPSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       FloatingIPParameters `json:"forProvider"`
+}
+
+// FloatingIPStatus represents the observed state of a FloatingIP.
+type FloatingIPStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+
+	AtProvider FloatingIPObservation `json:"atProvider,omitempty"`
+}
+
+// FloatingIPObservation contains the observed fields of a FloatingIP.
+type FloatingIPObservation struct {
+	// Network is the floating IP in CIDR notation, e.g. `192.0.2.1/32`.
+	// It identifies the floating IP in cloudscale.ch.
+	Network string `json:"network,omitempty"`
+	// IPVersion is the observed IP version of the floating IP.
+	IPVersion int `json:"ipVersion,omitempty"`
+	// Type is the observed scope of the floating IP.
+	Type FloatingIPType `json:"type,omitempty"`
+	// Region is the observed region slug of a regional floating IP.
+	Region string `json:"region,omitempty"`
+	// NextHop is the address the floating IP is routed to.
+	NextHop string `json:"nextHop,omitempty"`
+	// ReversePointer is the observed PTR record of the floating IP.
+	ReversePointer string `json:"reversePointer,omitempty"`
+	// ServerUUID is the UUID of the server the floating IP is assigned to.
+	ServerUUID string `json:"serverUUID,omitempty"`
+	// LoadBalancerUUID is the UUID of the load balancer the floating IP is assigned to.
+	LoadBalancerUUID string `json:"loadBalancerUUID,omitempty"`
+	// Tags contains the key-value map as observed in cloudscale.ch.
+	Tags Tags `json:"tags,omitempty"`
+	// Project is the label of the cloudscale.ch project as given in the referenced ProviderConfig.
+	Project string `json:"project,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="Synced",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="Network",type="string",JSONPath=".status.atProvider.network"
+// +kubebuilder:printcolumn:name="Type",type="string",JSONPath=".status.atProvider.type"
+// +kubebuilder:printcolumn:name="Next Hop",type="string",JSONPath=".status.atProvider.nextHop"
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".status.atProvider.project"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,cloudscale}
+
+// FloatingIP is the API for allocating floating IPs on cloudscale.ch.
+type FloatingIP struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FloatingIPSpec   `json:"spec"`
+	Status FloatingIPStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FloatingIPList contains a list of FloatingIP
+type FloatingIPList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FloatingIP `json:"items"`
+}
+
+// FloatingIP type metadata.
+var (
+	FloatingIPKind             = reflect.TypeOf(FloatingIP{}).Name()
+	FloatingIPGroupKind        = schema.GroupKind{Group: Group, Kind: FloatingIPKind}.String()
+	FloatingIPKindAPIVersion   = FloatingIPKind + "." + SchemeGroupVersion.String()
+	FloatingIPGroupVersionKind = SchemeGroupVersion.WithKind(FloatingIPKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&FloatingIP{}, &FloatingIPList{})
+}
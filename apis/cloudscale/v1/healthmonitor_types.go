@@ -0,0 +1,144 @@
+package v1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// HealthMonitorParameters are the configurable fields of a HealthMonitor.
+type HealthMonitorParameters struct {
+	// PoolUUID is the UUID of the pool whose members are checked.
+	// Cannot be changed after the health monitor is created.
+	PoolUUID string `json:"poolUUID,omitempty"`
+
+	// PoolRef references the Pool whose members are checked.
+	// The UUID of the referenced Pool is resolved into PoolUUID.
+	PoolRef *xpv1.Reference `json:"poolRef,omitempty"`
+
+	// PoolSelector selects the Pool whose members are checked.
+	PoolSelector *xpv1.Selector `json:"poolSelector,omitempty"`
+
+	// +kubebuilder:validation:Enum=ping;tcp;http;https;tls-hello
+	// +kubebuilder:default="tcp"
+
+	// Type is the kind of check.
+	// Cannot be changed after the health monitor is created.
+	Type string `json:"type,omitempty"`
+
+	// DelaySeconds is the interval between two checks of a member.
+	// If empty, the value chosen by cloudscale.ch is kept.
+	DelaySeconds int `json:"delaySeconds,omitempty"`
+
+	// TimeoutSeconds is the time after which a check fails.
+	// If empty, the value chosen by cloudscale.ch is kept.
+	TimeoutSeconds int `json:"timeoutSeconds,omitempty"`
+
+	// UpThreshold is the number of successful checks after which a member is up.
+	// If empty, the value chosen by cloudscale.ch is kept.
+	UpThreshold int `json:"upThreshold,omitempty"`
+
+	// DownThreshold is the number of failed checks after which a member is down.
+	// If empty, the value chosen by cloudscale.ch is kept.
+	DownThreshold int `json:"downThreshold,omitempty"`
+
+	// HTTP configures the requests of `http` and `https` checks.
+	HTTP *HealthMonitorHTTP `json:"http,omitempty"`
+
+	// Tags contain additional key-value information of a HealthMonitor.
+	// The tag `cloudscale.crossplane.io/owner-uid` is reserved, it is managed by the provider to identify the health monitor.
+	Tags Tags `json:"tags,omitempty"`
+}
+
+// HealthMonitorHTTP configures the requests of HTTP checks.
+type HealthMonitorHTTP struct {
+	// ExpectedCodes are the HTTP status codes of a successful check, e.g. `200`.
+	ExpectedCodes []string `json:"expectedCodes,omitempty"`
+	// Method is the HTTP method of the check, e.g. `GET`.
+	Method string `json:"method,omitempty"`
+	// URLPath is the path of the check, e.g. `/healthz`.
+	URLPath string `json:"urlPath,omitempty"`
+	// Version is the HTTP version of the check, e.g. `1.1`.
+	Version string `json:"version,omitempty"`
+	// Host is the value of the Host header of the check.
+	Host string `json:"host,omitempty"`
+}
+
+// HealthMonitorSpec defines the desired state of a HealthMonitor.
+type HealthMonitorSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       HealthMonitorParameters `json:"forProvider"`
+}
+
+// HealthMonitorStatus represents the observed state of a HealthMonitor.
+type HealthMonitorStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+
+	AtProvider HealthMonitorObservation `json:"atProvider,omitempty"`
+}
+
+// HealthMonitorObservation contains the observed fields of a HealthMonitor.
+type HealthMonitorObservation struct {
+	// HealthMonitorUUID is the unique ID as generated by cloudscale.ch.
+	HealthMonitorUUID string `json:"healthMonitorUUID,omitempty"`
+	// PoolUUID is the UUID of the pool whose members are checked.
+	PoolUUID string `json:"poolUUID,omitempty"`
+	// Type is the observed kind of check.
+	Type string `json:"type,omitempty"`
+	// DelaySeconds is the observed interval between two checks.
+	DelaySeconds int `json:"delaySeconds,omitempty"`
+	// TimeoutSeconds is the observed timeout of a check.
+	TimeoutSeconds int `json:"timeoutSeconds,omitempty"`
+	// UpThreshold is the observed number of successful checks after which a member is up.
+	UpThreshold int `json:"upThreshold,omitempty"`
+	// DownThreshold is the observed number of failed checks after which a member is down.
+	DownThreshold int `json:"downThreshold,omitempty"`
+	// HTTP is the observed configuration of HTTP checks.
+	HTTP *HealthMonitorHTTP `json:"http,omitempty"`
+	// Tags contains the key-value map as observed in cloudscale.ch.
+	Tags Tags `json:"tags,omitempty"`
+	// Project is the label of the cloudscale.ch project as given in the referenced ProviderConfig.
+	Project string `json:"project,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="Synced",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="Type",type="string",JSONPath=".status.atProvider.type"
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".status.atProvider.project"
+// +kubebuilder:printcolumn:name="Health Monitor UUID",type="string",JSONPath=".status.atProvider.healthMonitorUUID",priority=1
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,cloudscale}
+
+// HealthMonitor is the API for checking the members of load balancer pools on cloudscale.ch.
+type HealthMonitor struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   HealthMonitorSpec   `json:"spec"`
+	Status HealthMonitorStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// HealthMonitorList contains a list of HealthMonitor
+type HealthMonitorList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []HealthMonitor `json:"items"`
+}
+
+// HealthMonitor type metadata.
+var (
+	HealthMonitorKind             = reflect.TypeOf(HealthMonitor{}).Name()
+	HealthMonitorGroupKind        = schema.GroupKind{Group: Group, Kind: HealthMonitorKind}.String()
+	HealthMonitorKindAPIVersion   = HealthMonitorKind + "." + SchemeGroupVersion.String()
+	HealthMonitorGroupVersionKind = SchemeGroupVersion.WithKind(HealthMonitorKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&HealthMonitor{}, &HealthMonitorList{})
+}
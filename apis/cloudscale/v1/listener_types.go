@@ -0,0 +1,160 @@
+package v1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ListenerParameters are the configurable fields of a Listener.
+type ListenerParameters struct {
+	// ListenerName is the name of the listener as presented in the cloudscale.ch UI.
+	// If empty, the value of `.metadata.annotations."crossplane.io/external-name"` is used.
+	ListenerName string `json:"listenerName,omitempty"`
+
+	// PoolUUID is the UUID of the pool to which the listener forwards the traffic.
+	// Cannot be changed after the listener is created.
+	PoolUUID string `json:"poolUUID,omitempty"`
+
+	// PoolRef references the Pool to which the listener forwards the traffic.
+	// The UUID of the referenced Pool is resolved into PoolUUID.
+	PoolRef *xpv1.Reference `json:"poolRef,omitempty"`
+
+	// PoolSelector selects the Pool to which the listener forwards the traffic.
+	PoolSelector *xpv1.Selector `json:"poolSelector,omitempty"`
+
+	// +kubebuilder:validation:Enum=tcp
+	// +kubebuilder:default="tcp"
+
+	// Protocol is the protocol of the listener.
+	// Cannot be changed after the listener is created.
+	Protocol string `json:"protocol,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+
+	// ProtocolPort is the port on which the listener accepts traffic.
+	ProtocolPort int `json:"protocolPort"`
+
+	// AllowedCIDRs restrict the clients of the listener to the given address ranges.
+	// If empty, all clients are allowed.
+	AllowedCIDRs []string `json:"allowedCIDRs,omitempty"`
+
+	// TimeoutClientDataMS is the idle timeout of client connections in milliseconds.
+	// If empty, the timeout chosen by cloudscale.ch is kept.
+	TimeoutClientDataMS int `json:"timeoutClientDataMS,omitempty"`
+
+	// TimeoutMemberConnectMS is the timeout for connecting to a member in milliseconds.
+	// If empty, the timeout chosen by cloudscale.ch is kept.
+	TimeoutMemberConnectMS int `json:"timeoutMemberConnectMS,omitempty"`
+
+	// TimeoutMemberDataMS is the idle timeout of member connections in milliseconds.
+	// If empty, the timeout chosen by cloudscale.ch is kept.
+	TimeoutMemberDataMS int `json:"timeoutMemberDataMS,omitempty"`
+
+	// Tags contain additional key-value information of a Listener.
+	// The tag `cloudscale.crossplane.io/owner-uid` is reserved, it is managed by the provider to identify the listener.
+	Tags Tags `json:"tags,omitempty"`
+}
+
+// ListenerSpec defines the desired state of a Listener.
+type ListenerSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ListenerParameters `json:"forProvider"`
+}
+
+// ListenerStatus represents the observed state of a Listener.
+type ListenerStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+
+	AtProvider ListenerObservation `json:"atProvider,omitempty"`
+}
+
+// ListenerObservation contains the observed fields of a Listener.
+type ListenerObservation struct {
+	// ListenerUUID is the unique ID as generated by cloudscale.ch.
+	ListenerUUID string `json:"listenerUUID,omitempty"`
+	// ListenerName is the observed name of the listener.
+	ListenerName string `json:"listenerName,omitempty"`
+	// PoolUUID is the UUID of the pool to which the listener forwards the traffic.
+	PoolUUID string `json:"poolUUID,omitempty"`
+	// LoadBalancerUUID is the UUID of the load balancer of the listener.
+	LoadBalancerUUID string `json:"loadBalancerUUID,omitempty"`
+	// Protocol is the observed protocol of the listener.
+	Protocol string `json:"protocol,omitempty"`
+	// ProtocolPort is the observed port of the listener.
+	ProtocolPort int `json:"protocolPort,omitempty"`
+	// AllowedCIDRs are the observed address ranges of allowed clients.
+	AllowedCIDRs []string `json:"allowedCIDRs,omitempty"`
+	// TimeoutClientDataMS is the observed idle timeout of client connections in milliseconds.
+	TimeoutClientDataMS int `json:"timeoutClientDataMS,omitempty"`
+	// TimeoutMemberConnectMS is the observed timeout for connecting to a member in milliseconds.
+	TimeoutMemberConnectMS int `json:"timeoutMemberConnectMS,omitempty"`
+	// TimeoutMemberDataMS is the observed idle timeout of member connections in milliseconds.
+	TimeoutMemberDataMS int `json:"timeoutMemberDataMS,omitempty"`
+	// Tags contains the key-value map as observed in cloudscale.ch.
+	Tags Tags `json:"tags,omitempty"`
+	// Project is the label of the cloudscale.ch project as given in the referenced ProviderConfig.
+	Project string `json:"project,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="Synced",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="External Name",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="Protocol",type="string",JSONPath=".status.atProvider.protocol"
+// +kubebuilder:printcolumn:name="Port",type="integer",JSONPath=".status.atProvider.protocolPort"
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".status.atProvider.project"
+// +kubebuilder:printcolumn:name="Listener UUID",type="string",JSONPath=".status.atProvider.listenerUUID",priority=1
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,cloudscale}
+
+// Listener is the API for creating listeners of load balancers on cloudscale.ch.
+type Listener struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ListenerSpec   `json:"spec"`
+	Status ListenerStatus `json:"status,omitempty"`
+}
+
+// GetListenerName returns the Listener name in the following precedence:
+//
+//	.spec.forProvider.listenerName
+//	.metadata.annotations."crossplane.io/external-name"
+//	.metadata.name
+func (in *Listener) GetListenerName() string {
+	if in.Spec.ForProvider.ListenerName != "" {
+		return in.Spec.ForProvider.ListenerName
+	}
+	if name := meta.GetExternalName(in); name != "" {
+		return name
+	}
+	return in.Name
+}
+
+// +kubebuilder:object:root=true
+
+// ListenerList contains a list of Listener
+type ListenerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Listener `json:"items"`
+}
+
+// Listener type metadata.
+var (
+	ListenerKind             = reflect.TypeOf(Listener{}).Name()
+	ListenerGroupKind        = schema.GroupKind{Group: Group, Kind: ListenerKind}.String()
+	ListenerKindAPIVersion   = ListenerKind + "." + SchemeGroupVersion.String()
+	ListenerGroupVersionKind = SchemeGroupVersion.WithKind(ListenerKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Listener{}, &ListenerList{})
+}
@@ -0,0 +1,151 @@
+package v1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// LoadBalancerParameters are the configurable fields of a LoadBalancer.
+type LoadBalancerParameters struct {
+	// LoadBalancerName is the name of the load balancer as presented in the cloudscale.ch UI.
+	// If empty, the value of `.metadata.annotations."crossplane.io/external-name"` is used.
+	LoadBalancerName string `json:"loadBalancerName,omitempty"`
+
+	// +kubebuilder:default="lb-standard"
+
+	// Flavor is the slug of the load balancer flavor, e.g. `lb-standard`.
+	// Cannot be changed after the load balancer is created.
+	Flavor string `json:"flavor,omitempty"`
+
+	// Zone is the slug of the zone in which the load balancer is created, e.g. `rma1`.
+	// If empty, the default zone of the project is used.
+	// Cannot be changed after the load balancer is created.
+	Zone string `json:"zone,omitempty"`
+
+	// VIPAddresses are the virtual IP addresses of the load balancer in private subnets.
+	// If empty, the load balancer gets public virtual IP addresses.
+	// Cannot be changed after the load balancer is created.
+	VIPAddresses []LoadBalancerVIPAddress `json:"vipAddresses,omitempty"`
+
+	// Tags contain additional key-value information of a LoadBalancer.
+	// The tag `cloudscale.crossplane.io/owner-uid` is reserved, it is managed by the provider to identify the load balancer.
+	Tags Tags `json:"tags,omitempty"`
+}
+
+// LoadBalancerVIPAddress is a virtual IP address of a LoadBalancer in a private subnet.
+type LoadBalancerVIPAddress struct {
+	// SubnetUUID is the UUID of the subnet of the address.
+	SubnetUUID string `json:"subnetUUID,omitempty"`
+	// SubnetRef references the Subnet of the address.
+	// The UUID of the referenced Subnet is resolved into SubnetUUID.
+	SubnetRef *xpv1.Reference `json:"subnetRef,omitempty"`
+	// SubnetSelector selects the Subnet of the address.
+	SubnetSelector *xpv1.Selector `json:"subnetSelector,omitempty"`
+	// Address is a fixed IP address in the subnet.
+	// If empty, an address is assigned from the subnet.
+	Address string `json:"address,omitempty"`
+}
+
+// LoadBalancerSpec defines the desired state of a LoadBalancer.
+type LoadBalancerSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       LoadBalancerParameters `json:"forProvider"`
+}
+
+// LoadBalancerStatus represents the observed state of a LoadBalancer.
+type LoadBalancerStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+
+	AtProvider LoadBalancerObservation `json:"atProvider,omitempty"`
+}
+
+// LoadBalancerObservation contains the observed fields of a LoadBalancer.
+type LoadBalancerObservation struct {
+	// LoadBalancerUUID is the unique ID as generated by cloudscale.ch.
+	LoadBalancerUUID string `json:"loadBalancerUUID,omitempty"`
+	// LoadBalancerName is the observed name of the load balancer.
+	LoadBalancerName string `json:"loadBalancerName,omitempty"`
+	// Flavor is the observed flavor slug of the load balancer.
+	Flavor string `json:"flavor,omitempty"`
+	// Zone is the observed zone slug of the load balancer.
+	Zone string `json:"zone,omitempty"`
+	// Status is the observed status of the load balancer, e.g. `running` or `changing`.
+	Status string `json:"status,omitempty"`
+	// VIPAddresses are the observed virtual IP addresses of the load balancer.
+	VIPAddresses []LoadBalancerVIPAddressObservation `json:"vipAddresses,omitempty"`
+	// Tags contains the key-value map as observed in cloudscale.ch.
+	Tags Tags `json:"tags,omitempty"`
+	// Project is the label of the cloudscale.ch project as given in the referenced ProviderConfig.
+	Project string `json:"project,omitempty"`
+}
+
+// LoadBalancerVIPAddressObservation is an observed virtual IP address of a LoadBalancer.
+type LoadBalancerVIPAddressObservation struct {
+	// Version is the IP version of the address.
+	Version int `json:"version,omitempty"`
+	// Address is the IP address.
+	Address string `json:"address,omitempty"`
+	// SubnetUUID is the UUID of the subnet of the address.
+	SubnetUUID string `json:"subnetUUID,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="Synced",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="External Name",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="Zone",type="string",JSONPath=".status.atProvider.zone"
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".status.atProvider.project"
+// +kubebuilder:printcolumn:name="Load Balancer UUID",type="string",JSONPath=".status.atProvider.loadBalancerUUID",priority=1
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,cloudscale}
+
+// LoadBalancer is the API for creating managed load balancers on cloudscale.ch.
+type LoadBalancer struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LoadBalancerSpec   `json:"spec"`
+	Status LoadBalancerStatus `json:"status,omitempty"`
+}
+
+// GetLoadBalancerName returns the LoadBalancer name in the following precedence:
+//
+//	.spec.forProvider.loadBalancerName
+//	.metadata.annotations."crossplane.io/external-name"
+//	.metadata.name
+func (in *LoadBalancer) GetLoadBalancerName() string {
+	if in.Spec.ForProvider.LoadBalancerName != "" {
+		return in.Spec.ForProvider.LoadBalancerName
+	}
+	if name := meta.GetExternalName(in); name != "" {
+		return name
+	}
+	return in.Name
+}
+
+// +kubebuilder:object:root=true
+
+// LoadBalancerList contains a list of LoadBalancer
+type LoadBalancerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LoadBalancer `json:"items"`
+}
+
+// LoadBalancer type metadata.
+var (
+	LoadBalancerKind             = reflect.TypeOf(LoadBalancer{}).Name()
+	LoadBalancerGroupKind        = schema.GroupKind{Group: Group, Kind: LoadBalancerKind}.String()
+	LoadBalancerKindAPIVersion   = LoadBalancerKind + "." + SchemeGroupVersion.String()
+	LoadBalancerGroupVersionKind = SchemeGroupVersion.WithKind(LoadBalancerKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&LoadBalancer{}, &LoadBalancerList{})
+}
@@ -0,0 +1,135 @@
+package v1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// NetworkParameters are the configurable fields of a Network.
+type NetworkParameters struct {
+	// NetworkName is the name of the network as presented in the cloudscale.ch UI.
+	// If empty, the value of `.metadata.annotations."crossplane.io/external-name"` is used.
+	NetworkName string `json:"networkName,omitempty"`
+
+	// Zone is the slug of the zone in which the network is created, e.g. `rma1`.
+	// If empty, the default zone of the project is used.
+	// Cannot be changed after the network is created.
+	Zone string `json:"zone,omitempty"`
+
+	// +kubebuilder:validation:Minimum=1280
+	// +kubebuilder:validation:Maximum=9000
+
+	// MTU is the maximum transmission unit of the network.
+	// If empty, cloudscale.ch uses 9000.
+	MTU int `json:"mtu,omitempty"`
+
+	// AutoCreateIPv4Subnet creates a subnet `172.16.0.0/24` in the network.
+	// If empty, cloudscale.ch creates the subnet.
+	// Set it to `false` if the subnets of the network are managed with Subnet resources.
+	// Cannot be changed after the network is created.
+	AutoCreateIPv4Subnet *bool `json:"autoCreateIPv4Subnet,omitempty"`
+
+	// Tags contain additional key-value information of a Network.
+	// The tag `cloudscale.crossplane.io/owner-uid` is reserved, it is managed by the provider to identify the network.
+	Tags Tags `json:"tags,omitempty"`
+}
+
+// NetworkSpec defines the desired state of a Network.
+type NetworkSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       NetworkParameters `json:"forProvider"`
+}
+
+// NetworkStatus represents the observed state of a Network.
+type NetworkStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+
+	AtProvider NetworkObservation `json:"atProvider,omitempty"`
+}
+
+// NetworkObservation contains the observed fields of a Network.
+type NetworkObservation struct {
+	// NetworkUUID is the unique ID as generated by cloudscale.ch.
+	NetworkUUID string `json:"networkUUID,omitempty"`
+	// NetworkName is the observed name of the network.
+	NetworkName string `json:"networkName,omitempty"`
+	// Zone is the observed zone slug of the network.
+	Zone string `json:"zone,omitempty"`
+	// MTU is the observed maximum transmission unit of the network.
+	MTU int `json:"mtu,omitempty"`
+	// Subnets are the subnets of the network.
+	Subnets []NetworkSubnetObservation `json:"subnets,omitempty"`
+	// Tags contains the key-value map as observed in cloudscale.ch.
+	Tags Tags `json:"tags,omitempty"`
+	// Project is the label of the cloudscale.ch project as given in the referenced ProviderConfig.
+	Project string `json:"project,omitempty"`
+}
+
+// NetworkSubnetObservation is an observed subnet of a Network.
+type NetworkSubnetObservation struct {
+	// SubnetUUID is the UUID of the subnet.
+	SubnetUUID string `json:"subnetUUID,omitempty"`
+	// CIDR is the address range of the subnet.
+	CIDR string `json:"cidr,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="Synced",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="External Name",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="Zone",type="string",JSONPath=".status.atProvider.zone"
+// +kubebuilder:printcolumn:name="MTU",type="integer",JSONPath=".status.atProvider.mtu"
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".status.atProvider.project"
+// +kubebuilder:printcolumn:name="Network UUID",type="string",JSONPath=".status.atProvider.networkUUID",priority=1
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,cloudscale}
+
+// Network is the API for creating private networks on cloudscale.ch.
+type Network struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NetworkSpec   `json:"spec"`
+	Status NetworkStatus `json:"status,omitempty"`
+}
+
+// GetNetworkName returns the Network name in the following precedence:
+//
+//	.spec.forProvider.networkName
+//	.metadata.annotations."crossplane.io/external-name"
+//	.metadata.name
+func (in *Network) GetNetworkName() string {
+	if in.Spec.ForProvider.NetworkName != "" {
+		return in.Spec.ForProvider.NetworkName
+	}
+	if name := meta.GetExternalName(in); name != "" {
+		return name
+	}
+	return in.Name
+}
+
+// +kubebuilder:object:root=true
+
+// NetworkList contains a list of Network
+type NetworkList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Network `json:"items"`
+}
+
+// Network type metadata.
+var (
+	NetworkKind             = reflect.TypeOf(Network{}).Name()
+	NetworkGroupKind        = schema.GroupKind{Group: Group, Kind: NetworkKind}.String()
+	NetworkKindAPIVersion   = NetworkKind + "." + SchemeGroupVersion.String()
+	NetworkGroupVersionKind = SchemeGroupVersion.WithKind(NetworkKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Network{}, &NetworkList{})
+}
@@ -18,6 +18,7 @@ type ObjectsUserParameters struct {
 
 	// Tags contain additional key-value information of an ObjectsUser.
 	// If this map is empty, existing tags will be removed.
+	// The tag `cloudscale.crossplane.io/owner-uid` is reserved, it is managed by the provider to identify the objects user.
 	Tags Tags `json:"tags,omitempty"`
 }
 
@@ -42,6 +43,8 @@ type ObjectsUserObservation struct {
 	Tags Tags `json:"tags,omitempty"`
 	// DisplayName is the observed name of the ObjectsUser.
 	DisplayName string `json:"displayName,omitempty"`
+	// Project is the label of the cloudscale.ch project as given in the referenced ProviderConfig.
+	Project string `json:"project,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -50,6 +53,7 @@ type ObjectsUserObservation struct {
 // +kubebuilder:printcolumn:name="External Name",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:printcolumn:name="User ID",type="string",JSONPath=".status.atProvider.userID"
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".status.atProvider.project"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,cloudscale}
 
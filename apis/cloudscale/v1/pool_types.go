@@ -0,0 +1,152 @@
+package v1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// PoolParameters are the configurable fields of a Pool.
+type PoolParameters struct {
+	// PoolName is the name of the pool as presented in the cloudscale.ch UI.
+	// If empty, the value of `.metadata.annotations."crossplane.io/external-name"` is used.
+	PoolName string `json:"poolName,omitempty"`
+
+	// LoadBalancerUUID is the UUID of the load balancer of the pool.
+	// Cannot be changed after the pool is created.
+	LoadBalancerUUID string `json:"loadBalancerUUID,omitempty"`
+
+	// LoadBalancerRef references the LoadBalancer of the pool.
+	// The UUID of the referenced LoadBalancer is resolved into LoadBalancerUUID.
+	LoadBalancerRef *xpv1.Reference `json:"loadBalancerRef,omitempty"`
+
+	// LoadBalancerSelector selects the LoadBalancer of the pool.
+	LoadBalancerSelector *xpv1.Selector `json:"loadBalancerSelector,omitempty"`
+
+	// +kubebuilder:validation:Enum=round_robin;least_connections;source_ip
+	// +kubebuilder:default="round_robin"
+
+	// Algorithm distributes the traffic to the members of the pool.
+	// Cannot be changed after the pool is created.
+	Algorithm string `json:"algorithm,omitempty"`
+
+	// +kubebuilder:validation:Enum=tcp;proxy;proxyv2
+	// +kubebuilder:default="tcp"
+
+	// Protocol is the protocol between the load balancer and the members of the pool.
+	// Cannot be changed after the pool is created.
+	Protocol string `json:"protocol,omitempty"`
+
+	// Tags contain additional key-value information of a Pool.
+	// The tag `cloudscale.crossplane.io/owner-uid` is reserved, it is managed by the provider to identify the pool.
+	Tags Tags `json:"tags,omitempty"`
+}
+
+// PoolSpec defines the desired state of a Pool.
+type PoolSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       PoolParameters `json:"forProvider"`
+}
+
+// PoolStatus represents the observed state of a Pool.
+type PoolStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+
+	AtProvider PoolObservation `json:"atProvider,omitempty"`
+}
+
+// PoolObservation contains the observed fields of a Pool.
+type PoolObservation struct {
+	// PoolUUID is the unique ID as generated by cloudscale.ch.
+	PoolUUID string `json:"poolUUID,omitempty"`
+	// PoolName is the observed name of the pool.
+	PoolName string `json:"poolName,omitempty"`
+	// LoadBalancerUUID is the UUID of the load balancer of the pool.
+	LoadBalancerUUID string `json:"loadBalancerUUID,omitempty"`
+	// Algorithm is the observed algorithm of the pool.
+	Algorithm string `json:"algorithm,omitempty"`
+	// Protocol is the observed protocol of the pool.
+	Protocol string `json:"protocol,omitempty"`
+	// Members are the members of the pool with their operational status.
+	Members []PoolMemberStatusObservation `json:"members,omitempty"`
+	// Tags contains the key-value map as observed in cloudscale.ch.
+	Tags Tags `json:"tags,omitempty"`
+	// Project is the label of the cloudscale.ch project as given in the referenced ProviderConfig.
+	Project string `json:"project,omitempty"`
+}
+
+// PoolMemberStatusObservation is the observed operational status of a member of a Pool.
+type PoolMemberStatusObservation struct {
+	// MemberUUID is the UUID of the member.
+	MemberUUID string `json:"memberUUID,omitempty"`
+	// MemberName is the name of the member.
+	MemberName string `json:"memberName,omitempty"`
+	// Address is the IP address of the member.
+	Address string `json:"address,omitempty"`
+	// ProtocolPort is the port to which the traffic is forwarded.
+	ProtocolPort int `json:"protocolPort,omitempty"`
+	// Enabled is false if the member doesn't receive traffic.
+	Enabled bool `json:"enabled"`
+	// MonitorStatus is the status reported by the health monitor of the pool, e.g. `up`, `down` or `no_monitor`.
+	MonitorStatus string `json:"monitorStatus,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="Synced",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="External Name",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="Algorithm",type="string",JSONPath=".status.atProvider.algorithm"
+// +kubebuilder:printcolumn:name="Protocol",type="string",JSONPath=".status.atProvider.protocol"
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".status.atProvider.project"
+// +kubebuilder:printcolumn:name="Pool UUID",type="string",JSONPath=".status.atProvider.poolUUID",priority=1
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,cloudscale}
+
+// Pool is the API for creating pools of load balancers on cloudscale.ch.
+type Pool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PoolSpec   `json:"spec"`
+	Status PoolStatus `json:"status,omitempty"`
+}
+
+// GetPoolName returns the Pool name in the following precedence:
+//
+//	.spec.forProvider.poolName
+//	.metadata.annotations."crossplane.io/external-name"
+//	.metadata.name
+func (in *Pool) GetPoolName() string {
+	if in.Spec.ForProvider.PoolName != "" {
+		return in.Spec.ForProvider.PoolName
+	}
+	if name := meta.GetExternalName(in); name != "" {
+		return name
+	}
+	return in.Name
+}
+
+// +kubebuilder:object:root=true
+
+// PoolList contains a list of Pool
+type PoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Pool `json:"items"`
+}
+
+// Pool type metadata.
+var (
+	PoolKind             = reflect.TypeOf(Pool{}).Name()
+	PoolGroupKind        = schema.GroupKind{Group: Group, Kind: PoolKind}.String()
+	PoolKindAPIVersion   = PoolKind + "." + SchemeGroupVersion.String()
+	PoolGroupVersionKind = SchemeGroupVersion.WithKind(PoolKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Pool{}, &PoolList{})
+}
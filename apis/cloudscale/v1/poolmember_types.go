@@ -0,0 +1,170 @@
+package v1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// PoolMemberParameters are the configurable fields of a PoolMember.
+type PoolMemberParameters struct {
+	// MemberName is the name of the member as presented in the cloudscale.ch UI.
+	// If empty, the value of `.metadata.annotations."crossplane.io/external-name"` is used.
+	MemberName string `json:"memberName,omitempty"`
+
+	// PoolUUID is the UUID of the pool of the member.
+	// Cannot be changed after the member is created.
+	PoolUUID string `json:"poolUUID,omitempty"`
+
+	// PoolRef references the Pool of the member.
+	// The UUID of the referenced Pool is resolved into PoolUUID.
+	PoolRef *xpv1.Reference `json:"poolRef,omitempty"`
+
+	// PoolSelector selects the Pool of the member.
+	PoolSelector *xpv1.Selector `json:"poolSelector,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+
+	// ProtocolPort is the port of the member to which the traffic is forwarded.
+	ProtocolPort int `json:"protocolPort"`
+
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+
+	// MonitorPort is the port of the member that is checked by the health monitor.
+	// If empty, the protocol port is checked.
+	MonitorPort int `json:"monitorPort,omitempty"`
+
+	// +kubebuilder:validation:Required
+
+	// Address is the IP address of the member in the subnet.
+	// Cannot be changed after the member is created.
+	Address string `json:"address"`
+
+	// SubnetUUID is the UUID of the subnet of the address.
+	// Cannot be changed after the member is created.
+	SubnetUUID string `json:"subnetUUID,omitempty"`
+
+	// SubnetRef references the Subnet of the address.
+	// The UUID of the referenced Subnet is resolved into SubnetUUID.
+	SubnetRef *xpv1.Reference `json:"subnetRef,omitempty"`
+
+	// SubnetSelector selects the Subnet of the address.
+	SubnetSelector *xpv1.Selector `json:"subnetSelector,omitempty"`
+
+	// +kubebuilder:default=true
+
+	// Enabled is false to stop forwarding traffic to the member, e.g. during maintenance.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Tags contain additional key-value information of a PoolMember.
+	// The tag `cloudscale.crossplane.io/owner-uid` is reserved, it is managed by the provider to identify the member.
+	Tags Tags `json:"tags,omitempty"`
+}
+
+// PoolMemberSpec defines the desired state of a PoolMember.
+type PoolMemberSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       PoolMemberParameters `json:"forProvider"`
+}
+
+// PoolMemberStatus represents the observed state of a PoolMember.
+type PoolMemberStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+
+	AtProvider PoolMemberObservation `json:"atProvider,omitempty"`
+}
+
+// PoolMemberObservation contains the observed fields of a PoolMember.
+type PoolMemberObservation struct {
+	// MemberUUID is the unique ID as generated by cloudscale.ch.
+	MemberUUID string `json:"memberUUID,omitempty"`
+	// MemberName is the observed name of the member.
+	MemberName string `json:"memberName,omitempty"`
+	// PoolUUID is the UUID of the pool of the member.
+	PoolUUID string `json:"poolUUID,omitempty"`
+	// ProtocolPort is the observed port to which the traffic is forwarded.
+	ProtocolPort int `json:"protocolPort,omitempty"`
+	// MonitorPort is the observed port that is checked by the health monitor.
+	MonitorPort int `json:"monitorPort,omitempty"`
+	// Address is the observed IP address of the member.
+	Address string `json:"address,omitempty"`
+	// SubnetUUID is the UUID of the subnet of the address.
+	SubnetUUID string `json:"subnetUUID,omitempty"`
+	// Enabled is false if the member doesn't receive traffic.
+	Enabled bool `json:"enabled"`
+	// MonitorStatus is the status reported by the health monitor of the pool, e.g. `up`, `down` or `no_monitor`.
+	MonitorStatus string `json:"monitorStatus,omitempty"`
+	// Tags contains the key-value map as observed in cloudscale.ch.
+	Tags Tags `json:"tags,omitempty"`
+	// Project is the label of the cloudscale.ch project as given in the referenced ProviderConfig.
+	Project string `json:"project,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="Synced",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="External Name",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="Address",type="string",JSONPath=".status.atProvider.address"
+// +kubebuilder:printcolumn:name="Port",type="integer",JSONPath=".status.atProvider.protocolPort"
+// +kubebuilder:printcolumn:name="Monitor Status",type="string",JSONPath=".status.atProvider.monitorStatus"
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".status.atProvider.project"
+// +kubebuilder:printcolumn:name="Member UUID",type="string",JSONPath=".status.atProvider.memberUUID",priority=1
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,cloudscale}
+
+// PoolMember is the API for adding members to pools of load balancers on cloudscale.ch.
+type PoolMember struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PoolMemberSpec   `json:"spec"`
+	Status PoolMemberStatus `json:"status,omitempty"`
+}
+
+// GetMemberName returns the PoolMember name in the following precedence:
+//
+//	.spec.forProvider.memberName
+//	.metadata.annotations."crossplane.io/external-name"
+//	.metadata.name
+func (in *PoolMember) GetMemberName() string {
+	if in.Spec.ForProvider.MemberName != "" {
+		return in.Spec.ForProvider.MemberName
+	}
+	if name := meta.GetExternalName(in); name != "" {
+		return name
+	}
+	return in.Name
+}
+
+// IsEnabled returns true unless the member has been disabled.
+func (in *PoolMember) IsEnabled() bool {
+	return in.Spec.ForProvider.Enabled == nil || *in.Spec.ForProvider.Enabled
+}
+
+// +kubebuilder:object:root=true
+
+// PoolMemberList contains a list of PoolMember
+type PoolMemberList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PoolMember `json:"items"`
+}
+
+// PoolMember type metadata.
+var (
+	PoolMemberKind             = reflect.TypeOf(PoolMember{}).Name()
+	PoolMemberGroupKind        = schema.GroupKind{Group: Group, Kind: PoolMemberKind}.String()
+	PoolMemberKindAPIVersion   = PoolMemberKind + "." + SchemeGroupVersion.String()
+	PoolMemberGroupVersionKind = SchemeGroupVersion.WithKind(PoolMemberKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&PoolMember{}, &PoolMemberList{})
+}
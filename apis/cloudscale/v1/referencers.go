@@ -0,0 +1,426 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ResolvedServerUUIDAnnotationKey is the annotation key where the server UUID is stored that has been resolved from a server reference or selector.
+	ResolvedServerUUIDAnnotationKey = "cloudscale.crossplane.io/resolved-server-uuid"
+	// ResolvedLoadBalancerUUIDAnnotationKey is the annotation key where the load balancer UUID is stored that has been resolved from a load balancer reference or selector.
+	ResolvedLoadBalancerUUIDAnnotationKey = "cloudscale.crossplane.io/resolved-load-balancer-uuid"
+)
+
+// resolveTarget resolves a reference to the resource something is attached or assigned to, which can be changed after creation.
+// Unlike reference.APIResolver, it resolves the reference again on every call, so that changing the reference changes the target.
+// The resolved value is remembered in the given annotation.
+// If the reference and the selector are removed, a value that has been resolved from them is cleared, while a value that has been set directly is kept.
+func resolveTarget(ctx context.Context, r *reference.APIResolver, mg resource.Managed, annotationKey string, req reference.ResolutionRequest) (reference.ResolutionResponse, error) {
+	resolved, wasResolved := mg.GetAnnotations()[annotationKey]
+	if req.Reference == nil && req.Selector == nil {
+		meta.RemoveAnnotations(mg, annotationKey)
+		if wasResolved && req.CurrentValue == resolved {
+			return reference.ResolutionResponse{}, nil
+		}
+		return reference.ResolutionResponse{ResolvedValue: req.CurrentValue}, nil
+	}
+	req.CurrentValue = ""
+	rsp, err := r.Resolve(ctx, req)
+	if err != nil {
+		return rsp, err
+	}
+	meta.AddAnnotations(mg, map[string]string{annotationKey: rsp.ResolvedValue})
+	return rsp, nil
+}
+
+// ServerUUID extracts the UUID of a referenced Server.
+func ServerUUID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		server, ok := mg.(*Server)
+		if !ok {
+			return ""
+		}
+		return server.Status.AtProvider.ServerUUID
+	}
+}
+
+// VolumeUUID extracts the UUID of a referenced Volume.
+func VolumeUUID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		volume, ok := mg.(*Volume)
+		if !ok {
+			return ""
+		}
+		return volume.Status.AtProvider.VolumeUUID
+	}
+}
+
+// VolumeSnapshotUUID extracts the UUID of a referenced VolumeSnapshot.
+func VolumeSnapshotUUID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		snapshot, ok := mg.(*VolumeSnapshot)
+		if !ok {
+			return ""
+		}
+		return snapshot.Status.AtProvider.SnapshotUUID
+	}
+}
+
+// NetworkUUID extracts the UUID of a referenced Network.
+func NetworkUUID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		network, ok := mg.(*Network)
+		if !ok {
+			return ""
+		}
+		return network.Status.AtProvider.NetworkUUID
+	}
+}
+
+// SubnetUUID extracts the UUID of a referenced Subnet.
+func SubnetUUID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		subnet, ok := mg.(*Subnet)
+		if !ok {
+			return ""
+		}
+		return subnet.Status.AtProvider.SubnetUUID
+	}
+}
+
+// LoadBalancerUUID extracts the UUID of a referenced LoadBalancer.
+func LoadBalancerUUID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		lb, ok := mg.(*LoadBalancer)
+		if !ok {
+			return ""
+		}
+		return lb.Status.AtProvider.LoadBalancerUUID
+	}
+}
+
+// PoolUUID extracts the UUID of a referenced Pool.
+func PoolUUID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		pool, ok := mg.(*Pool)
+		if !ok {
+			return ""
+		}
+		return pool.Status.AtProvider.PoolUUID
+	}
+}
+
+// ServerGroupUUID extracts the UUID of a referenced ServerGroup.
+func ServerGroupUUID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		group, ok := mg.(*ServerGroup)
+		if !ok {
+			return ""
+		}
+		return group.Status.AtProvider.ServerGroupUUID
+	}
+}
+
+// CustomImageSlug extracts the image slug of a referenced CustomImage, e.g. `custom:my-image`.
+func CustomImageSlug() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		image, ok := mg.(*CustomImage)
+		if !ok {
+			return ""
+		}
+		return image.Status.AtProvider.ImageSlug
+	}
+}
+
+// ResolveReferences of this Server.
+func (in *Server) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: in.Spec.ForProvider.Image,
+		Reference:    in.Spec.ForProvider.ImageRef,
+		Selector:     in.Spec.ForProvider.ImageSelector,
+		To:           reference.To{Managed: &CustomImage{}, List: &CustomImageList{}},
+		Extract:      CustomImageSlug(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.image")
+	}
+	in.Spec.ForProvider.Image = rsp.ResolvedValue
+	in.Spec.ForProvider.ImageRef = rsp.ResolvedReference
+
+	for i := range in.Spec.ForProvider.Interfaces {
+		iface := &in.Spec.ForProvider.Interfaces[i]
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: iface.Network,
+			Reference:    iface.NetworkRef,
+			Selector:     iface.NetworkSelector,
+			To:           reference.To{Managed: &Network{}, List: &NetworkList{}},
+			Extract:      NetworkUUID(),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "spec.forProvider.interfaces[%d].network", i)
+		}
+		iface.Network = rsp.ResolvedValue
+		iface.NetworkRef = rsp.ResolvedReference
+
+		for j := range iface.Addresses {
+			addr := &iface.Addresses[j]
+			rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+				CurrentValue: addr.Subnet,
+				Reference:    addr.SubnetRef,
+				Selector:     addr.SubnetSelector,
+				To:           reference.To{Managed: &Subnet{}, List: &SubnetList{}},
+				Extract:      SubnetUUID(),
+			})
+			if err != nil {
+				return errors.Wrapf(err, "spec.forProvider.interfaces[%d].addresses[%d].subnet", i, j)
+			}
+			addr.Subnet = rsp.ResolvedValue
+			addr.SubnetRef = rsp.ResolvedReference
+		}
+	}
+
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: in.Spec.ForProvider.ServerGroupUUIDs,
+		References:    in.Spec.ForProvider.ServerGroupRefs,
+		Selector:      in.Spec.ForProvider.ServerGroupSelector,
+		To:            reference.To{Managed: &ServerGroup{}, List: &ServerGroupList{}},
+		Extract:       ServerGroupUUID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.serverGroupUUIDs")
+	}
+	in.Spec.ForProvider.ServerGroupUUIDs = mrsp.ResolvedValues
+	in.Spec.ForProvider.ServerGroupRefs = mrsp.ResolvedReferences
+	return nil
+}
+
+// ResolveReferences of this Subnet.
+func (in *Subnet) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: in.Spec.ForProvider.NetworkUUID,
+		Reference:    in.Spec.ForProvider.NetworkRef,
+		Selector:     in.Spec.ForProvider.NetworkSelector,
+		To:           reference.To{Managed: &Network{}, List: &NetworkList{}},
+		Extract:      NetworkUUID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.networkUUID")
+	}
+	in.Spec.ForProvider.NetworkUUID = rsp.ResolvedValue
+	in.Spec.ForProvider.NetworkRef = rsp.ResolvedReference
+	return nil
+}
+
+// ResolveReferences of this FloatingIP.
+func (in *FloatingIP) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	rsp, err := resolveTarget(ctx, r, in, ResolvedServerUUIDAnnotationKey, reference.ResolutionRequest{
+		CurrentValue: in.Spec.ForProvider.ServerUUID,
+		Reference:    in.Spec.ForProvider.ServerRef,
+		Selector:     in.Spec.ForProvider.ServerSelector,
+		To:           reference.To{Managed: &Server{}, List: &ServerList{}},
+		Extract:      ServerUUID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.serverUUID")
+	}
+	in.Spec.ForProvider.ServerUUID = rsp.ResolvedValue
+	in.Spec.ForProvider.ServerRef = rsp.ResolvedReference
+
+	rsp, err = resolveTarget(ctx, r, in, ResolvedLoadBalancerUUIDAnnotationKey, reference.ResolutionRequest{
+		CurrentValue: in.Spec.ForProvider.LoadBalancerUUID,
+		Reference:    in.Spec.ForProvider.LoadBalancerRef,
+		Selector:     in.Spec.ForProvider.LoadBalancerSelector,
+		To:           reference.To{Managed: &LoadBalancer{}, List: &LoadBalancerList{}},
+		Extract:      LoadBalancerUUID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.loadBalancerUUID")
+	}
+	in.Spec.ForProvider.LoadBalancerUUID = rsp.ResolvedValue
+	in.Spec.ForProvider.LoadBalancerRef = rsp.ResolvedReference
+	return nil
+}
+
+// ResolveReferences of this LoadBalancer.
+func (in *LoadBalancer) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	for i := range in.Spec.ForProvider.VIPAddresses {
+		vip := &in.Spec.ForProvider.VIPAddresses[i]
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: vip.SubnetUUID,
+			Reference:    vip.SubnetRef,
+			Selector:     vip.SubnetSelector,
+			To:           reference.To{Managed: &Subnet{}, List: &SubnetList{}},
+			Extract:      SubnetUUID(),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "spec.forProvider.vipAddresses[%d].subnetUUID", i)
+		}
+		vip.SubnetUUID = rsp.ResolvedValue
+		vip.SubnetRef = rsp.ResolvedReference
+	}
+	return nil
+}
+
+// ResolveReferences of this Pool.
+func (in *Pool) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: in.Spec.ForProvider.LoadBalancerUUID,
+		Reference:    in.Spec.ForProvider.LoadBalancerRef,
+		Selector:     in.Spec.ForProvider.LoadBalancerSelector,
+		To:           reference.To{Managed: &LoadBalancer{}, List: &LoadBalancerList{}},
+		Extract:      LoadBalancerUUID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.loadBalancerUUID")
+	}
+	in.Spec.ForProvider.LoadBalancerUUID = rsp.ResolvedValue
+	in.Spec.ForProvider.LoadBalancerRef = rsp.ResolvedReference
+	return nil
+}
+
+// ResolveReferences of this PoolMember.
+func (in *PoolMember) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: in.Spec.ForProvider.PoolUUID,
+		Reference:    in.Spec.ForProvider.PoolRef,
+		Selector:     in.Spec.ForProvider.PoolSelector,
+		To:           reference.To{Managed: &Pool{}, List: &PoolList{}},
+		Extract:      PoolUUID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.poolUUID")
+	}
+	in.Spec.ForProvider.PoolUUID = rsp.ResolvedValue
+	in.Spec.ForProvider.PoolRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: in.Spec.ForProvider.SubnetUUID,
+		Reference:    in.Spec.ForProvider.SubnetRef,
+		Selector:     in.Spec.ForProvider.SubnetSelector,
+		To:           reference.To{Managed: &Subnet{}, List: &SubnetList{}},
+		Extract:      SubnetUUID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.subnetUUID")
+	}
+	in.Spec.ForProvider.SubnetUUID = rsp.ResolvedValue
+	in.Spec.ForProvider.SubnetRef = rsp.ResolvedReference
+	return nil
+}
+
+// ResolveReferences of this Listener.
+func (in *Listener) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: in.Spec.ForProvider.PoolUUID,
+		Reference:    in.Spec.ForProvider.PoolRef,
+		Selector:     in.Spec.ForProvider.PoolSelector,
+		To:           reference.To{Managed: &Pool{}, List: &PoolList{}},
+		Extract:      PoolUUID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.poolUUID")
+	}
+	in.Spec.ForProvider.PoolUUID = rsp.ResolvedValue
+	in.Spec.ForProvider.PoolRef = rsp.ResolvedReference
+	return nil
+}
+
+// ResolveReferences of this HealthMonitor.
+func (in *HealthMonitor) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: in.Spec.ForProvider.PoolUUID,
+		Reference:    in.Spec.ForProvider.PoolRef,
+		Selector:     in.Spec.ForProvider.PoolSelector,
+		To:           reference.To{Managed: &Pool{}, List: &PoolList{}},
+		Extract:      PoolUUID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.poolUUID")
+	}
+	in.Spec.ForProvider.PoolUUID = rsp.ResolvedValue
+	in.Spec.ForProvider.PoolRef = rsp.ResolvedReference
+	return nil
+}
+
+// ResolveReferences of this Volume.
+func (in *Volume) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: in.Spec.ForProvider.SourceSnapshotUUID,
+		Reference:    in.Spec.ForProvider.SourceSnapshotRef,
+		Selector:     in.Spec.ForProvider.SourceSnapshotSelector,
+		To:           reference.To{Managed: &VolumeSnapshot{}, List: &VolumeSnapshotList{}},
+		Extract:      VolumeSnapshotUUID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.sourceSnapshotUUID")
+	}
+	in.Spec.ForProvider.SourceSnapshotUUID = rsp.ResolvedValue
+	in.Spec.ForProvider.SourceSnapshotRef = rsp.ResolvedReference
+
+	current := ""
+	if len(in.Spec.ForProvider.ServerUUIDs) > 0 {
+		current = in.Spec.ForProvider.ServerUUIDs[0]
+	}
+	rsp, err = resolveTarget(ctx, r, in, ResolvedServerUUIDAnnotationKey, reference.ResolutionRequest{
+		CurrentValue: current,
+		Reference:    in.Spec.ForProvider.ServerRef,
+		Selector:     in.Spec.ForProvider.ServerSelector,
+		To:           reference.To{Managed: &Server{}, List: &ServerList{}},
+		Extract:      ServerUUID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.serverUUIDs")
+	}
+	switch {
+	case rsp.ResolvedValue == current:
+	case rsp.ResolvedValue == "":
+		in.Spec.ForProvider.ServerUUIDs = nil
+	default:
+		in.Spec.ForProvider.ServerUUIDs = []string{rsp.ResolvedValue}
+	}
+	in.Spec.ForProvider.ServerRef = rsp.ResolvedReference
+	return nil
+}
+
+// ResolveReferences of this VolumeSnapshot.
+func (in *VolumeSnapshot) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: in.Spec.ForProvider.SourceVolumeUUID,
+		Reference:    in.Spec.ForProvider.SourceVolumeRef,
+		Selector:     in.Spec.ForProvider.SourceVolumeSelector,
+		To:           reference.To{Managed: &Volume{}, List: &VolumeList{}},
+		Extract:      VolumeUUID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.sourceVolumeUUID")
+	}
+	in.Spec.ForProvider.SourceVolumeUUID = rsp.ResolvedValue
+	in.Spec.ForProvider.SourceVolumeRef = rsp.ResolvedReference
+	return nil
+}
@@ -0,0 +1,144 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newReferenceClient(t *testing.T) client.Reader {
+	scheme := runtime.NewScheme()
+	require.NoError(t, SchemeBuilder.AddToScheme(scheme))
+	serverA := &Server{ObjectMeta: metav1.ObjectMeta{Name: "server-a"}}
+	serverA.Status.AtProvider.ServerUUID = "uuid-a"
+	serverB := &Server{ObjectMeta: metav1.ObjectMeta{Name: "server-b"}}
+	serverB.Status.AtProvider.ServerUUID = "uuid-b"
+	lb := &LoadBalancer{ObjectMeta: metav1.ObjectMeta{Name: "lb"}}
+	lb.Status.AtProvider.LoadBalancerUUID = "uuid-lb"
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(serverA, serverB, lb).Build()
+}
+
+func TestFloatingIP_ResolveReferences(t *testing.T) {
+	tests := map[string]struct {
+		givenAnnotations     map[string]string
+		givenServerUUID      string
+		givenServerRef       string
+		givenLoadBalancerRef string
+
+		expectedServerUUID       string
+		expectedLoadBalancerUUID string
+		expectedAnnotations      map[string]string
+	}{
+		"GivenServerRef_ThenExpectResolved": {
+			givenServerRef:      "server-a",
+			expectedServerUUID:  "uuid-a",
+			expectedAnnotations: map[string]string{ResolvedServerUUIDAnnotationKey: "uuid-a"},
+		},
+		"GivenResolvedServerRef_WhenRefChanged_ThenExpectOtherServer": {
+			givenAnnotations:    map[string]string{ResolvedServerUUIDAnnotationKey: "uuid-a"},
+			givenServerUUID:     "uuid-a",
+			givenServerRef:      "server-b",
+			expectedServerUUID:  "uuid-b",
+			expectedAnnotations: map[string]string{ResolvedServerUUIDAnnotationKey: "uuid-b"},
+		},
+		"GivenResolvedServerRef_WhenReplacedByLoadBalancerRef_ThenExpectServerCleared": {
+			givenAnnotations:         map[string]string{ResolvedServerUUIDAnnotationKey: "uuid-a"},
+			givenServerUUID:          "uuid-a",
+			givenLoadBalancerRef:     "lb",
+			expectedLoadBalancerUUID: "uuid-lb",
+			expectedAnnotations:      map[string]string{ResolvedLoadBalancerUUIDAnnotationKey: "uuid-lb"},
+		},
+		"GivenServerUUID_WhenNoRef_ThenExpectKept": {
+			givenServerUUID:     "uuid-direct",
+			expectedServerUUID:  "uuid-direct",
+			expectedAnnotations: map[string]string{},
+		},
+		"GivenResolvedServerRef_WhenRefRemovedAndUUIDChanged_ThenExpectUUIDKept": {
+			givenAnnotations:    map[string]string{ResolvedServerUUIDAnnotationKey: "uuid-a"},
+			givenServerUUID:     "uuid-direct",
+			expectedServerUUID:  "uuid-direct",
+			expectedAnnotations: map[string]string{},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			annotations := map[string]string{}
+			for k, v := range tc.givenAnnotations {
+				annotations[k] = v
+			}
+			floatingIP := &FloatingIP{ObjectMeta: metav1.ObjectMeta{Name: "my-floating-ip", Annotations: annotations}}
+			floatingIP.Spec.ForProvider.ServerUUID = tc.givenServerUUID
+			if tc.givenServerRef != "" {
+				floatingIP.Spec.ForProvider.ServerRef = &xpv1.Reference{Name: tc.givenServerRef}
+			}
+			if tc.givenLoadBalancerRef != "" {
+				floatingIP.Spec.ForProvider.LoadBalancerRef = &xpv1.Reference{Name: tc.givenLoadBalancerRef}
+			}
+
+			err := floatingIP.ResolveReferences(context.Background(), newReferenceClient(t))
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedServerUUID, floatingIP.Spec.ForProvider.ServerUUID)
+			assert.Equal(t, tc.expectedLoadBalancerUUID, floatingIP.Spec.ForProvider.LoadBalancerUUID)
+			assert.Equal(t, tc.expectedAnnotations, floatingIP.Annotations)
+		})
+	}
+}
+
+func TestVolume_ResolveReferences(t *testing.T) {
+	tests := map[string]struct {
+		givenAnnotations map[string]string
+		givenServerUUIDs []string
+		givenServerRef   string
+
+		expectedServerUUIDs []string
+		expectedAnnotations map[string]string
+	}{
+		"GivenServerRef_ThenExpectAttached": {
+			givenServerRef:      "server-a",
+			expectedServerUUIDs: []string{"uuid-a"},
+			expectedAnnotations: map[string]string{ResolvedServerUUIDAnnotationKey: "uuid-a"},
+		},
+		"GivenResolvedServerRef_WhenRefChanged_ThenExpectOtherServer": {
+			givenAnnotations:    map[string]string{ResolvedServerUUIDAnnotationKey: "uuid-a"},
+			givenServerUUIDs:    []string{"uuid-a"},
+			givenServerRef:      "server-b",
+			expectedServerUUIDs: []string{"uuid-b"},
+			expectedAnnotations: map[string]string{ResolvedServerUUIDAnnotationKey: "uuid-b"},
+		},
+		"GivenResolvedServerRef_WhenRefRemoved_ThenExpectDetached": {
+			givenAnnotations:    map[string]string{ResolvedServerUUIDAnnotationKey: "uuid-a"},
+			givenServerUUIDs:    []string{"uuid-a"},
+			expectedAnnotations: map[string]string{},
+		},
+		"GivenServerUUIDs_WhenNoRef_ThenExpectKept": {
+			givenServerUUIDs:    []string{"uuid-direct"},
+			expectedServerUUIDs: []string{"uuid-direct"},
+			expectedAnnotations: map[string]string{},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			annotations := map[string]string{}
+			for k, v := range tc.givenAnnotations {
+				annotations[k] = v
+			}
+			volume := &Volume{ObjectMeta: metav1.ObjectMeta{Name: "my-volume", Annotations: annotations}}
+			volume.Spec.ForProvider.ServerUUIDs = tc.givenServerUUIDs
+			if tc.givenServerRef != "" {
+				volume.Spec.ForProvider.ServerRef = &xpv1.Reference{Name: tc.givenServerRef}
+			}
+
+			err := volume.ResolveReferences(context.Background(), newReferenceClient(t))
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedServerUUIDs, volume.Spec.ForProvider.ServerUUIDs)
+			assert.Equal(t, tc.expectedAnnotations, volume.Annotations)
+		})
+	}
+}
@@ -0,0 +1,291 @@
+package v1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// PublicIPv4Name is the connection detail key of the public IPv4 address.
+	PublicIPv4Name = "PUBLIC_IPV4"
+	// PublicIPv6Name is the connection detail key of the public IPv6 address.
+	PublicIPv6Name = "PUBLIC_IPV6"
+)
+
+// PublicNetwork is the network name of the public network of cloudscale.ch.
+const PublicNetwork = "public"
+
+const (
+	// PowerStateRunning keeps the server running.
+	PowerStateRunning PowerState = "running"
+	// PowerStateStopped keeps the server stopped.
+	PowerStateStopped PowerState = "stopped"
+)
+
+// RebootAnnotationKey is the annotation key that triggers a reboot of a Server whenever its value changes, e.g. to the current timestamp.
+const RebootAnnotationKey = "cloudscale.crossplane.io/reboot-requested-at"
+
+// PowerState is the desired power state of a Server.
+type PowerState string
+
+// ServerParameters are the configurable fields of a Server.
+type ServerParameters struct {
+	// ServerName is the name of the server as presented in the cloudscale.ch UI.
+	// If empty, the value of `.metadata.annotations."crossplane.io/external-name"` is used.
+	ServerName string `json:"serverName,omitempty"`
+
+	// +kubebuilder:validation:Required
+
+	// Flavor is the slug of the flavor of the server, e.g. `flex-4-2`.
+	// The server has to be stopped to change the flavor.
+	Flavor string `json:"flavor"`
+
+	// Image is the slug of the image the server is installed from, e.g. `debian-12` or `custom:my-image`.
+	// Either Image, ImageRef or ImageSelector is required.
+	// Cannot be changed after the server is created.
+	Image string `json:"image,omitempty"`
+
+	// ImageRef references the CustomImage the server is installed from.
+	// The image slug of the referenced CustomImage is resolved into Image.
+	ImageRef *xpv1.Reference `json:"imageRef,omitempty"`
+
+	// ImageSelector selects the CustomImage the server is installed from.
+	ImageSelector *xpv1.Selector `json:"imageSelector,omitempty"`
+
+	// Zone is the slug of the zone in which the server is created, e.g. `rma1`.
+	// If empty, the default zone of the project is used.
+	// Cannot be changed after the server is created.
+	Zone string `json:"zone,omitempty"`
+
+	// VolumeSizeGB is the size of the root volume in GB.
+	// If empty, the minimum size of the image is used.
+	// Cannot be changed after the server is created.
+	VolumeSizeGB int `json:"volumeSizeGB,omitempty"`
+
+	// BulkVolumeSizeGB is the size of an additional bulk volume in GB, in multiples of 100.
+	// Cannot be changed after the server is created.
+	BulkVolumeSizeGB int `json:"bulkVolumeSizeGB,omitempty"`
+
+	// SSHKeys are the public SSH keys that are installed for the default user.
+	// Cannot be changed after the server is created.
+	SSHKeys []string `json:"sshKeys,omitempty"`
+
+	// UserDataSecretRef references the key of a Secret that contains the cloud-init user data.
+	// Cannot be changed after the server is created.
+	UserDataSecretRef *xpv1.SecretKeySelector `json:"userDataSecretRef,omitempty"`
+
+	// Interfaces are the network interfaces of the server, in the order in which they're attached.
+	// If empty, the server is attached to the public network only.
+	Interfaces []ServerInterface `json:"interfaces,omitempty"`
+
+	// ServerGroupUUIDs are the UUIDs of the server groups the server joins.
+	// Cannot be changed after the server is created.
+	ServerGroupUUIDs []string `json:"serverGroupUUIDs,omitempty"`
+
+	// ServerGroupRefs reference ServerGroups.
+	// The UUIDs of the referenced ServerGroups are resolved into ServerGroupUUIDs.
+	ServerGroupRefs []xpv1.Reference `json:"serverGroupRefs,omitempty"`
+
+	// ServerGroupSelector selects ServerGroups.
+	ServerGroupSelector *xpv1.Selector `json:"serverGroupSelector,omitempty"`
+
+	// UseIPv6 enables an IPv6 address on the public network interface.
+	// Cannot be changed after the server is created.
+	UseIPv6 *bool `json:"useIPv6,omitempty"`
+
+	// Tags contain additional key-value information of a Server.
+	// The tag `cloudscale.crossplane.io/owner-uid` is reserved, it is managed by the provider to identify the server.
+	Tags Tags `json:"tags,omitempty"`
+
+	// +kubebuilder:validation:Enum=running;stopped
+	// +kubebuilder:default="running"
+
+	// PowerState is the desired power state of the server.
+	//  `running` starts the server if it has been stopped, e.g. in the cloudscale.ch UI.
+	//  `stopped` stops the server if it is running.
+	// To reboot the server, change the value of the annotation `cloudscale.crossplane.io/reboot-requested-at`.
+	PowerState PowerState `json:"powerState,omitempty"`
+}
+
+// ServerInterface is a network interface of a Server.
+type ServerInterface struct {
+	// Network is either `public` for the public network, or the UUID of a private network.
+	// Either Network, NetworkRef or NetworkSelector is required.
+	Network string `json:"network,omitempty"`
+
+	// NetworkRef references a Network.
+	// The UUID of the referenced Network is resolved into Network.
+	NetworkRef *xpv1.Reference `json:"networkRef,omitempty"`
+
+	// NetworkSelector selects a Network.
+	NetworkSelector *xpv1.Selector `json:"networkSelector,omitempty"`
+
+	// Addresses configure the addresses of an interface in a private network.
+	// If empty, an address is assigned from the subnet of the network.
+	Addresses []ServerInterfaceAddress `json:"addresses,omitempty"`
+}
+
+// ServerInterfaceAddress is an address of a network interface in a private network.
+type ServerInterfaceAddress struct {
+	// Subnet is the UUID of the subnet of the address.
+	Subnet string `json:"subnet,omitempty"`
+	// SubnetRef references a Subnet.
+	// The UUID of the referenced Subnet is resolved into Subnet.
+	SubnetRef *xpv1.Reference `json:"subnetRef,omitempty"`
+	// SubnetSelector selects a Subnet.
+	SubnetSelector *xpv1.Selector `json:"subnetSelector,omitempty"`
+	// Address is a fixed IP address in the subnet.
+	// If empty, an address is assigned from the subnet.
+	Address string `json:"address,omitempty"`
+}
+
+// ServerSpec defines the desired state of a Server.
+type ServerSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ServerParameters `json:"forProvider"`
+}
+
+// ServerStatus represents the observed state of a Server.
+type ServerStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+
+	AtProvider ServerObservation `json:"atProvider,omitempty"`
+}
+
+// ServerObservation contains the observed fields of a Server.
+type ServerObservation struct {
+	// ServerUUID is the unique ID as generated by cloudscale.ch.
+	ServerUUID string `json:"serverUUID,omitempty"`
+	// ServerName is the observed name of the server.
+	ServerName string `json:"serverName,omitempty"`
+	// State is the observed state of the server, e.g. `running`, `stopped` or `changing`.
+	State string `json:"state,omitempty"`
+	// Flavor is the observed flavor slug of the server.
+	Flavor string `json:"flavor,omitempty"`
+	// Image is the observed image slug of the server.
+	Image string `json:"image,omitempty"`
+	// Zone is the observed zone slug of the server.
+	Zone string `json:"zone,omitempty"`
+	// PublicIPv4 is the IPv4 address of the server in the public network.
+	PublicIPv4 string `json:"publicIPv4,omitempty"`
+	// PublicIPv6 is the IPv6 address of the server in the public network.
+	PublicIPv6 string `json:"publicIPv6,omitempty"`
+	// Interfaces are the observed network interfaces of the server.
+	Interfaces []ServerInterfaceObservation `json:"interfaces,omitempty"`
+	// Volumes are the volumes attached to the server.
+	Volumes []ServerVolumeObservation `json:"volumes,omitempty"`
+	// ServerGroupUUIDs are the UUIDs of the server groups of the server.
+	ServerGroupUUIDs []string `json:"serverGroupUUIDs,omitempty"`
+	// Tags contains the key-value map as observed in cloudscale.ch.
+	Tags Tags `json:"tags,omitempty"`
+	// Project is the label of the cloudscale.ch project as given in the referenced ProviderConfig.
+	Project string `json:"project,omitempty"`
+	// LastRebootRequest is the value of the annotation `cloudscale.crossplane.io/reboot-requested-at` that has been handled last.
+	LastRebootRequest string `json:"lastRebootRequest,omitempty"`
+}
+
+// ServerInterfaceObservation is an observed network interface of a Server.
+type ServerInterfaceObservation struct {
+	// Type is either `public` or `private`.
+	Type string `json:"type,omitempty"`
+	// NetworkUUID is the UUID of the network.
+	NetworkUUID string `json:"networkUUID,omitempty"`
+	// Addresses are the IP addresses of the interface.
+	Addresses []ServerAddressObservation `json:"addresses,omitempty"`
+}
+
+// ServerAddressObservation is an observed IP address of a network interface.
+type ServerAddressObservation struct {
+	// Version is the IP version, either 4 or 6.
+	Version int `json:"version,omitempty"`
+	// Address is the IP address.
+	Address string `json:"address,omitempty"`
+	// PrefixLength is the prefix length of the subnet.
+	PrefixLength int `json:"prefixLength,omitempty"`
+	// Gateway is the gateway of the subnet.
+	Gateway string `json:"gateway,omitempty"`
+	// SubnetUUID is the UUID of the subnet.
+	SubnetUUID string `json:"subnetUUID,omitempty"`
+}
+
+// ServerVolumeObservation is an observed volume attached to a Server.
+type ServerVolumeObservation struct {
+	// VolumeUUID is the UUID of the volume.
+	VolumeUUID string `json:"volumeUUID,omitempty"`
+	// Type is either `ssd` or `bulk`.
+	Type string `json:"type,omitempty"`
+	// DevicePath is the path of the block device in the server.
+	DevicePath string `json:"devicePath,omitempty"`
+	// SizeGB is the size of the volume in GB.
+	SizeGB int `json:"sizeGB,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="Synced",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="External Name",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="Public IPv4",type="string",JSONPath=".status.atProvider.publicIPv4"
+// +kubebuilder:printcolumn:name="Zone",type="string",JSONPath=".status.atProvider.zone"
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".status.atProvider.project"
+// +kubebuilder:printcolumn:name="Server UUID",type="string",JSONPath=".status.atProvider.serverUUID",priority=1
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,cloudscale}
+
+// Server is the API for creating compute instances on cloudscale.ch.
+type Server struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServerSpec   `json:"spec"`
+	Status ServerStatus `json:"status,omitempty"`
+}
+
+// GetServerName returns the Server name in the following precedence:
+//
+//	.spec.forProvider.serverName
+//	.metadata.annotations."crossplane.io/external-name"
+//	.metadata.name
+func (in *Server) GetServerName() string {
+	if in.Spec.ForProvider.ServerName != "" {
+		return in.Spec.ForProvider.ServerName
+	}
+	if name := meta.GetExternalName(in); name != "" {
+		return name
+	}
+	return in.Name
+}
+
+// GetPowerState returns the desired power state, defaulting to PowerStateRunning.
+func (in *Server) GetPowerState() PowerState {
+	if in.Spec.ForProvider.PowerState == "" {
+		return PowerStateRunning
+	}
+	return in.Spec.ForProvider.PowerState
+}
+
+// +kubebuilder:object:root=true
+
+// ServerList contains a list of Server
+type ServerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Server `json:"items"`
+}
+
+// Server type metadata.
+var (
+	ServerKind             = reflect.TypeOf(Server{}).Name()
+	ServerGroupKind        = schema.GroupKind{Group: Group, Kind: ServerKind}.String()
+	ServerKindAPIVersion   = ServerKind + "." + SchemeGroupVersion.String()
+	ServerGroupVersionKind = SchemeGroupVersion.WithKind(ServerKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Server{}, &ServerList{})
+}
@@ -0,0 +1,135 @@
+package v1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ServerGroupAntiAffinity places the servers of a ServerGroup on different physical hosts.
+const ServerGroupAntiAffinity = "anti-affinity"
+
+// ServerGroupParameters are the configurable fields of a ServerGroup.
+type ServerGroupParameters struct {
+	// ServerGroupName is the name of the server group as presented in the cloudscale.ch UI.
+	// If empty, the value of `.metadata.annotations."crossplane.io/external-name"` is used.
+	ServerGroupName string `json:"serverGroupName,omitempty"`
+
+	// +kubebuilder:validation:Enum=anti-affinity
+	// +kubebuilder:default="anti-affinity"
+
+	// Type is the placement policy of the servers in the group.
+	//  `anti-affinity` places the servers on different physical hosts.
+	// Cannot be changed after the server group is created.
+	Type string `json:"type,omitempty"`
+
+	// Zone is the slug of the zone in which the server group is created, e.g. `rma1`.
+	// If empty, the default zone of the project is used.
+	// Servers can only join a server group in the same zone.
+	// Cannot be changed after the server group is created.
+	Zone string `json:"zone,omitempty"`
+
+	// Tags contain additional key-value information of a ServerGroup.
+	// The tag `cloudscale.crossplane.io/owner-uid` is reserved, it is managed by the provider to identify the server group.
+	Tags Tags `json:"tags,omitempty"`
+}
+
+// ServerGroupSpec defines the desired state of a ServerGroup.
+type ServerGroupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ServerGroupParameters `json:"forProvider"`
+}
+
+// ServerGroupStatus represents the observed state of a ServerGroup.
+type ServerGroupStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+
+	AtProvider ServerGroupObservation `json:"atProvider,omitempty"`
+}
+
+// ServerGroupObservation contains the observed fields of a ServerGroup.
+type ServerGroupObservation struct {
+	// ServerGroupUUID is the unique ID as generated by cloudscale.ch.
+	ServerGroupUUID string `json:"serverGroupUUID,omitempty"`
+	// ServerGroupName is the observed name of the server group.
+	ServerGroupName string `json:"serverGroupName,omitempty"`
+	// Type is the observed placement policy of the server group.
+	Type string `json:"type,omitempty"`
+	// Zone is the observed zone slug of the server group.
+	Zone string `json:"zone,omitempty"`
+	// ServerUUIDs are the UUIDs of the servers in the group.
+	ServerUUIDs []string `json:"serverUUIDs,omitempty"`
+	// Tags contains the key-value map as observed in cloudscale.ch.
+	Tags Tags `json:"tags,omitempty"`
+	// Project is the label of the cloudscale.ch project as given in the referenced ProviderConfig.
+	Project string `json:"project,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="Synced",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="External Name",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="Type",type="string",JSONPath=".status.atProvider.type"
+// +kubebuilder:printcolumn:name="Zone",type="string",JSONPath=".status.atProvider.zone"
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".status.atProvider.project"
+// +kubebuilder:printcolumn:name="Server Group UUID",type="string",JSONPath=".status.atProvider.serverGroupUUID",priority=1
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,cloudscale}
+
+// ServerGroup is the API for creating server groups on cloudscale.ch, which control the placement of their servers on physical hosts.
+type ServerGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServerGroupSpec   `json:"spec"`
+	Status ServerGroupStatus `json:"status,omitempty"`
+}
+
+// GetServerGroupName returns the ServerGroup name in the following precedence:
+//
+//	.spec.forProvider.serverGroupName
+//	.metadata.annotations."crossplane.io/external-name"
+//	.metadata.name
+func (in *ServerGroup) GetServerGroupName() string {
+	if in.Spec.ForProvider.ServerGroupName != "" {
+		return in.Spec.ForProvider.ServerGroupName
+	}
+	if name := meta.GetExternalName(in); name != "" {
+		return name
+	}
+	return in.Name
+}
+
+// GetType returns the placement policy, defaulting to ServerGroupAntiAffinity.
+func (in *ServerGroup) GetType() string {
+	if in.Spec.ForProvider.Type == "" {
+		return ServerGroupAntiAffinity
+	}
+	return in.Spec.ForProvider.Type
+}
+
+// +kubebuilder:object:root=true
+
+// ServerGroupList contains a list of ServerGroup
+type ServerGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServerGroup `json:"items"`
+}
+
+// ServerGroup type metadata.
+// The kind is called ServerGroupKindName, since ServerGroupKind is already the group kind of Server.
+var (
+	ServerGroupKindName         = reflect.TypeOf(ServerGroup{}).Name()
+	ServerGroupGroupKind        = schema.GroupKind{Group: Group, Kind: ServerGroupKindName}.String()
+	ServerGroupKindAPIVersion   = ServerGroupKindName + "." + SchemeGroupVersion.String()
+	ServerGroupGroupVersionKind = SchemeGroupVersion.WithKind(ServerGroupKindName)
+)
+
+func init() {
+	SchemeBuilder.Register(&ServerGroup{}, &ServerGroupList{})
+}
@@ -0,0 +1,113 @@
+package v1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// SubnetParameters are the configurable fields of a Subnet.
+type SubnetParameters struct {
+	// +kubebuilder:validation:Required
+
+	// CIDR is the address range of the subnet, e.g. `10.11.12.0/24`.
+	// Cannot be changed after the subnet is created.
+	CIDR string `json:"cidr"`
+
+	// NetworkUUID is the UUID of the network of the subnet.
+	// Cannot be changed after the subnet is created.
+	NetworkUUID string `json:"networkUUID,omitempty"`
+
+	// NetworkRef references the Network of the subnet.
+	// The UUID of the referenced Network is resolved into NetworkUUID.
+	NetworkRef *xpv1.Reference `json:"networkRef,omitempty"`
+
+	// NetworkSelector selects the Network of the subnet.
+	NetworkSelector *xpv1.Selector `json:"networkSelector,omitempty"`
+
+	// GatewayAddress is the gateway that is announced to servers via DHCP.
+	// If empty, the gateway chosen by cloudscale.ch is kept.
+	GatewayAddress string `json:"gatewayAddress,omitempty"`
+
+	// DNSServers are the DNS servers that are announced to servers via DHCP.
+	// If empty, the DNS servers chosen by cloudscale.ch are kept.
+	DNSServers []string `json:"dnsServers,omitempty"`
+
+	// Tags contain additional key-value information of a Subnet.
+	// The tag `cloudscale.crossplane.io/owner-uid` is reserved, it is managed by the provider to identify the subnet.
+	Tags Tags `json:"tags,omitempty"`
+}
+
+// SubnetSpec defines the desired state of a Subnet.
+type SubnetSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SubnetParameters `json:"forProvider"`
+}
+
+// SubnetStatus represents the observed state of a Subnet.
+type SubnetStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+
+	AtProvider SubnetObservation `json:"atProvider,omitempty"`
+}
+
+// SubnetObservation contains the observed fields of a Subnet.
+type SubnetObservation struct {
+	// SubnetUUID is the unique ID as generated by cloudscale.ch.
+	SubnetUUID string `json:"subnetUUID,omitempty"`
+	// CIDR is the observed address range of the subnet.
+	CIDR string `json:"cidr,omitempty"`
+	// NetworkUUID is the UUID of the network of the subnet.
+	NetworkUUID string `json:"networkUUID,omitempty"`
+	// GatewayAddress is the observed gateway of the subnet.
+	GatewayAddress string `json:"gatewayAddress,omitempty"`
+	// DNSServers are the observed DNS servers of the subnet.
+	DNSServers []string `json:"dnsServers,omitempty"`
+	// Tags contains the key-value map as observed in cloudscale.ch.
+	Tags Tags `json:"tags,omitempty"`
+	// Project is the label of the cloudscale.ch project as given in the referenced ProviderConfig.
+	Project string `json:"project,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="Synced",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="CIDR",type="string",JSONPath=".status.atProvider.cidr"
+// +kubebuilder:printcolumn:name="Gateway",type="string",JSONPath=".status.atProvider.gatewayAddress"
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".status.atProvider.project"
+// +kubebuilder:printcolumn:name="Subnet UUID",type="string",JSONPath=".status.atProvider.subnetUUID",priority=1
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,cloudscale}
+
+// Subnet is the API for creating subnets of private networks on cloudscale.ch.
+type Subnet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SubnetSpec   `json:"spec"`
+	Status SubnetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SubnetList contains a list of Subnet
+type SubnetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Subnet `json:"items"`
+}
+
+// Subnet type metadata.
+var (
+	SubnetKind             = reflect.TypeOf(Subnet{}).Name()
+	SubnetGroupKind        = schema.GroupKind{Group: Group, Kind: SubnetKind}.String()
+	SubnetKindAPIVersion   = SubnetKind + "." + SchemeGroupVersion.String()
+	SubnetGroupVersionKind = SchemeGroupVersion.WithKind(SubnetKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Subnet{}, &SubnetList{})
+}
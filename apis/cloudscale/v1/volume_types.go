@@ -0,0 +1,178 @@
+package v1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// DeleteIfDetached only deletes the volume if it isn't attached to any server.
+	DeleteIfDetached VolumeDeletionPolicy = "DeleteIfDetached"
+	// DetachAndDelete detaches the volume from all servers and then deletes it.
+	DetachAndDelete VolumeDeletionPolicy = "DetachAndDelete"
+)
+
+// VolumeDeletionPolicy determines how volumes should be deleted when a Volume is deleted.
+type VolumeDeletionPolicy string
+
+// VolumeParameters are the configurable fields of a Volume.
+type VolumeParameters struct {
+	// VolumeName is the name of the volume as presented in the cloudscale.ch UI.
+	// If empty, the value of `.metadata.annotations."crossplane.io/external-name"` is used.
+	VolumeName string `json:"volumeName,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+
+	// SizeGB is the size of the volume in GB.
+	// Volumes of type `bulk` have to be sized in multiples of 100.
+	// The size can be increased, but not decreased.
+	// A volume restored from a snapshot is at least as large as the snapshot.
+	SizeGB int `json:"sizeGB"`
+
+	// +kubebuilder:validation:Enum=ssd;bulk
+	// +kubebuilder:default="ssd"
+
+	// Type is the storage type of the volume, either `ssd` or `bulk`.
+	// A volume restored from a snapshot has the type of the source volume of the snapshot.
+	// Cannot be changed after the volume is created.
+	Type string `json:"type,omitempty"`
+
+	// Zone is the slug of the zone in which the volume is created, e.g. `rma1`.
+	// If empty, the default zone of the project is used.
+	// Cannot be changed after the volume is created.
+	Zone string `json:"zone,omitempty"`
+
+	// SourceSnapshotUUID is the UUID of a VolumeSnapshot from which the volume is restored.
+	// If set, the volume is created in the zone of the snapshot and with its size and type.
+	// Cannot be changed after the volume is created.
+	SourceSnapshotUUID string `json:"sourceSnapshotUUID,omitempty"`
+
+	// SourceSnapshotRef references the VolumeSnapshot from which the volume is restored.
+	// The UUID of the referenced VolumeSnapshot is resolved into SourceSnapshotUUID.
+	SourceSnapshotRef *xpv1.Reference `json:"sourceSnapshotRef,omitempty"`
+
+	// SourceSnapshotSelector selects the VolumeSnapshot from which the volume is restored.
+	SourceSnapshotSelector *xpv1.Selector `json:"sourceSnapshotSelector,omitempty"`
+
+	// ServerUUIDs are the UUIDs of the servers to which the volume is attached.
+	// If empty, the volume is detached.
+	ServerUUIDs []string `json:"serverUUIDs,omitempty"`
+
+	// ServerRef references the Server to which the volume is attached.
+	// The UUID of the referenced Server is resolved into ServerUUIDs.
+	// Changing the reference attaches the volume to the other Server, removing it detaches the volume.
+	ServerRef *xpv1.Reference `json:"serverRef,omitempty"`
+
+	// ServerSelector selects the Server to which the volume is attached.
+	ServerSelector *xpv1.Selector `json:"serverSelector,omitempty"`
+
+	// Tags contain additional key-value information of a Volume.
+	// The tag `cloudscale.crossplane.io/owner-uid` is reserved, it is managed by the provider to identify the volume.
+	Tags Tags `json:"tags,omitempty"`
+
+	// +kubebuilder:validation:Enum=DeleteIfDetached;DetachAndDelete
+	// +kubebuilder:default="DeleteIfDetached"
+
+	// VolumeDeletionPolicy determines how volumes should be deleted when Volume is deleted.
+	//  `DeleteIfDetached` only deletes the volume if it isn't attached to any server.
+	//  `DetachAndDelete` detaches the volume from all servers and then deletes it.
+	// To skip deletion of the volume (orphan it) set `spec.deletionPolicy=Orphan`.
+	VolumeDeletionPolicy VolumeDeletionPolicy `json:"volumeDeletionPolicy,omitempty"`
+}
+
+// VolumeSpec defines the desired state of a Volume.
+type VolumeSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       VolumeParameters `json:"forProvider"`
+}
+
+// VolumeStatus represents the observed state of a Volume.
+type VolumeStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+
+	AtProvider VolumeObservation `json:"atProvider,omitempty"`
+}
+
+// VolumeObservation contains the observed fields of a Volume.
+type VolumeObservation struct {
+	// VolumeUUID is the unique ID as generated by cloudscale.ch.
+	VolumeUUID string `json:"volumeUUID,omitempty"`
+	// VolumeName is the observed name of the volume.
+	VolumeName string `json:"volumeName,omitempty"`
+	// SizeGB is the observed size of the volume in GB.
+	SizeGB int `json:"sizeGB,omitempty"`
+	// Type is the observed storage type of the volume.
+	Type string `json:"type,omitempty"`
+	// Zone is the observed zone slug of the volume.
+	Zone string `json:"zone,omitempty"`
+	// ServerUUIDs are the UUIDs of the servers to which the volume is attached.
+	ServerUUIDs []string `json:"serverUUIDs,omitempty"`
+	// Tags contains the key-value map as observed in cloudscale.ch.
+	Tags Tags `json:"tags,omitempty"`
+	// Project is the label of the cloudscale.ch project as given in the referenced ProviderConfig.
+	Project string `json:"project,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="Synced",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="External Name",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="Size GB",type="integer",JSONPath=".status.atProvider.sizeGB"
+// +kubebuilder:printcolumn:name="Type",type="string",JSONPath=".status.atProvider.type"
+// +kubebuilder:printcolumn:name="Zone",type="string",JSONPath=".status.atProvider.zone"
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".status.atProvider.project"
+// +kubebuilder:printcolumn:name="Volume UUID",type="string",JSONPath=".status.atProvider.volumeUUID",priority=1
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,cloudscale}
+// +kubebuilder:webhook:verbs=update,path=/validate-cloudscale-crossplane-io-v1-volume,mutating=false,failurePolicy=fail,groups=cloudscale.crossplane.io,resources=volumes,versions=v1,name=volumes.cloudscale.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+// Volume is the API for creating block storage volumes on cloudscale.ch.
+type Volume struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VolumeSpec   `json:"spec"`
+	Status VolumeStatus `json:"status,omitempty"`
+}
+
+// GetVolumeName returns the Volume name in the following precedence:
+//
+//	.spec.forProvider.volumeName
+//	.metadata.annotations."crossplane.io/external-name"
+//	.metadata.name
+func (in *Volume) GetVolumeName() string {
+	if in.Spec.ForProvider.VolumeName != "" {
+		return in.Spec.ForProvider.VolumeName
+	}
+	if name := meta.GetExternalName(in); name != "" {
+		return name
+	}
+	return in.Name
+}
+
+// +kubebuilder:object:root=true
+
+// VolumeList contains a list of Volume
+type VolumeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Volume `json:"items"`
+}
+
+// Volume type metadata.
+var (
+	VolumeKind             = reflect.TypeOf(Volume{}).Name()
+	VolumeGroupKind        = schema.GroupKind{Group: Group, Kind: VolumeKind}.String()
+	VolumeKindAPIVersion   = VolumeKind + "." + SchemeGroupVersion.String()
+	VolumeGroupVersionKind = SchemeGroupVersion.WithKind(VolumeKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Volume{}, &VolumeList{})
+}
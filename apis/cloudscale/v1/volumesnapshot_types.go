@@ -0,0 +1,122 @@
+package v1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// VolumeSnapshotParameters are the configurable fields of a VolumeSnapshot.
+type VolumeSnapshotParameters struct {
+	// SnapshotName is the name of the snapshot as presented in the cloudscale.ch UI.
+	// If empty, the value of `.metadata.annotations."crossplane.io/external-name"` is used.
+	SnapshotName string `json:"snapshotName,omitempty"`
+
+	// SourceVolumeUUID is the UUID of the volume from which the snapshot is taken.
+	// Cannot be changed after the snapshot is created.
+	SourceVolumeUUID string `json:"sourceVolumeUUID,omitempty"`
+
+	// SourceVolumeRef references the Volume from which the snapshot is taken.
+	// The UUID of the referenced Volume is resolved into SourceVolumeUUID.
+	SourceVolumeRef *xpv1.Reference `json:"sourceVolumeRef,omitempty"`
+
+	// SourceVolumeSelector selects the Volume from which the snapshot is taken.
+	SourceVolumeSelector *xpv1.Selector `json:"sourceVolumeSelector,omitempty"`
+
+	// Tags contain additional key-value information of a VolumeSnapshot.
+	// The tag `cloudscale.crossplane.io/owner-uid` is reserved, it is managed by the provider to identify the snapshot.
+	Tags Tags `json:"tags,omitempty"`
+}
+
+// VolumeSnapshotSpec defines the desired state of a VolumeSnapshot.
+type VolumeSnapshotSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       VolumeSnapshotParameters `json:"forProvider"`
+}
+
+// VolumeSnapshotStatus represents the observed state of a VolumeSnapshot.
+type VolumeSnapshotStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+
+	AtProvider VolumeSnapshotObservation `json:"atProvider,omitempty"`
+}
+
+// VolumeSnapshotObservation contains the observed fields of a VolumeSnapshot.
+type VolumeSnapshotObservation struct {
+	// SnapshotUUID is the unique ID as generated by cloudscale.ch.
+	SnapshotUUID string `json:"snapshotUUID,omitempty"`
+	// SnapshotName is the observed name of the snapshot.
+	SnapshotName string `json:"snapshotName,omitempty"`
+	// State is the observed state of the snapshot, e.g. `creating` or `available`.
+	State string `json:"state,omitempty"`
+	// SizeGB is the size of the snapshot in GB, which is the size of the source volume at the time the snapshot has been taken.
+	SizeGB int `json:"sizeGB,omitempty"`
+	// SourceVolumeUUID is the UUID of the volume from which the snapshot has been taken.
+	SourceVolumeUUID string `json:"sourceVolumeUUID,omitempty"`
+	// Zone is the observed zone slug of the snapshot.
+	Zone string `json:"zone,omitempty"`
+	// Tags contains the key-value map as observed in cloudscale.ch.
+	Tags Tags `json:"tags,omitempty"`
+	// Project is the label of the cloudscale.ch project as given in the referenced ProviderConfig.
+	Project string `json:"project,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="Synced",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="External Name",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="Size GB",type="integer",JSONPath=".status.atProvider.sizeGB"
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".status.atProvider.project"
+// +kubebuilder:printcolumn:name="Snapshot UUID",type="string",JSONPath=".status.atProvider.snapshotUUID",priority=1
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,cloudscale}
+
+// VolumeSnapshot is the API for creating point-in-time snapshots of volumes on cloudscale.ch.
+type VolumeSnapshot struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VolumeSnapshotSpec   `json:"spec"`
+	Status VolumeSnapshotStatus `json:"status,omitempty"`
+}
+
+// GetSnapshotName returns the VolumeSnapshot name in the following precedence:
+//
+//	.spec.forProvider.snapshotName
+//	.metadata.annotations."crossplane.io/external-name"
+//	.metadata.name
+func (in *VolumeSnapshot) GetSnapshotName() string {
+	if in.Spec.ForProvider.SnapshotName != "" {
+		return in.Spec.ForProvider.SnapshotName
+	}
+	if name := meta.GetExternalName(in); name != "" {
+		return name
+	}
+	return in.Name
+}
+
+// +kubebuilder:object:root=true
+
+// VolumeSnapshotList contains a list of VolumeSnapshot
+type VolumeSnapshotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VolumeSnapshot `json:"items"`
+}
+
+// VolumeSnapshot type metadata.
+var (
+	VolumeSnapshotKind             = reflect.TypeOf(VolumeSnapshot{}).Name()
+	VolumeSnapshotGroupKind        = schema.GroupKind{Group: Group, Kind: VolumeSnapshotKind}.String()
+	VolumeSnapshotKindAPIVersion   = VolumeSnapshotKind + "." + SchemeGroupVersion.String()
+	VolumeSnapshotGroupVersionKind = SchemeGroupVersion.WithKind(VolumeSnapshotKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&VolumeSnapshot{}, &VolumeSnapshotList{})
+}
@@ -0,0 +1,49 @@
+package v1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Reasons a ProviderConfig is or is not healthy.
+const (
+	// ReasonTokenValid indicates that the API token has been accepted by the cloudscale.ch API.
+	ReasonTokenValid xpv1.ConditionReason = "TokenValid"
+	// ReasonTokenInvalid indicates that the API token has been rejected by the cloudscale.ch API, e.g. because it is revoked or mistyped.
+	ReasonTokenInvalid xpv1.ConditionReason = "TokenInvalid"
+	// ReasonPermissionDenied indicates that the API token lacks the required permissions.
+	ReasonPermissionDenied xpv1.ConditionReason = "PermissionDenied"
+	// ReasonCredentialsUnavailable indicates that the API token could not be extracted from the credentials source.
+	ReasonCredentialsUnavailable xpv1.ConditionReason = "CredentialsUnavailable"
+	// ReasonAPIUnavailable indicates that the cloudscale.ch API could not be reached to validate the token.
+	ReasonAPIUnavailable xpv1.ConditionReason = "APIUnavailable"
+)
+
+// Healthy returns a condition that indicates that the API token of the ProviderConfig is valid.
+// The message names the given project label of the ProviderConfig.
+// The cloudscale.ch API doesn't expose the project of a token, so the message says so if no label is given.
+func Healthy(project string) xpv1.Condition {
+	message := "API token is valid for project " + project
+	if project == "" {
+		message = "API token is valid, the project is unknown since the cloudscale.ch API doesn't expose it, set spec.project to label it"
+	}
+	return xpv1.Condition{
+		Type:               xpv1.TypeHealthy,
+		Status:             corev1.ConditionTrue,
+		Reason:             ReasonTokenValid,
+		Message:            message,
+		LastTransitionTime: metav1.Now(),
+	}
+}
+
+// Unhealthy returns a condition that indicates that the API token of the ProviderConfig cannot be used.
+func Unhealthy(reason xpv1.ConditionReason, message string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               xpv1.TypeHealthy,
+		Status:             corev1.ConditionFalse,
+		Reason:             reason,
+		Message:            message,
+		LastTransitionTime: metav1.Now(),
+	}
+}
@@ -14,16 +14,35 @@ import (
 type ProviderConfigSpec struct {
 	// Credentials required to authenticate to this provider.
 	Credentials ProviderCredentials `json:"credentials"`
+
+	// APIURL is the base URL of the cloudscale.ch API, without the version path.
+	// It can point to a local stand-in of the API for testing.
+	// Defaults to `https://api.cloudscale.ch/`.
+	// +kubebuilder:validation:Pattern=`^https?://`
+	// +optional
+	APIURL string `json:"apiURL,omitempty"`
+
+	// Project is a human-readable label of the cloudscale.ch project to which the API token belongs.
+	// The cloudscale.ch API doesn't expose the project of a token, so the label is only displayed, e.g. in the status of the managed resources.
+	// +optional
+	Project string `json:"project,omitempty"`
 }
 
 // ProviderCredentials required to authenticate.
 type ProviderCredentials struct {
 	//+kubebuilder:validation:Enum=None;Secret;InjectedIdentity;Environment;Filesystem
 
-	// Source represents location of the cluster token.
+	// Source represents location of the cloudscale.ch API token.
+	//  `Secret` reads the token from `secretRef`, or from the key `CLOUDSCALE_API_TOKEN` in `apiTokenSecretRef` if `secretRef` is not set.
+	//  `Environment` reads the token from the environment variable given in `env`.
+	//  `Filesystem` reads the token from the file given in `fs`, e.g. a file rendered by a Vault agent sidecar.
+	// `None` is not supported.
+	// `InjectedIdentity` is deprecated, it is only supported together with `apiTokenSecretRef` for backwards compatibility.
 	Source xpv1.CredentialsSource `json:"source"`
 
 	// APITokenSecretRef is the reference to the secret with the cloudscale API token.
+	// The token is expected in the key `CLOUDSCALE_API_TOKEN`.
+	// Only used if the source is `Secret` and `secretRef` is not set.
 	APITokenSecretRef corev1.SecretReference `json:"apiTokenSecretRef,omitempty"`
 
 	xpv1.CommonCredentialSelectors `json:",inline"`
@@ -36,8 +55,11 @@ type ProviderConfigStatus struct {
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".spec.project"
+// +kubebuilder:printcolumn:name="Healthy",type="string",JSONPath=".status.conditions[?(@.type=='Healthy')].status"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:printcolumn:name="Secret-Name",type="string",JSONPath=".spec.credentials.secretRef.name",priority=1
+// +kubebuilder:printcolumn:name="API-URL",type="string",JSONPath=".spec.apiURL",priority=1
 // +kubebuilder:resource:scope=Cluster
 
 // A ProviderConfig configures a Template provider.
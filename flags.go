@@ -2,8 +2,12 @@ package main
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/urfave/cli/v2"
+	"github.com/vshn/provider-cloudscale/operator/probes"
+	"github.com/vshn/provider-cloudscale/operator/ratelimit"
 )
 
 func newLogLevelFlag() *cli.IntFlag {
@@ -44,3 +48,179 @@ func newWebhookTLSCertDirFlag(dest *string) *cli.StringFlag {
 		Destination: dest,
 	}
 }
+
+func newHealthProbeBindAddressFlag(dest *string) *cli.StringFlag {
+	return &cli.StringFlag{
+		Name: "health-probe-bind-address", EnvVars: []string{"HEALTH_PROBE_BIND_ADDRESS"},
+		Usage:       "Address on which the '/healthz' and '/readyz' endpoints are served. If '0', the probes are disabled.",
+		Value:       ":8081",
+		Destination: dest,
+	}
+}
+
+func newReadinessAPIURLFlag(dest *string) *cli.StringFlag {
+	return &cli.StringFlag{
+		Name: "readiness-api-url", EnvVars: []string{"READINESS_API_URL"},
+		Usage:       "URL of the cloudscale.ch API that '/readyz' checks for reachability. If empty, the API isn't checked.",
+		Value:       probes.DefaultAPIURL,
+		Destination: dest,
+	}
+}
+
+func newMetricsBindAddressFlag(dest *string) *cli.StringFlag {
+	return &cli.StringFlag{
+		Name: "metrics-bind-address", EnvVars: []string{"METRICS_BIND_ADDRESS"},
+		Usage:       "Address on which the '/metrics' endpoint is served. If '0', the metrics endpoint is disabled.",
+		Value:       ":8080",
+		Destination: dest,
+	}
+}
+
+func newTracingEndpointFlag(dest *string) *cli.StringFlag {
+	return &cli.StringFlag{
+		Name: "tracing-endpoint", EnvVars: []string{"TRACING_ENDPOINT"},
+		Usage:       "URL of the OTLP/HTTP collector to which traces are exported, e.g. 'http://localhost:4318'. If empty, tracing is disabled.",
+		Destination: dest,
+	}
+}
+
+func newTracingSamplingRatioFlag(dest *float64) *cli.Float64Flag {
+	return &cli.Float64Flag{
+		Name: "tracing-sampling-ratio", EnvVars: []string{"TRACING_SAMPLING_RATIO"},
+		Usage:       "Ratio of reconciliations that are traced, between 0 and 1.",
+		Value:       1,
+		Destination: dest,
+	}
+}
+
+func newAPIQPSFlag(dest *float64) *cli.Float64Flag {
+	return &cli.Float64Flag{
+		Name: "cloudscale-api-qps", EnvVars: []string{"CLOUDSCALE_API_QPS"},
+		Usage:       "Number of requests per second made to the cloudscale.ch APIs with the same credentials. If 0, requests are not limited.",
+		Value:       ratelimit.DefaultOptions.QPS,
+		Destination: dest,
+	}
+}
+
+func newAPIBurstFlag(dest *int) *cli.IntFlag {
+	return &cli.IntFlag{
+		Name: "cloudscale-api-burst", EnvVars: []string{"CLOUDSCALE_API_BURST"},
+		Usage:       "Number of requests made at once to the cloudscale.ch APIs with the same credentials, before the QPS limit applies.",
+		Value:       ratelimit.DefaultOptions.Burst,
+		Destination: dest,
+	}
+}
+
+func newAPIMaxRetriesFlag(dest *int) *cli.IntFlag {
+	return &cli.IntFlag{
+		Name: "cloudscale-api-max-retries", EnvVars: []string{"CLOUDSCALE_API_MAX_RETRIES"},
+		Usage:       "Number of times a request to the cloudscale.ch API is retried at most if it is throttled or fails with a server error.",
+		Value:       ratelimit.DefaultOptions.MaxRetries,
+		Destination: dest,
+	}
+}
+
+func newPollIntervalFlag(controller string, dest *time.Duration, value time.Duration) *cli.DurationFlag {
+	return &cli.DurationFlag{
+		Name: controller + "-poll-interval", EnvVars: []string{envVarName(controller, "POLL_INTERVAL")},
+		Usage:       fmt.Sprintf("Interval after which %s resources are reconciled again, even if they didn't change.", controller),
+		Value:       value,
+		Destination: dest,
+	}
+}
+
+func newPollJitterFlag(controller string, dest *time.Duration) *cli.DurationFlag {
+	return &cli.DurationFlag{
+		Name: controller + "-poll-jitter", EnvVars: []string{envVarName(controller, "POLL_JITTER")},
+		Usage:       fmt.Sprintf("Maximum duration that is randomly added to or subtracted from the poll interval of %s resources. Must be shorter than the poll interval.", controller),
+		Destination: dest,
+		Action: func(ctx *cli.Context, jitter time.Duration) error {
+			interval := ctx.Duration(controller + "-poll-interval")
+			if jitter < 0 || jitter >= interval {
+				return fmt.Errorf("--%s-poll-jitter must be at least 0 and shorter than --%s-poll-interval (%s): %s", controller, controller, interval, jitter)
+			}
+			return nil
+		},
+	}
+}
+
+func newMaxReconcilesFlag(controller string, dest *int) *cli.IntFlag {
+	return &cli.IntFlag{
+		Name: controller + "-max-reconciles", EnvVars: []string{envVarName(controller, "MAX_RECONCILES")},
+		Usage:       fmt.Sprintf("Number of %s resources that are reconciled in parallel.", controller),
+		Value:       1,
+		Destination: dest,
+	}
+}
+
+func newKubeClientQPSFlag(dest *float64) *cli.Float64Flag {
+	return &cli.Float64Flag{
+		Name: "kube-client-qps", EnvVars: []string{"KUBE_CLIENT_QPS"},
+		Usage:       "Number of requests per second made to the Kubernetes API server.",
+		Value:       100,
+		Destination: dest,
+	}
+}
+
+func newKubeClientBurstFlag(dest *int) *cli.IntFlag {
+	return &cli.IntFlag{
+		Name: "kube-client-burst", EnvVars: []string{"KUBE_CLIENT_BURST"},
+		Usage:       "Number of requests made at once to the Kubernetes API server, before the QPS limit applies.",
+		Value:       150, // more Openshift friendly
+		Destination: dest,
+	}
+}
+
+func newSyncPeriodFlag(dest *time.Duration) *cli.DurationFlag {
+	return &cli.DurationFlag{
+		Name: "sync-period", EnvVars: []string{"SYNC_PERIOD"},
+		Usage:       "Interval in which the informer cache is resynced, which reconciles all resources of all controllers.",
+		Value:       10 * time.Hour,
+		Destination: dest,
+	}
+}
+
+func newShardSelectorFlag(dest *string) *cli.StringFlag {
+	return &cli.StringFlag{
+		Name: "shard-selector", EnvVars: []string{"SHARD_SELECTOR"},
+		Usage:       "Label selector of the managed resources that are reconciled, e.g. 'tenant=acme'. If empty, all managed resources are reconciled.",
+		Destination: dest,
+	}
+}
+
+func newShardProviderConfigsFlag() *cli.StringSliceFlag {
+	return &cli.StringSliceFlag{
+		Name: "shard-provider-configs", EnvVars: []string{"SHARD_PROVIDER_CONFIGS"},
+		Usage: "Names of the ProviderConfigs whose managed resources are reconciled. If empty, managed resources of all ProviderConfigs are reconciled.",
+	}
+}
+
+func newEnableExternalSecretStoresFlag(dest *bool) *cli.BoolFlag {
+	return &cli.BoolFlag{
+		Name: "enable-external-secret-stores", Value: false, EnvVars: []string{"ENABLE_EXTERNAL_SECRET_STORES"},
+		Usage:       "Publish connection details to External Secret Stores configured by StoreConfigs.",
+		Destination: dest,
+	}
+}
+
+func newESSTLSCertDirFlag(dest *string) *cli.StringFlag {
+	return &cli.StringFlag{
+		Name: "ess-tls-cert-dir", EnvVars: []string{"ESS_TLS_CERTS_DIR"}, // Env var is set by Crossplane
+		Usage:       "Directory containing the certificates to connect to External Secret Store plugins.",
+		Destination: dest,
+	}
+}
+
+func newNamespaceFlag(dest *string) *cli.StringFlag {
+	return &cli.StringFlag{
+		Name: "namespace", EnvVars: []string{"POD_NAMESPACE"},
+		Usage:       "Namespace in which the provider runs. Used as the default scope of the default StoreConfig.",
+		Value:       "crossplane-system",
+		Destination: dest,
+	}
+}
+
+// envVarName returns the name of the environment variable of a flag for the given controller.
+func envVarName(controller, suffix string) string {
+	return strings.ToUpper(strings.ReplaceAll(controller, "-", "_")) + "_" + suffix
+}
@@ -35,6 +35,21 @@ func main() {
 	generateCloudscaleObjectsUserSample()
 	generateBucketSample()
 	generateProviderConfigSample()
+	generateStoreConfigSample()
+	generateServerSample()
+	generateVolumeSample()
+	generateVolumeSnapshotSample()
+	generateNetworkSample()
+	generateSubnetSample()
+	generateFloatingIPSample()
+	generateLoadBalancerSample()
+	generatePoolSample()
+	generatePoolMemberSample()
+	generateListenerSample()
+	generateHealthMonitorSample()
+	generateServerGroupSample()
+	generateCustomImageSample()
+	generateCatalogSample()
 	generateBucketAdmissionRequest()
 }
 
@@ -109,7 +124,7 @@ func newProviderConfigSample() *providerv1.ProviderConfig {
 			Name: "provider-config"},
 		Spec: providerv1.ProviderConfigSpec{
 			Credentials: providerv1.ProviderCredentials{
-				Source: xpv1.CredentialsSourceInjectedIdentity,
+				Source: xpv1.CredentialsSourceSecret,
 				APITokenSecretRef: corev1.SecretReference{
 					Name:      "api-token",
 					Namespace: "crossplane-system",
@@ -119,6 +134,436 @@ func newProviderConfigSample() *providerv1.ProviderConfig {
 	}
 }
 
+func generateStoreConfigSample() {
+	spec := newStoreConfigSample()
+	serialize(spec, true)
+}
+
+func newStoreConfigSample() *providerv1.StoreConfig {
+	storeType := xpv1.SecretStorePlugin
+	return &providerv1.StoreConfig{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: providerv1.StoreConfigGroupVersionKind.GroupVersion().String(),
+			Kind:       providerv1.StoreConfigKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: "vault"},
+		Spec: providerv1.StoreConfigSpec{
+			SecretStoreConfig: xpv1.SecretStoreConfig{
+				Type:         &storeType,
+				DefaultScope: "crossplane-system",
+				Plugin: &xpv1.PluginStoreConfig{
+					Endpoint: "ess-plugin-vault.crossplane-system:4040",
+					ConfigRef: xpv1.Config{
+						APIVersion: "secrets.crossplane.io/v1alpha1",
+						Kind:       "VaultConfig",
+						Name:       "vault-internal",
+					},
+				},
+			},
+		},
+	}
+}
+
+func generateServerSample() {
+	spec := newServerSample()
+	serialize(spec, true)
+}
+
+func newServerSample() *cloudscalev1.Server {
+	return &cloudscalev1.Server{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: cloudscalev1.ServerGroupVersionKind.GroupVersion().String(),
+			Kind:       cloudscalev1.ServerKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: "my-server"},
+		Spec: cloudscalev1.ServerSpec{
+			ResourceSpec: xpv1.ResourceSpec{
+				ProviderConfigReference: &xpv1.Reference{Name: "provider-config"},
+				WriteConnectionSecretToReference: &xpv1.SecretReference{
+					Name:      "my-server-connection",
+					Namespace: "default",
+				},
+			},
+			ForProvider: cloudscalev1.ServerParameters{
+				Flavor:       "flex-4-2",
+				Image:        "debian-12",
+				Zone:         "rma1",
+				VolumeSizeGB: 20,
+				SSHKeys:      []string{"ssh-ed25519 AAAA... user@example.com"},
+				UserDataSecretRef: &xpv1.SecretKeySelector{
+					SecretReference: xpv1.SecretReference{Name: "my-server-user-data", Namespace: "default"},
+					Key:             "user-data",
+				},
+				Interfaces: []cloudscalev1.ServerInterface{{Network: cloudscalev1.PublicNetwork}},
+				Tags: map[string]string{
+					"key": "value",
+				},
+				PowerState: cloudscalev1.PowerStateRunning,
+			},
+		},
+	}
+}
+
+func generateVolumeSample() {
+	spec := newVolumeSample()
+	serialize(spec, true)
+}
+
+func newVolumeSample() *cloudscalev1.Volume {
+	return &cloudscalev1.Volume{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: cloudscalev1.VolumeGroupVersionKind.GroupVersion().String(),
+			Kind:       cloudscalev1.VolumeKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: "my-volume"},
+		Spec: cloudscalev1.VolumeSpec{
+			ResourceSpec: xpv1.ResourceSpec{
+				ProviderConfigReference: &xpv1.Reference{Name: "provider-config"},
+			},
+			ForProvider: cloudscalev1.VolumeParameters{
+				SizeGB:    50,
+				Type:      "ssd",
+				Zone:      "rma1",
+				ServerRef: &xpv1.Reference{Name: "my-server"},
+				Tags: map[string]string{
+					"key": "value",
+				},
+				VolumeDeletionPolicy: cloudscalev1.DeleteIfDetached,
+			},
+		},
+	}
+}
+
+func generateVolumeSnapshotSample() {
+	spec := newVolumeSnapshotSample()
+	serialize(spec, true)
+}
+
+func newVolumeSnapshotSample() *cloudscalev1.VolumeSnapshot {
+	return &cloudscalev1.VolumeSnapshot{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: cloudscalev1.VolumeSnapshotGroupVersionKind.GroupVersion().String(),
+			Kind:       cloudscalev1.VolumeSnapshotKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: "my-volume-pre-upgrade"},
+		Spec: cloudscalev1.VolumeSnapshotSpec{
+			ResourceSpec: xpv1.ResourceSpec{
+				ProviderConfigReference: &xpv1.Reference{Name: "provider-config"},
+			},
+			ForProvider: cloudscalev1.VolumeSnapshotParameters{
+				SourceVolumeRef: &xpv1.Reference{Name: "my-volume"},
+				Tags: map[string]string{
+					"key": "value",
+				},
+			},
+		},
+	}
+}
+
+func generateNetworkSample() {
+	spec := newNetworkSample()
+	serialize(spec, true)
+}
+
+func newNetworkSample() *cloudscalev1.Network {
+	autoCreateIPv4Subnet := false
+	return &cloudscalev1.Network{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: cloudscalev1.NetworkGroupVersionKind.GroupVersion().String(),
+			Kind:       cloudscalev1.NetworkKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: "my-network"},
+		Spec: cloudscalev1.NetworkSpec{
+			ResourceSpec: xpv1.ResourceSpec{
+				ProviderConfigReference: &xpv1.Reference{Name: "provider-config"},
+			},
+			ForProvider: cloudscalev1.NetworkParameters{
+				Zone:                 "rma1",
+				MTU:                  9000,
+				AutoCreateIPv4Subnet: &autoCreateIPv4Subnet,
+				Tags: map[string]string{
+					"key": "value",
+				},
+			},
+		},
+	}
+}
+
+func generateSubnetSample() {
+	spec := newSubnetSample()
+	serialize(spec, true)
+}
+
+func newSubnetSample() *cloudscalev1.Subnet {
+	return &cloudscalev1.Subnet{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: cloudscalev1.SubnetGroupVersionKind.GroupVersion().String(),
+			Kind:       cloudscalev1.SubnetKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: "my-subnet"},
+		Spec: cloudscalev1.SubnetSpec{
+			ResourceSpec: xpv1.ResourceSpec{
+				ProviderConfigReference: &xpv1.Reference{Name: "provider-config"},
+			},
+			ForProvider: cloudscalev1.SubnetParameters{
+				CIDR:           "10.11.12.0/24",
+				NetworkRef:     &xpv1.Reference{Name: "my-network"},
+				GatewayAddress: "10.11.12.1",
+				DNSServers:     []string{"10.11.12.1"},
+				Tags: map[string]string{
+					"key": "value",
+				},
+			},
+		},
+	}
+}
+
+func generateFloatingIPSample() {
+	spec := newFloatingIPSample()
+	serialize(spec, true)
+}
+
+func newFloatingIPSample() *cloudscalev1.FloatingIP {
+	return &cloudscalev1.FloatingIP{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: cloudscalev1.FloatingIPGroupVersionKind.GroupVersion().String(),
+			Kind:       cloudscalev1.FloatingIPKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: "my-floating-ip"},
+		Spec: cloudscalev1.FloatingIPSpec{
+			ResourceSpec: xpv1.ResourceSpec{
+				ProviderConfigReference: &xpv1.Reference{Name: "provider-config"},
+				WriteConnectionSecretToReference: &xpv1.SecretReference{
+					Name:      "my-floating-ip-connection",
+					Namespace: "default",
+				},
+			},
+			ForProvider: cloudscalev1.FloatingIPParameters{
+				IPVersion:      4,
+				Type:           cloudscalev1.FloatingIPRegional,
+				Region:         "rma",
+				ReversePointer: "www.example.com",
+				ServerRef:      &xpv1.Reference{Name: "my-server"},
+				Tags: map[string]string{
+					"key": "value",
+				},
+			},
+		},
+	}
+}
+
+func generateLoadBalancerSample() {
+	spec := newLoadBalancerSample()
+	serialize(spec, true)
+}
+
+func newLoadBalancerSample() *cloudscalev1.LoadBalancer {
+	return &cloudscalev1.LoadBalancer{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: cloudscalev1.LoadBalancerGroupVersionKind.GroupVersion().String(),
+			Kind:       cloudscalev1.LoadBalancerKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: "my-load-balancer"},
+		Spec: cloudscalev1.LoadBalancerSpec{
+			ResourceSpec: xpv1.ResourceSpec{
+				ProviderConfigReference: &xpv1.Reference{Name: "provider-config"},
+			},
+			ForProvider: cloudscalev1.LoadBalancerParameters{
+				Flavor: "lb-standard",
+				Zone:   "lpg1",
+				VIPAddresses: []cloudscalev1.LoadBalancerVIPAddress{
+					{SubnetRef: &xpv1.Reference{Name: "my-subnet"}},
+				},
+				Tags: map[string]string{
+					"key": "value",
+				},
+			},
+		},
+	}
+}
+
+func generatePoolSample() {
+	spec := newPoolSample()
+	serialize(spec, true)
+}
+
+func newPoolSample() *cloudscalev1.Pool {
+	return &cloudscalev1.Pool{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: cloudscalev1.PoolGroupVersionKind.GroupVersion().String(),
+			Kind:       cloudscalev1.PoolKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: "my-pool"},
+		Spec: cloudscalev1.PoolSpec{
+			ResourceSpec: xpv1.ResourceSpec{
+				ProviderConfigReference: &xpv1.Reference{Name: "provider-config"},
+			},
+			ForProvider: cloudscalev1.PoolParameters{
+				LoadBalancerRef: &xpv1.Reference{Name: "my-load-balancer"},
+				Algorithm:       "round_robin",
+				Protocol:        "tcp",
+			},
+		},
+	}
+}
+
+func generatePoolMemberSample() {
+	spec := newPoolMemberSample()
+	serialize(spec, true)
+}
+
+func newPoolMemberSample() *cloudscalev1.PoolMember {
+	return &cloudscalev1.PoolMember{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: cloudscalev1.PoolMemberGroupVersionKind.GroupVersion().String(),
+			Kind:       cloudscalev1.PoolMemberKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: "my-pool-member"},
+		Spec: cloudscalev1.PoolMemberSpec{
+			ResourceSpec: xpv1.ResourceSpec{
+				ProviderConfigReference: &xpv1.Reference{Name: "provider-config"},
+			},
+			ForProvider: cloudscalev1.PoolMemberParameters{
+				PoolRef:      &xpv1.Reference{Name: "my-pool"},
+				ProtocolPort: 80,
+				Address:      "10.11.12.10",
+				SubnetRef:    &xpv1.Reference{Name: "my-subnet"},
+			},
+		},
+	}
+}
+
+func generateListenerSample() {
+	spec := newListenerSample()
+	serialize(spec, true)
+}
+
+func newListenerSample() *cloudscalev1.Listener {
+	return &cloudscalev1.Listener{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: cloudscalev1.ListenerGroupVersionKind.GroupVersion().String(),
+			Kind:       cloudscalev1.ListenerKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: "my-listener"},
+		Spec: cloudscalev1.ListenerSpec{
+			ResourceSpec: xpv1.ResourceSpec{
+				ProviderConfigReference: &xpv1.Reference{Name: "provider-config"},
+			},
+			ForProvider: cloudscalev1.ListenerParameters{
+				PoolRef:      &xpv1.Reference{Name: "my-pool"},
+				Protocol:     "tcp",
+				ProtocolPort: 80,
+				AllowedCIDRs: []string{"192.0.2.0/24"},
+			},
+		},
+	}
+}
+
+func generateHealthMonitorSample() {
+	spec := newHealthMonitorSample()
+	serialize(spec, true)
+}
+
+func newHealthMonitorSample() *cloudscalev1.HealthMonitor {
+	return &cloudscalev1.HealthMonitor{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: cloudscalev1.HealthMonitorGroupVersionKind.GroupVersion().String(),
+			Kind:       cloudscalev1.HealthMonitorKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: "my-health-monitor"},
+		Spec: cloudscalev1.HealthMonitorSpec{
+			ResourceSpec: xpv1.ResourceSpec{
+				ProviderConfigReference: &xpv1.Reference{Name: "provider-config"},
+			},
+			ForProvider: cloudscalev1.HealthMonitorParameters{
+				PoolRef: &xpv1.Reference{Name: "my-pool"},
+				Type:    "http",
+				HTTP: &cloudscalev1.HealthMonitorHTTP{
+					ExpectedCodes: []string{"200"},
+					URLPath:       "/healthz",
+				},
+			},
+		},
+	}
+}
+
+func generateServerGroupSample() {
+	spec := newServerGroupSample()
+	serialize(spec, true)
+}
+
+func newServerGroupSample() *cloudscalev1.ServerGroup {
+	return &cloudscalev1.ServerGroup{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: cloudscalev1.ServerGroupGroupVersionKind.GroupVersion().String(),
+			Kind:       cloudscalev1.ServerGroupKindName,
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: "my-server-group"},
+		Spec: cloudscalev1.ServerGroupSpec{
+			ResourceSpec: xpv1.ResourceSpec{
+				ProviderConfigReference: &xpv1.Reference{Name: "provider-config"},
+			},
+			ForProvider: cloudscalev1.ServerGroupParameters{
+				Type: cloudscalev1.ServerGroupAntiAffinity,
+				Zone: "rma1",
+				Tags: map[string]string{
+					"key": "value",
+				},
+			},
+		},
+	}
+}
+
+func generateCustomImageSample() {
+	spec := newCustomImageSample()
+	serialize(spec, true)
+}
+
+func newCustomImageSample() *cloudscalev1.CustomImage {
+	return &cloudscalev1.CustomImage{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: cloudscalev1.CustomImageGroupVersionKind.GroupVersion().String(),
+			Kind:       cloudscalev1.CustomImageKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: "my-custom-image"},
+		Spec: cloudscalev1.CustomImageSpec{
+			ResourceSpec: xpv1.ResourceSpec{
+				ProviderConfigReference: &xpv1.Reference{Name: "provider-config"},
+			},
+			ForProvider: cloudscalev1.CustomImageParameters{
+				URL:              "https://example.com/images/my-image.raw",
+				Slug:             "my-image",
+				UserDataHandling: "extend-cloud-config",
+				FirmwareType:     "uefi",
+				Zones:            []string{"rma1"},
+				Tags: map[string]string{
+					"key": "value",
+				},
+			},
+		},
+	}
+}
+
+func generateCatalogSample() {
+	spec := newCatalogSample()
+	serialize(spec, true)
+}
+
+func newCatalogSample() *cloudscalev1.Catalog {
+	return &cloudscalev1.Catalog{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: cloudscalev1.CatalogGroupVersionKind.GroupVersion().String(),
+			Kind:       cloudscalev1.CatalogKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: "cloudscale"},
+		Spec: cloudscalev1.CatalogSpec{
+			ResourceSpec: xpv1.ResourceSpec{
+				ProviderConfigReference: &xpv1.Reference{Name: "provider-config"},
+			},
+		},
+	}
+}
+
 // generateBucketAdmissionRequest generates an update request that will fail.
 func generateBucketAdmissionRequest() {
 	oldSpec := newBucketSample()
@@ -0,0 +1,145 @@
+package apimetrics
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/vshn/provider-cloudscale/operator/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	// APICloudscale is the label value for requests to the cloudscale.ch API.
+	APICloudscale = "cloudscale"
+	// APIS3 is the label value for requests to the S3-compatible objects storage API.
+	APIS3 = "s3"
+)
+
+var (
+	requestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "cloudscale",
+		Subsystem: "api",
+		Name:      "requests_total",
+		Help:      "Number of HTTP requests made to the cloudscale.ch APIs, partitioned by API, resource kind, operation and status code.",
+	}, []string{"api", "kind", "operation", "code"})
+	requestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "cloudscale",
+		Subsystem: "api",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of HTTP requests made to the cloudscale.ch APIs, partitioned by API, resource kind and operation.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"api", "kind", "operation"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(requestsTotal, requestDuration)
+}
+
+// OperationFunc returns the name of the API operation of the given request.
+// The returned value is used as metric label, so it must have a low cardinality.
+type OperationFunc func(req *http.Request) string
+
+// Transport is a http.RoundTripper that records the amount and latency of requests.
+// Each request is also recorded as a client span, and the trace context is propagated via request headers.
+type Transport struct {
+	// API is the name of the API that is being called, e.g. APICloudscale.
+	API string
+	// Kind is the kind of the managed resource on behalf of which the request is made.
+	Kind string
+	// Operation derives the operation name from a request.
+	Operation OperationFunc
+	// Base is the underlying RoundTripper.
+	// If nil, http.DefaultTransport is used.
+	Base http.RoundTripper
+}
+
+// NewCloudscaleTransport returns a new Transport for the cloudscale.ch API.
+func NewCloudscaleTransport(base http.RoundTripper, kind string) *Transport {
+	return &Transport{API: APICloudscale, Kind: kind, Operation: CloudscaleOperation, Base: base}
+}
+
+// NewS3Transport returns a new Transport for the S3-compatible objects storage API.
+func NewS3Transport(base http.RoundTripper, kind string) *Transport {
+	return &Transport{API: APIS3, Kind: kind, Operation: S3Operation, Base: base}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	operation := t.Operation(req)
+
+	ctx, span := otel.Tracer(tracing.TracerName).Start(req.Context(), t.API+" "+operation, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(
+		attribute.String("kind", t.Kind),
+		attribute.String("http.request.method", req.Method),
+		attribute.String("server.address", req.URL.Host),
+	))
+	defer span.End()
+	// A RoundTripper must not modify the given request.
+	req = req.Clone(ctx)
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	start := time.Now()
+	resp, err := base.RoundTrip(req)
+	requestDuration.WithLabelValues(t.API, t.Kind, operation).Observe(time.Since(start).Seconds())
+
+	code := "error"
+	if err == nil {
+		code = strconv.Itoa(resp.StatusCode)
+		span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+		if resp.StatusCode >= http.StatusBadRequest {
+			span.SetStatus(codes.Error, resp.Status)
+		}
+	} else {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	requestsTotal.WithLabelValues(t.API, t.Kind, operation, code).Inc()
+	return resp, err
+}
+
+// CloudscaleOperation returns the HTTP method and the resource type of the cloudscale.ch API request.
+// For example, `GET /v1/objects-users/<id>` returns "GET objects-users".
+func CloudscaleOperation(req *http.Request) string {
+	segments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	if len(segments) < 2 {
+		return req.Method
+	}
+	// segments[0] is the API version
+	return req.Method + " " + segments[1]
+}
+
+// s3SubResources are the query parameters that identify an S3 operation in addition to the method.
+var s3SubResources = []string{"acl", "delete", "lifecycle", "list-type", "location", "object-lock", "policy", "tagging", "uploads", "uploadId", "versioning", "versions"}
+
+// S3Operation returns the HTTP method and the target of the S3 request.
+// The target is either "service", "bucket" or "object", followed by a sub-resource if there is one.
+// For example, `GET /my-bucket?location=` returns "GET bucket?location".
+// Only path-style requests are supported.
+func S3Operation(req *http.Request) string {
+	target := "service"
+	path := strings.Trim(req.URL.Path, "/")
+	if path != "" {
+		target = "bucket"
+		if strings.Contains(path, "/") {
+			target = "object"
+		}
+	}
+	query := req.URL.Query()
+	for _, sub := range s3SubResources {
+		if query.Has(sub) {
+			return req.Method + " " + target + "?" + sub
+		}
+	}
+	return req.Method + " " + target
+}
@@ -0,0 +1,109 @@
+package apimetrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloudscaleOperation(t *testing.T) {
+	tests := map[string]struct {
+		givenMethod       string
+		givenURL          string
+		expectedOperation string
+	}{
+		"GivenCollectionPath_ThenExpectResourceType": {
+			givenMethod:       http.MethodPost,
+			givenURL:          "https://api.cloudscale.ch/v1/objects-users",
+			expectedOperation: "POST objects-users",
+		},
+		"GivenResourcePath_ThenExpectResourceTypeWithoutID": {
+			givenMethod:       http.MethodGet,
+			givenURL:          "https://api.cloudscale.ch/v1/objects-users/abc?tag:key=value",
+			expectedOperation: "GET objects-users",
+		},
+		"GivenNestedPath_ThenExpectFirstResourceType": {
+			givenMethod:       http.MethodGet,
+			givenURL:          "https://api.cloudscale.ch/v1/metrics/buckets",
+			expectedOperation: "GET metrics",
+		},
+		"GivenRootPath_ThenExpectMethodOnly": {
+			givenMethod:       http.MethodGet,
+			givenURL:          "https://api.cloudscale.ch/",
+			expectedOperation: "GET",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := httptest.NewRequest(tc.givenMethod, tc.givenURL, nil)
+			assert.Equal(t, tc.expectedOperation, CloudscaleOperation(req))
+		})
+	}
+}
+
+func TestS3Operation(t *testing.T) {
+	tests := map[string]struct {
+		givenMethod       string
+		givenURL          string
+		expectedOperation string
+	}{
+		"GivenRootPath_ThenExpectService": {
+			givenMethod:       http.MethodGet,
+			givenURL:          "https://objects.rma.cloudscale.ch/",
+			expectedOperation: "GET service",
+		},
+		"GivenBucketPath_ThenExpectBucket": {
+			givenMethod:       http.MethodPut,
+			givenURL:          "https://objects.rma.cloudscale.ch/my-bucket/",
+			expectedOperation: "PUT bucket",
+		},
+		"GivenBucketPath_WhenSubResource_ThenExpectBucketWithSubResource": {
+			givenMethod:       http.MethodGet,
+			givenURL:          "https://objects.rma.cloudscale.ch/my-bucket/?location=",
+			expectedOperation: "GET bucket?location",
+		},
+		"GivenBucketPath_WhenListing_ThenExpectListType": {
+			givenMethod:       http.MethodGet,
+			givenURL:          "https://objects.rma.cloudscale.ch/my-bucket/?list-type=2&prefix=foo",
+			expectedOperation: "GET bucket?list-type",
+		},
+		"GivenObjectPath_ThenExpectObject": {
+			givenMethod:       http.MethodDelete,
+			givenURL:          "https://objects.rma.cloudscale.ch/my-bucket/path/to/object",
+			expectedOperation: "DELETE object",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := httptest.NewRequest(tc.givenMethod, tc.givenURL, nil)
+			assert.Equal(t, tc.expectedOperation, S3Operation(req))
+		})
+	}
+}
+
+func TestTransport_RoundTrip(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	throttled := requestsTotal.WithLabelValues(APICloudscale, "TestKind", "GET servers", "429")
+	failed := requestsTotal.WithLabelValues(APICloudscale, "TestKind", "GET servers", "error")
+	throttledBefore, failedBefore := testutil.ToFloat64(throttled), testutil.ToFloat64(failed)
+
+	transport := NewCloudscaleTransport(nil, "TestKind")
+	client := &http.Client{Transport: transport}
+
+	resp, err := client.Get(server.URL + "/v1/servers")
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	_, err = client.Get("http://127.0.0.1:0/v1/servers")
+	require.Error(t, err)
+
+	assert.Equal(t, float64(1), testutil.ToFloat64(throttled)-throttledBefore)
+	assert.Equal(t, float64(1), testutil.ToFloat64(failed)-failedBefore)
+}
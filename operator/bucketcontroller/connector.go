@@ -7,14 +7,21 @@ import (
 	"strings"
 
 	pipeline "github.com/ccremer/go-command-pipeline"
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
 	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	providerv1 "github.com/vshn/provider-cloudscale/apis/provider/v1"
+	"github.com/vshn/provider-cloudscale/operator/apimetrics"
+	"github.com/vshn/provider-cloudscale/operator/clientcache"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
 	"github.com/vshn/provider-cloudscale/operator/pipelineutil"
+	"github.com/vshn/provider-cloudscale/operator/ratelimit"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	controllerruntime "sigs.k8s.io/controller-runtime"
@@ -22,8 +29,12 @@ import (
 )
 
 type bucketConnector struct {
-	kube     client.Client
-	recorder event.Recorder
+	kube      client.Client
+	recorder  event.Recorder
+	s3Clients *clientcache.Cache[*minio.Client]
+	csClients *clientcache.Cache[*cloudscalesdk.Client]
+	// providerUsers holds the objects users that the provider manages for ProviderConfigs, so that they aren't looked up in every reconcile.
+	providerUsers *clientcache.Cache[*cloudscalesdk.ObjectsUser]
 }
 
 type connectContext struct {
@@ -31,6 +42,23 @@ type connectContext struct {
 	bucket            *cloudscalev1.Bucket
 	minio             *minio.Client
 	credentialsSecret *corev1.Secret
+	providerConfig    *providerv1.ProviderConfig
+	csClient          *cloudscalesdk.Client
+	// credentialsVersion is the version of the credentials of the ProviderConfig.
+	credentialsVersion string
+	accessKey          string
+	secretKey          string
+	s3ClientKey        string
+}
+
+// ParentContext implements tracing.Context.
+func (c *connectContext) ParentContext() context.Context {
+	return c.Context
+}
+
+// SetParentContext implements tracing.Context.
+func (c *connectContext) SetParentContext(ctx context.Context) {
+	c.Context = ctx
 }
 
 func getEndpoint(bucket *cloudscalev1.Bucket) string {
@@ -58,21 +86,46 @@ func (c *bucketConnector) Connect(ctx context.Context, mg resource.Managed) (man
 
 	pctx := &connectContext{Context: ctx, bucket: bucket}
 	pipe := pipeline.NewPipeline[*connectContext]()
+	stepMetrics := pipelineutil.NewStepMetrics[*connectContext](cloudscalev1.BucketKind)
 	pipe.WithBeforeHooks(pipelineutil.DebugLogger(pctx)).
-		WithSteps(
-			pipe.NewStep("fetch secret", c.fetchCredentialsSecret),
-			pipe.NewStep("validate secret", c.validateSecret),
-			pipe.NewStep("create S3 client", c.createS3Client),
-		)
+		WithSteps(stepMetrics.Steps(
+			pipe.When(hasProviderConfigRef, "track provider config", c.trackProviderConfig),
+			pipe.WithNestedSteps("connect with credentials secret", hasCredentialsSecretRef, stepMetrics.Steps(
+				pipe.NewStep("fetch secret", c.fetchCredentialsSecret),
+				pipe.NewStep("get S3 client", c.getS3ClientFromSecret),
+			)...),
+			pipe.WithNestedSteps("connect with provider config", pipeline.Not(hasCredentialsSecretRef), stepMetrics.Steps(
+				pipe.NewStep("fetch provider config", c.fetchProviderConfig),
+				pipe.NewStep("get cloudscale client", c.getCloudscaleClient),
+				pipe.NewStep("ensure provider objects user", c.ensureProviderObjectsUser),
+				pipe.NewStep("get S3 client", c.getS3ClientFromProviderConfig),
+			)...),
+			pipe.WithNestedSteps("get cloudscale client", pipeline.And(hasProviderConfigRef, hasNoCloudscaleClient), stepMetrics.Steps(
+				pipe.NewStep("fetch provider config", c.fetchProviderConfig),
+				pipe.NewStep("get cloudscale client", c.getCloudscaleClient),
+			)...).WithErrorHandler(c.createCloudscaleClientHandler),
+		)...)
 	result := pipe.RunWithContext(pctx)
 
 	if result != nil {
 		return nil, result
 	}
 
-	// We don't need anything from a ProviderConfig.
-	// The S3 credentials are loaded as part of the CRUD methods.
-	return NewProvisioningPipeline(c.kube, c.recorder, pctx.minio), nil
+	// The S3 credentials are either taken from the credentials secret or from the objects user managed for the ProviderConfig.
+	// If a credentials secret is given, the cloudscale client from the ProviderConfig is optional and only used to observe the bucket usage.
+	return &s3CredentialsClient{
+		ExternalClient: NewProvisioningPipeline(c.kube, c.recorder, pctx.minio, pctx.csClient),
+		evict:          func() { c.evictS3Credentials(pctx) },
+	}, nil
+}
+
+// evictS3Credentials evicts the cached S3 client, and the cached objects user if the S3 credentials are taken from the objects user managed for the ProviderConfig.
+// Both are then looked up anew in the next reconcile, e.g. after the objects user or its keys have been deleted.
+func (c *bucketConnector) evictS3Credentials(ctx *connectContext) {
+	c.s3Clients.Delete(ctx.s3ClientKey)
+	if !hasCredentialsSecretRef(ctx) {
+		c.providerUsers.Delete(string(ctx.providerConfig.UID))
+	}
 }
 
 func (c *bucketConnector) fetchCredentialsSecret(ctx *connectContext) error {
@@ -90,6 +143,12 @@ func (c *bucketConnector) fetchCredentialsSecret(ctx *connectContext) error {
 	return nil
 }
 
+// trackProviderConfig ensures that the ProviderConfig referenced by the Bucket is not deleted until all Buckets stop using the ProviderConfig.
+func (c *bucketConnector) trackProviderConfig(ctx *connectContext) error {
+	u := resource.NewProviderConfigUsageTracker(c.kube, &providerv1.ProviderConfigUsage{})
+	return u.Track(ctx, ctx.bucket)
+}
+
 func (c *bucketConnector) validateSecret(ctx *connectContext) error {
 	secret := ctx.credentialsSecret
 
@@ -106,9 +165,56 @@ func (c *bucketConnector) validateSecret(ctx *connectContext) error {
 	return nil
 }
 
-// createS3Client creates a new client using the S3 credentials from the Secret.
-func (c *bucketConnector) createS3Client(ctx *connectContext) error {
+// getS3ClientFromSecret returns the cached S3 client for the credentials secret.
+// A new client is created if there is none yet, or if the secret changed since.
+func (c *bucketConnector) getS3ClientFromSecret(ctx *connectContext) error {
+	secret := ctx.credentialsSecret
+	key := s3ClientKey(fmt.Sprintf("secret/%s/%s", secret.Namespace, secret.Name), ctx.bucket)
+	ctx.s3ClientKey = key
+	s3Client, err := c.s3Clients.Get(key, secret.ResourceVersion, func() (*minio.Client, error) {
+		if err := c.validateSecret(ctx); err != nil {
+			return nil, err
+		}
+		if err := c.loadCredentialsFromSecret(ctx); err != nil {
+			return nil, err
+		}
+		err := c.createS3Client(ctx)
+		return ctx.minio, err
+	})
+	ctx.minio = s3Client
+	return err
+}
+
+// getS3ClientFromProviderConfig returns the cached S3 client for the objects user of the ProviderConfig.
+// A new client is created if there is none yet, or if the objects user or its keys changed since.
+func (c *bucketConnector) getS3ClientFromProviderConfig(ctx *connectContext) error {
+	key := s3ClientKey(fmt.Sprintf("providerconfig/%s", ctx.providerConfig.UID), ctx.bucket)
+	ctx.s3ClientKey = key
+	s3Client, err := c.s3Clients.Get(key, ctx.accessKey, func() (*minio.Client, error) {
+		err := c.createS3Client(ctx)
+		return ctx.minio, err
+	})
+	ctx.minio = s3Client
+	return err
+}
+
+// s3ClientKey returns the key of the cached S3 client for the given credentials and the endpoint of the given Bucket.
+// The deprecated endpointURL is part of the key, so that a client is never shared between Buckets that disagree about the endpoint.
+func s3ClientKey(credentials string, bucket *cloudscalev1.Bucket) string {
+	return fmt.Sprintf("%s/%s/%s", credentials, bucket.Spec.ForProvider.Region, bucket.Spec.ForProvider.EndpointURL)
+}
+
+// loadCredentialsFromSecret stores the S3 credentials from the Secret in the context.
+// We assume here that the secret has the expected keys and data.
+func (c *bucketConnector) loadCredentialsFromSecret(ctx *connectContext) error {
 	secret := ctx.credentialsSecret
+	ctx.accessKey = string(secret.Data[cloudscalev1.AccessKeyIDName])
+	ctx.secretKey = string(secret.Data[cloudscalev1.SecretAccessKeyName])
+	return nil
+}
+
+// createS3Client creates a new client using the S3 credentials from the context.
+func (c *bucketConnector) createS3Client(ctx *connectContext) error {
 	bucket := ctx.bucket
 
 	parsed, err := url.Parse(getEndpointURL(bucket))
@@ -116,22 +222,89 @@ func (c *bucketConnector) createS3Client(ctx *connectContext) error {
 		return err
 	}
 
-	// we assume here that the secret has the expected keys and data.
-	accessKey := string(secret.Data[cloudscalev1.AccessKeyIDName])
-	secretKey := string(secret.Data[cloudscalev1.SecretAccessKeyName])
-
 	host := parsed.Host
 	if parsed.Host == "" {
 		host = parsed.Path // if no scheme is given, it's parsed as a path -.-
 	}
+	secure := isTLSEnabled(parsed)
+	transport, err := minio.DefaultTransport(secure)
+	if err != nil {
+		return err
+	}
 	s3Client, err := minio.New(host, &minio.Options{
-		Creds:  credentials.NewStaticV4(accessKey, secretKey, ""),
-		Secure: isTLSEnabled(parsed),
+		Creds:  credentials.NewStaticV4(ctx.accessKey, ctx.secretKey, ""),
+		Secure: secure,
+		Transport: &ratelimit.Transport{
+			// The S3 client retries throttled requests on its own.
+			Limiter: ratelimit.LimiterFor(s3LimiterKey(ctx)),
+			Base:    apimetrics.NewS3Transport(transport, cloudscalev1.BucketKind),
+		},
 	})
 	ctx.minio = s3Client
 	return err
 }
 
+// s3LimiterKey returns the key of the rate limiter for the S3 credentials in use.
+func s3LimiterKey(ctx *connectContext) string {
+	if hasCredentialsSecretRef(ctx) {
+		secretRef := ctx.bucket.Spec.ForProvider.CredentialsSecretRef
+		return fmt.Sprintf("s3/secret/%s/%s", secretRef.Namespace, secretRef.Name)
+	}
+	return fmt.Sprintf("s3/providerconfig/%s", ctx.providerConfig.UID)
+}
+
+func (c *bucketConnector) fetchProviderConfig(ctx *connectContext) error {
+	if !hasProviderConfigRef(ctx) {
+		return fmt.Errorf("either a credentials secret or a provider config reference is required")
+	}
+	config := &providerv1.ProviderConfig{}
+	err := c.kube.Get(ctx, types.NamespacedName{Name: ctx.bucket.Spec.ProviderConfigReference.Name}, config)
+	if err != nil {
+		return errors.Wrap(err, "cannot get ProviderConfig")
+	}
+	ctx.providerConfig = config
+	ctx.bucket.Status.AtProvider.Project = config.Spec.Project
+	return nil
+}
+
+// getCloudscaleClient returns the cached cloudscale.ch API client of the ProviderConfig.
+// A new client is created if there is none yet, or if the credentials of the ProviderConfig changed since.
+func (c *bucketConnector) getCloudscaleClient(ctx *connectContext) error {
+	version, err := cloudscaleclient.CredentialsVersion(ctx, c.kube, ctx.providerConfig)
+	if err != nil {
+		return err
+	}
+	ctx.credentialsVersion = version
+	csClient, err := c.csClients.Get(string(ctx.providerConfig.UID), version, func() (*cloudscalesdk.Client, error) {
+		token, err := cloudscaleclient.ExtractAPIToken(ctx, c.kube, ctx.providerConfig)
+		if err != nil {
+			return nil, err
+		}
+		return cloudscaleclient.New(token, cloudscalev1.BucketKind, ctx.providerConfig)
+	})
+	ctx.csClient = csClient
+	return err
+}
+
+// createCloudscaleClientHandler ignores the error, since the bucket can be managed without cloudscale client.
+func (c *bucketConnector) createCloudscaleClientHandler(ctx *connectContext, err error) error {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.V(1).Info("Cannot create cloudscale client, bucket usage is not observed", "error", err.Error())
+	return nil
+}
+
+func hasProviderConfigRef(ctx *connectContext) bool {
+	return ctx.bucket.Spec.ProviderConfigReference != nil && ctx.bucket.Spec.ProviderConfigReference.Name != ""
+}
+
+func hasCredentialsSecretRef(ctx *connectContext) bool {
+	return ctx.bucket.Spec.ForProvider.CredentialsSecretRef.Name != ""
+}
+
+func hasNoCloudscaleClient(ctx *connectContext) bool {
+	return ctx.csClient == nil
+}
+
 // isBucketAlreadyDeleted returns true if the status conditions are in a state where one can assume that the deletion of a bucket was successful in a previous reconciliation.
 // This is useful to prevent further reconciliation with possibly lost S3 credentials.
 func isBucketAlreadyDeleted(bucket *cloudscalev1.Bucket) bool {
@@ -163,3 +336,53 @@ func findCondition(conds []xpv1.Condition, condType xpv1.ConditionType) *xpv1.Co
 func isTLSEnabled(u *url.URL) bool {
 	return !strings.EqualFold(u.Scheme, "http")
 }
+
+// s3CredentialsClient evicts the cached S3 client if the S3 endpoint rejects its credentials.
+// The client is then created again in the next reconcile, with the credentials read anew.
+type s3CredentialsClient struct {
+	managed.ExternalClient
+	evict func()
+}
+
+// Observe implements managed.ExternalClient.
+func (c *s3CredentialsClient) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	obs, err := c.ExternalClient.Observe(ctx, mg)
+	c.evictOnAuthError(err)
+	return obs, err
+}
+
+// Create implements managed.ExternalClient.
+func (c *s3CredentialsClient) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	creation, err := c.ExternalClient.Create(ctx, mg)
+	c.evictOnAuthError(err)
+	return creation, err
+}
+
+// Update implements managed.ExternalClient.
+func (c *s3CredentialsClient) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	update, err := c.ExternalClient.Update(ctx, mg)
+	c.evictOnAuthError(err)
+	return update, err
+}
+
+// Delete implements managed.ExternalClient.
+func (c *s3CredentialsClient) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	deletion, err := c.ExternalClient.Delete(ctx, mg)
+	c.evictOnAuthError(err)
+	return deletion, err
+}
+
+func (c *s3CredentialsClient) evictOnAuthError(err error) {
+	if isS3AuthError(err) {
+		c.evict()
+	}
+}
+
+// isS3AuthError returns true if the S3 endpoint rejected the credentials of the request, e.g. because the keys have been revoked.
+func isS3AuthError(err error) bool {
+	var s3Err minio.ErrorResponse
+	if !errors.As(err, &s3Err) {
+		return false
+	}
+	return s3Err.Code == "InvalidAccessKeyId" || s3Err.Code == "SignatureDoesNotMatch"
+}
@@ -2,9 +2,14 @@ package bucketcontroller
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/url"
 	"testing"
 
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/minio/minio-go/v7"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
@@ -107,6 +112,79 @@ func Test_isTLSEnabled(t *testing.T) {
 	}
 }
 
+func Test_s3ClientKey(t *testing.T) {
+	bucket := &cloudscalev1.Bucket{Spec: cloudscalev1.BucketSpec{ForProvider: cloudscalev1.BucketParameters{Region: "rma"}}}
+	deprecatedEndpoint := bucket.DeepCopy()
+	deprecatedEndpoint.Spec.ForProvider.EndpointURL = "https://custom.endpoint"
+
+	assert.Equal(t, "providerconfig/uid/rma/", s3ClientKey("providerconfig/uid", bucket))
+	assert.NotEqual(t, s3ClientKey("providerconfig/uid", bucket), s3ClientKey("providerconfig/uid", deprecatedEndpoint))
+}
+
+type fakeExternalClient struct {
+	managed.ExternalClient
+	err error
+}
+
+// Observe implements managed.ExternalClient.
+func (f *fakeExternalClient) Observe(context.Context, resource.Managed) (managed.ExternalObservation, error) {
+	return managed.ExternalObservation{}, f.err
+}
+
+// Delete implements managed.ExternalClient.
+func (f *fakeExternalClient) Delete(context.Context, resource.Managed) (managed.ExternalDelete, error) {
+	return managed.ExternalDelete{}, f.err
+}
+
+func TestS3CredentialsClient_EvictOnAuthError(t *testing.T) {
+	tests := map[string]struct {
+		givenError     error
+		expectEviction bool
+	}{
+		"GivenNoError_ThenExpectNoEviction": {
+			givenError:     nil,
+			expectEviction: false,
+		},
+		"GivenInvalidAccessKey_ThenExpectEviction": {
+			givenError:     minio.ErrorResponse{Code: "InvalidAccessKeyId"},
+			expectEviction: true,
+		},
+		"GivenSignatureMismatch_WhenWrapped_ThenExpectEviction": {
+			givenError:     fmt.Errorf("cannot get bucket: %w", minio.ErrorResponse{Code: "SignatureDoesNotMatch"}),
+			expectEviction: true,
+		},
+		"GivenOtherS3Error_ThenExpectNoEviction": {
+			givenError:     minio.ErrorResponse{Code: "NoSuchBucket"},
+			expectEviction: false,
+		},
+		"GivenOtherError_ThenExpectNoEviction": {
+			givenError:     errors.New("connection refused"),
+			expectEviction: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			evictions := 0
+			client := &s3CredentialsClient{
+				ExternalClient: &fakeExternalClient{err: tc.givenError},
+				evict:          func() { evictions++ },
+			}
+			bucket := &cloudscalev1.Bucket{}
+
+			_, err := client.Observe(context.Background(), bucket)
+			assert.Equal(t, tc.givenError, err)
+			_, err = client.Delete(context.Background(), bucket)
+			assert.Equal(t, tc.givenError, err)
+
+			if tc.expectEviction {
+				assert.Equal(t, 2, evictions)
+			} else {
+				assert.Zero(t, evictions)
+			}
+		})
+	}
+}
+
 func mustParse(rawUrl string) *url.URL {
 	u, err := url.Parse(rawUrl)
 	if err != nil {
@@ -9,6 +9,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/minio/minio-go/v7"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
 	"github.com/vshn/provider-cloudscale/operator/pipelineutil"
 	controllerruntime "sigs.k8s.io/controller-runtime"
 )
@@ -21,12 +22,13 @@ func (p *ProvisioningPipeline) Create(ctx context.Context, mg resource.Managed)
 	bucket := fromManaged(mg)
 	pctx := &pipelineContext{Context: ctx, bucket: bucket}
 	pipe := pipeline.NewPipeline[*pipelineContext]()
+	stepMetrics := pipelineutil.NewStepMetrics[*pipelineContext](cloudscalev1.BucketKind)
 	pipe.WithBeforeHooks(pipelineutil.DebugLogger(pctx)).
-		WithSteps(
+		WithSteps(stepMetrics.Steps(
 			pipe.NewStep("create bucket", p.createS3Bucket),
 			pipe.NewStep("set lock", p.setLock),
 			pipe.NewStep("emit event", p.emitCreationEvent),
-		)
+		)...)
 	err := pipe.RunWithContext(pctx)
 
 	return managed.ExternalCreation{}, errors.Wrap(err, "cannot provision bucket")
@@ -23,14 +23,16 @@ func (p *ProvisioningPipeline) Delete(ctx context.Context, mg resource.Managed)
 	bucket := fromManaged(mg)
 	pctx := &pipelineContext{Context: ctx, bucket: bucket}
 	pipe := pipeline.NewPipeline[*pipelineContext]()
+	stepMetrics := pipelineutil.NewStepMetrics[*pipelineContext](cloudscalev1.BucketKind)
 	pipe.WithBeforeHooks(pipelineutil.DebugLogger(pctx)).
-		WithSteps(
+		WithSteps(stepMetrics.Steps(
 			pipe.When(hasDeleteAllPolicy,
 				"delete all objects", p.deleteAllObjects,
 			),
 			pipe.NewStep("delete bucket", p.deleteS3Bucket),
+			pipe.NewStep("remove usage metrics", p.removeUsageMetrics),
 			pipe.NewStep("emit event", p.emitDeletionEvent),
-		)
+		)...)
 	err := pipe.RunWithContext(pctx)
 	return managed.ExternalDelete{}, errors.Wrap(err, "cannot deprovision bucket")
 }
@@ -74,6 +76,11 @@ func (p *ProvisioningPipeline) deleteS3Bucket(ctx *pipelineContext) error {
 	return err
 }
 
+func (p *ProvisioningPipeline) removeUsageMetrics(ctx *pipelineContext) error {
+	deleteUsageMetrics(ctx.bucket)
+	return nil
+}
+
 func (p *ProvisioningPipeline) emitDeletionEvent(ctx *pipelineContext) error {
 	p.recorder.Event(ctx.bucket, event.Event{
 		Type:    event.TypeNormal,
@@ -42,6 +42,7 @@ func (p *ProvisioningPipeline) Observe(ctx context.Context, mg resource.Managed)
 	}
 	if _, hasAnnotation := bucket.Annotations[lockAnnotation]; hasAnnotation && exists {
 		bucket.Status.AtProvider.BucketName = bucketName
+		p.observeUsage(ctx, bucket)
 		bucket.SetConditions(xpv1.Available())
 		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
 	} else if exists {
@@ -2,6 +2,8 @@ package bucketcontroller
 
 import (
 	"context"
+
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/minio/minio-go/v7"
@@ -14,7 +16,8 @@ type ProvisioningPipeline struct {
 	recorder event.Recorder
 	kube     client.Client
 
-	minio *minio.Client
+	minio    *minio.Client
+	csClient *cloudscalesdk.Client
 }
 
 func (p *ProvisioningPipeline) Disconnect(ctx context.Context) error {
@@ -26,12 +29,24 @@ type pipelineContext struct {
 	bucket *cloudscalev1.Bucket
 }
 
+// ParentContext implements tracing.Context.
+func (c *pipelineContext) ParentContext() context.Context {
+	return c.Context
+}
+
+// SetParentContext implements tracing.Context.
+func (c *pipelineContext) SetParentContext(ctx context.Context) {
+	c.Context = ctx
+}
+
 // NewProvisioningPipeline returns a new instance of ProvisioningPipeline.
-func NewProvisioningPipeline(kube client.Client, recorder event.Recorder, minio *minio.Client) *ProvisioningPipeline {
+// The cloudscale client is optional and may be nil.
+func NewProvisioningPipeline(kube client.Client, recorder event.Recorder, minio *minio.Client, csClient *cloudscalesdk.Client) *ProvisioningPipeline {
 	return &ProvisioningPipeline{
 		kube:     kube,
 		recorder: recorder,
 		minio:    minio,
+		csClient: csClient,
 	}
 }
 
@@ -0,0 +1,128 @@
+package bucketcontroller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	providerv1 "github.com/vshn/provider-cloudscale/apis/provider/v1"
+	"golang.org/x/sync/singleflight"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+const (
+	// ProviderConfigTagKey is the tag key of the objects user that the provider manages for a ProviderConfig.
+	// The value is the UID of the ProviderConfig.
+	ProviderConfigTagKey = cloudscalev1.Group + "/provider-config-uid"
+	// CreatedAtTagKey is the tag key of the creation time of the objects user that the provider manages for a ProviderConfig.
+	// It's used to choose the oldest objects user if more than one has been created.
+	CreatedAtTagKey = cloudscalev1.Group + "/created-at"
+)
+
+// providerUserLookupTimeout is the timeout of looking up the objects user that the provider manages for a ProviderConfig.
+// The lookup doesn't inherit the cancellation of the reconcile that started it, since concurrent reconciles wait for the same lookup.
+const providerUserLookupTimeout = 1 * time.Minute
+
+// ReasonDuplicateObjectsUsers is the reason of the warning event emitted for a ProviderConfig that has more than one objects user.
+const ReasonDuplicateObjectsUsers event.Reason = "DuplicateObjectsUsers"
+
+// providerUserLookups prevents that concurrent reconciles of Buckets referencing the same ProviderConfig create more than one objects user.
+// Lookups for different ProviderConfigs aren't blocked by each other.
+var providerUserLookups singleflight.Group
+
+// ensureProviderObjectsUser looks up the objects user that the provider manages for the referenced ProviderConfig, and creates it if it doesn't exist yet.
+// The objects user is cached until the credentials of the ProviderConfig change, or the S3 endpoint rejects its keys.
+// The S3 credentials of the user are stored in the context.
+func (c *bucketConnector) ensureProviderObjectsUser(ctx *connectContext) error {
+	providerConfig := ctx.providerConfig
+
+	key := string(providerConfig.UID)
+	csUser, err := c.providerUsers.Get(key, ctx.credentialsVersion, func() (*cloudscalesdk.ObjectsUser, error) {
+		result, err, _ := providerUserLookups.Do(key, func() (any, error) {
+			lookupCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), providerUserLookupTimeout)
+			defer cancel()
+			return c.lookupProviderObjectsUser(lookupCtx, ctx.csClient, providerConfig)
+		})
+		if err != nil {
+			return nil, err
+		}
+		csUser := result.(*cloudscalesdk.ObjectsUser)
+		if len(csUser.Keys) == 0 || csUser.Keys[0]["access_key"] == "" || csUser.Keys[0]["secret_key"] == "" {
+			return nil, fmt.Errorf("objects user %q for provider config has no S3 keys", csUser.ID)
+		}
+		return csUser, nil
+	})
+	if err != nil {
+		return err
+	}
+	ctx.accessKey = csUser.Keys[0]["access_key"]
+	ctx.secretKey = csUser.Keys[0]["secret_key"]
+	return nil
+}
+
+// lookupProviderObjectsUser returns the objects user that the provider manages for the given ProviderConfig, and creates it if it doesn't exist yet.
+// If more than one objects user exists, e.g. because they have been created by concurrent provider instances, the oldest is used and a warning event is emitted for the ProviderConfig.
+// The other objects users aren't deleted, since they may own buckets.
+func (c *bucketConnector) lookupProviderObjectsUser(ctx context.Context, csClient *cloudscalesdk.Client, providerConfig *providerv1.ProviderConfig) (*cloudscalesdk.ObjectsUser, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+
+	tags := cloudscalesdk.TagMap{ProviderConfigTagKey: string(providerConfig.UID)}
+	csUsers, err := csClient.ObjectsUsers.List(ctx, cloudscalesdk.WithTagFilter(tags))
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot list objects users by provider config tag")
+	}
+
+	if len(csUsers) == 0 {
+		tags[CreatedAtTagKey] = time.Now().UTC().Format(time.RFC3339Nano)
+		csUser, err := csClient.ObjectsUsers.Create(ctx, &cloudscalesdk.ObjectsUserRequest{
+			DisplayName:           fmt.Sprintf("crossplane-provider-%s", providerConfig.Name),
+			TaggedResourceRequest: cloudscalesdk.TaggedResourceRequest{Tags: &tags},
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot create objects user for provider config")
+		}
+		log.Info("Created objects user for provider config", "userID", csUser.ID, "providerConfig", providerConfig.Name)
+		return csUser, nil
+	}
+
+	sortOldestFirst(csUsers)
+	if len(csUsers) > 1 {
+		ids := make([]string, 0, len(csUsers)-1)
+		for _, duplicate := range csUsers[1:] {
+			ids = append(ids, duplicate.ID)
+		}
+		err := fmt.Errorf("found %d objects users tagged with %s=%s, using %q, the others need to be cleaned up manually: %s",
+			len(csUsers), ProviderConfigTagKey, providerConfig.UID, csUsers[0].ID, strings.Join(ids, ", "))
+		log.Info("Found duplicate objects users for provider config", "userID", csUsers[0].ID, "duplicateUserIDs", ids, "providerConfig", providerConfig.Name)
+		c.recorder.Event(providerConfig, event.Warning(ReasonDuplicateObjectsUsers, err))
+	}
+	return &csUsers[0], nil
+}
+
+// sortOldestFirst sorts the given objects users by their creation time tag, and by ID if the creation time is equal.
+// Objects users without creation time tag have been created by earlier versions of the provider and are considered the oldest.
+// The order only depends on the objects users, so that all provider instances choose the same one.
+func sortOldestFirst(csUsers []cloudscalesdk.ObjectsUser) {
+	sort.Slice(csUsers, func(i, j int) bool {
+		createdI, createdJ := createdAt(csUsers[i]), createdAt(csUsers[j])
+		if !createdI.Equal(createdJ) {
+			return createdI.Before(createdJ)
+		}
+		return csUsers[i].ID < csUsers[j].ID
+	})
+}
+
+// createdAt returns the parsed creation time tag of the given objects user, or the zero time if the tag is missing or invalid.
+func createdAt(csUser cloudscalesdk.ObjectsUser) time.Time {
+	created, err := time.Parse(time.RFC3339Nano, csUser.Tags[CreatedAtTagKey])
+	if err != nil {
+		return time.Time{}
+	}
+	return created
+}
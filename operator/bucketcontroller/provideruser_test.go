@@ -0,0 +1,184 @@
+package bucketcontroller
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/go-logr/logr"
+	"github.com/minio/minio-go/v7"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	providerv1 "github.com/vshn/provider-cloudscale/apis/provider/v1"
+	"github.com/vshn/provider-cloudscale/operator/clientcache"
+	"github.com/vshn/provider-cloudscale/operator/operatortest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type fakeObjectsUsersService struct {
+	cloudscalesdk.ObjectsUsersService
+	listCalls    int
+	users        []cloudscalesdk.ObjectsUser
+	createdUser  *cloudscalesdk.ObjectsUser
+	givenQuery   string
+	givenRequest *cloudscalesdk.ObjectsUserRequest
+	deletedIDs   []string
+}
+
+// List implements cloudscalesdk.ObjectsUsersService.
+func (f *fakeObjectsUsersService) List(ctx context.Context, modifiers ...cloudscalesdk.ListRequestModifier) ([]cloudscalesdk.ObjectsUser, error) {
+	req, _ := http.NewRequest(http.MethodGet, "https://api.cloudscale.ch/v1/objects-users", nil)
+	for _, modifier := range modifiers {
+		modifier(req)
+	}
+	f.givenQuery = req.URL.RawQuery
+	f.listCalls++
+	return f.users, ctx.Err()
+}
+
+// Create implements cloudscalesdk.ObjectsUsersService.
+func (f *fakeObjectsUsersService) Create(_ context.Context, createRequest *cloudscalesdk.ObjectsUserRequest) (*cloudscalesdk.ObjectsUser, error) {
+	f.givenRequest = createRequest
+	return f.createdUser, nil
+}
+
+// Delete implements cloudscalesdk.ObjectsUsersService.
+func (f *fakeObjectsUsersService) Delete(_ context.Context, id string) error {
+	f.deletedIDs = append(f.deletedIDs, id)
+	return nil
+}
+
+func TestBucketConnector_ensureProviderObjectsUser(t *testing.T) {
+	keys := []map[string]string{{"access_key": "access", "secret_key": "secret"}}
+	tests := map[string]struct {
+		givenUsers          []cloudscalesdk.ObjectsUser
+		givenCreatedUser    *cloudscalesdk.ObjectsUser
+		expectedDisplayName string
+		expectedAccessKey   string
+		expectedWarning     string
+		expectedError       string
+	}{
+		"GivenNoUser_ThenExpectUserCreated": {
+			givenCreatedUser:    &cloudscalesdk.ObjectsUser{ID: "new", Keys: keys},
+			expectedDisplayName: "crossplane-provider-default",
+			expectedAccessKey:   "access",
+		},
+		"GivenExistingUser_ThenExpectUserReused": {
+			givenUsers:        []cloudscalesdk.ObjectsUser{{ID: "existing", Keys: keys}},
+			expectedAccessKey: "access",
+		},
+		"GivenMultipleUsers_ThenExpectOldestKept": {
+			givenUsers: []cloudscalesdk.ObjectsUser{
+				{ID: "newer", TaggedResource: cloudscalesdk.TaggedResource{Tags: cloudscalesdk.TagMap{CreatedAtTagKey: "2024-01-02T00:00:00Z"}}},
+				{ID: "oldest", Keys: keys, TaggedResource: cloudscalesdk.TaggedResource{Tags: cloudscalesdk.TagMap{CreatedAtTagKey: "2024-01-01T00:00:00Z"}}},
+				{ID: "a-same-time", TaggedResource: cloudscalesdk.TaggedResource{Tags: cloudscalesdk.TagMap{CreatedAtTagKey: "2024-01-02T00:00:00Z"}}},
+			},
+			expectedAccessKey: "access",
+			expectedWarning:   `found 3 objects users tagged with cloudscale.crossplane.io/provider-config-uid=uid, using "oldest", the others need to be cleaned up manually: a-same-time, newer`,
+		},
+		"GivenMultipleUsers_WhenUntagged_ThenExpectUntaggedKept": {
+			givenUsers: []cloudscalesdk.ObjectsUser{
+				{ID: "tagged", TaggedResource: cloudscalesdk.TaggedResource{Tags: cloudscalesdk.TagMap{CreatedAtTagKey: "2024-01-01T00:00:00Z"}}},
+				{ID: "untagged", Keys: keys},
+			},
+			expectedAccessKey: "access",
+			expectedWarning:   `found 2 objects users tagged with cloudscale.crossplane.io/provider-config-uid=uid, using "untagged", the others need to be cleaned up manually: tagged`,
+		},
+		"GivenExistingUser_WhenNoKeys_ThenExpectError": {
+			givenUsers:    []cloudscalesdk.ObjectsUser{{ID: "existing"}},
+			expectedError: `objects user "existing" for provider config has no S3 keys`,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			svc := &fakeObjectsUsersService{users: tc.givenUsers, createdUser: tc.givenCreatedUser}
+			recorder := &operatortest.Recorder{}
+			c := &bucketConnector{recorder: recorder, providerUsers: clientcache.New[*cloudscalesdk.ObjectsUser](time.Hour)}
+			ctx := &connectContext{
+				Context:        logr.NewContext(context.Background(), logr.Discard()),
+				csClient:       &cloudscalesdk.Client{ObjectsUsers: svc},
+				providerConfig: &providerv1.ProviderConfig{ObjectMeta: metav1.ObjectMeta{Name: "default", UID: "uid"}},
+			}
+
+			err := c.ensureProviderObjectsUser(ctx)
+			assert.Equal(t, "tag%3Acloudscale.crossplane.io%2Fprovider-config-uid=uid", svc.givenQuery)
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedAccessKey, ctx.accessKey)
+			assert.Equal(t, "secret", ctx.secretKey)
+			assert.Empty(t, svc.deletedIDs, "objects users may own buckets and must never be deleted")
+			if tc.expectedWarning != "" {
+				require.Len(t, recorder.Events, 1)
+				assert.Equal(t, event.TypeWarning, recorder.Events[0].Type)
+				assert.Equal(t, ReasonDuplicateObjectsUsers, recorder.Events[0].Reason)
+				assert.Equal(t, tc.expectedWarning, recorder.Events[0].Message)
+			} else {
+				assert.Empty(t, recorder.Events)
+			}
+			if tc.expectedDisplayName != "" {
+				require.NotNil(t, svc.givenRequest)
+				assert.Equal(t, tc.expectedDisplayName, svc.givenRequest.DisplayName)
+				assert.Equal(t, "uid", (*svc.givenRequest.Tags)[ProviderConfigTagKey])
+				assert.NotEmpty(t, (*svc.givenRequest.Tags)[CreatedAtTagKey])
+			} else {
+				assert.Nil(t, svc.givenRequest)
+			}
+		})
+	}
+}
+
+func TestBucketConnector_ensureProviderObjectsUser_WhenReconcileCancelled(t *testing.T) {
+	keys := []map[string]string{{"access_key": "access", "secret_key": "secret"}}
+	svc := &fakeObjectsUsersService{users: []cloudscalesdk.ObjectsUser{{ID: "existing", Keys: keys}}}
+	c := &bucketConnector{recorder: &operatortest.Recorder{}, providerUsers: clientcache.New[*cloudscalesdk.ObjectsUser](time.Hour)}
+	cancelled, cancel := context.WithCancel(logr.NewContext(context.Background(), logr.Discard()))
+	cancel()
+	ctx := &connectContext{
+		Context:        cancelled,
+		csClient:       &cloudscalesdk.Client{ObjectsUsers: svc},
+		providerConfig: &providerv1.ProviderConfig{ObjectMeta: metav1.ObjectMeta{Name: "default", UID: "uid"}},
+	}
+
+	err := c.ensureProviderObjectsUser(ctx)
+	require.NoError(t, err, "the lookup is shared between reconciles and must not be cancelled by one of them")
+	assert.Equal(t, "access", ctx.accessKey)
+}
+
+func TestBucketConnector_ensureProviderObjectsUser_Cache(t *testing.T) {
+	keys := []map[string]string{{"access_key": "access", "secret_key": "secret"}}
+	svc := &fakeObjectsUsersService{users: []cloudscalesdk.ObjectsUser{{ID: "existing", Keys: keys}}}
+	c := &bucketConnector{
+		recorder:      &operatortest.Recorder{},
+		s3Clients:     clientcache.New[*minio.Client](time.Hour),
+		providerUsers: clientcache.New[*cloudscalesdk.ObjectsUser](time.Hour),
+	}
+	newCtx := func(credentialsVersion string) *connectContext {
+		return &connectContext{
+			Context:            logr.NewContext(context.Background(), logr.Discard()),
+			bucket:             &cloudscalev1.Bucket{},
+			csClient:           &cloudscalesdk.Client{ObjectsUsers: svc},
+			providerConfig:     &providerv1.ProviderConfig{ObjectMeta: metav1.ObjectMeta{Name: "default", UID: "uid"}},
+			credentialsVersion: credentialsVersion,
+		}
+	}
+
+	require.NoError(t, c.ensureProviderObjectsUser(newCtx("1")))
+	require.NoError(t, c.ensureProviderObjectsUser(newCtx("1")))
+	assert.Equal(t, 1, svc.listCalls, "objects user should be cached")
+
+	require.NoError(t, c.ensureProviderObjectsUser(newCtx("2")))
+	assert.Equal(t, 2, svc.listCalls, "objects user should be looked up again after the credentials changed")
+
+	ctx := newCtx("2")
+	c.evictS3Credentials(ctx)
+	require.NoError(t, c.ensureProviderObjectsUser(ctx))
+	assert.Equal(t, 3, svc.listCalls, "objects user should be looked up again after the S3 credentials have been rejected")
+	assert.Equal(t, "access", ctx.accessKey)
+}
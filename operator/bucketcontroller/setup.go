@@ -4,36 +4,52 @@ import (
 	"strings"
 	"time"
 
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/minio/minio-go/v7"
 	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/clientcache"
+	"github.com/vshn/provider-cloudscale/operator/controlleropts"
+	"github.com/vshn/provider-cloudscale/operator/ratelimit"
+	"github.com/vshn/provider-cloudscale/operator/tracing"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 )
 
+// DefaultPollInterval is the default interval in which buckets are observed again.
+// Buckets are rather static.
+const DefaultPollInterval = 1 * time.Hour
+
 // SetupController adds a controller that reconciles cloudscalev1.Bucket managed resources.
-func SetupController(mgr ctrl.Manager) error {
+func SetupController(mgr ctrl.Manager, opts controlleropts.Options) error {
 	name := managed.ControllerName(cloudscalev1.BucketGroupKind)
 
-	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
 	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+	throttler := ratelimit.NewThrottler()
 
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(cloudscalev1.BucketGroupVersionKind),
-		managed.WithExternalConnecter(&bucketConnector{
-			kube:     mgr.GetClient(),
-			recorder: recorder,
-		}),
+		managed.WithExternalConnecter(throttler.WrapConnecter(&bucketConnector{
+			kube:          mgr.GetClient(),
+			recorder:      recorder,
+			s3Clients:     clientcache.New[*minio.Client](clientcache.DefaultMaxIdle),
+			csClients:     clientcache.New[*cloudscalesdk.Client](clientcache.DefaultMaxIdle),
+			providerUsers: clientcache.New[*cloudscalesdk.ObjectsUser](clientcache.DefaultMaxIdle),
+		})),
 		managed.WithLogger(logging.NewLogrLogger(mgr.GetLogger().WithValues("controller", name))),
 		managed.WithRecorder(recorder),
-		managed.WithPollInterval(1*time.Hour), // buckets are rather static
-		managed.WithConnectionPublishers(cps...))
+		managed.WithPollIntervalHook(opts.PollIntervalHook(nil)),
+		managed.WithPollInterval(opts.PollInterval),
+		managed.WithConnectionPublishers(opts.ConnectionPublishers(mgr)...))
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		For(&cloudscalev1.Bucket{}).
-		Complete(r)
+		For(&cloudscalev1.Bucket{}, builder.WithPredicates(opts.Predicates...)).
+		WithOptions(opts.ForControllerRuntime()).
+		Complete(tracing.NewReconciler(cloudscalev1.BucketKind, throttler.WrapReconciler(r)))
 }
 
 // SetupWebhook adds a webhook for Bucket managed resources.
@@ -54,6 +70,8 @@ func SetupWebhook(mgr ctrl.Manager) error {
 		For(&cloudscalev1.Bucket{}).
 		WithValidator(&BucketValidator{
 			log: mgr.GetLogger().WithName("webhook").WithName(strings.ToLower(cloudscalev1.BucketKind)),
+			// The cache only contains the Catalogs of the shard, all shards have to admit the same Buckets.
+			kube: mgr.GetAPIReader(),
 		}).
 		Complete()
 }
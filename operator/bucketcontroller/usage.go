@@ -0,0 +1,104 @@
+package bucketcontroller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/prometheus/client_golang/prometheus"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// usageSampleInterval is the minimum duration between fetching the usage of the same bucket.
+// The metrics API of cloudscale.ch aggregates usage in daily intervals, there's no need to query it more often.
+const usageSampleInterval = 1 * time.Hour
+
+var (
+	bucketStorageBytes = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "cloudscale",
+		Subsystem: "bucket",
+		Name:      "storage_bytes",
+		Help:      "Amount of bytes stored in the bucket as reported by the cloudscale.ch metrics API.",
+	}, []string{"bucket", "bucket_name", "region"})
+	bucketObjects = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "cloudscale",
+		Subsystem: "bucket",
+		Name:      "objects",
+		Help:      "Number of objects stored in the bucket as reported by the cloudscale.ch metrics API.",
+	}, []string{"bucket", "bucket_name", "region"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(bucketStorageBytes, bucketObjects)
+}
+
+var getBucketMetricsFn = func(ctx context.Context, csClient *cloudscalesdk.Client, req *cloudscalesdk.BucketMetricsRequest) (*cloudscalesdk.BucketMetrics, error) {
+	return csClient.Metrics.GetBucketMetrics(ctx, req)
+}
+
+// observeUsage fetches the usage of the bucket from the metrics API and stores it in the status.
+// The usage is fetched at most once per usageSampleInterval.
+// Errors are only logged, since the usage is informational and shouldn't prevent the bucket from becoming ready.
+func (p *ProvisioningPipeline) observeUsage(ctx context.Context, bucket *cloudscalev1.Bucket) {
+	log := controllerruntime.LoggerFrom(ctx)
+	if p.csClient == nil {
+		return
+	}
+	now := time.Now()
+	if usage := bucket.Status.AtProvider.Usage; usage != nil && now.Sub(usage.LastSampled.Time) < usageSampleInterval {
+		setUsageMetrics(bucket)
+		return
+	}
+
+	bucketName := bucket.Status.AtProvider.BucketName
+	result, err := getBucketMetricsFn(ctx, p.csClient, &cloudscalesdk.BucketMetricsRequest{
+		Start:       now.AddDate(0, 0, -1),
+		End:         now,
+		BucketNames: []string{bucketName},
+	})
+	if err != nil {
+		log.V(1).Info("Cannot fetch bucket usage", "error", err.Error())
+		return
+	}
+	usage, err := toBucketUsage(result, bucketName)
+	if err != nil {
+		log.V(1).Info("Cannot determine bucket usage", "error", err.Error())
+		return
+	}
+	usage.LastSampled = metav1.NewTime(now)
+	bucket.Status.AtProvider.Usage = usage
+	setUsageMetrics(bucket)
+	log.V(1).Info("Fetched bucket usage", "storageBytes", usage.StorageBytes, "objectCount", usage.ObjectCount)
+}
+
+// toBucketUsage returns the usage of the most recent interval of the given bucket.
+func toBucketUsage(result *cloudscalesdk.BucketMetrics, bucketName string) (*cloudscalev1.BucketUsage, error) {
+	for _, data := range result.Data {
+		if data.Subject.BucketName != bucketName || len(data.TimeSeries) == 0 {
+			continue
+		}
+		latest := data.TimeSeries[len(data.TimeSeries)-1]
+		return &cloudscalev1.BucketUsage{
+			StorageBytes: int64(latest.Usage.StorageBytes),
+			ObjectCount:  int64(latest.Usage.ObjectCount),
+		}, nil
+	}
+	return nil, fmt.Errorf("no metrics available for bucket %q", bucketName)
+}
+
+func setUsageMetrics(bucket *cloudscalev1.Bucket) {
+	usage := bucket.Status.AtProvider.Usage
+	labels := prometheus.Labels{"bucket": bucket.Name, "bucket_name": bucket.Status.AtProvider.BucketName, "region": bucket.Spec.ForProvider.Region}
+	bucketStorageBytes.With(labels).Set(float64(usage.StorageBytes))
+	bucketObjects.With(labels).Set(float64(usage.ObjectCount))
+}
+
+func deleteUsageMetrics(bucket *cloudscalev1.Bucket) {
+	labels := prometheus.Labels{"bucket": bucket.Name}
+	bucketStorageBytes.DeletePartialMatch(labels)
+	bucketObjects.DeletePartialMatch(labels)
+}
@@ -0,0 +1,114 @@
+package bucketcontroller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_toBucketUsage(t *testing.T) {
+	tests := map[string]struct {
+		givenData     []cloudscalesdk.BucketMetricsData
+		expectedUsage *cloudscalev1.BucketUsage
+		expectedError string
+	}{
+		"GivenNoData_ThenExpectError": {
+			expectedError: `no metrics available for bucket "my-bucket"`,
+		},
+		"GivenOtherBucket_ThenExpectError": {
+			givenData: []cloudscalesdk.BucketMetricsData{
+				{Subject: cloudscalesdk.BucketMetricsDataSubject{BucketName: "other"}, TimeSeries: []cloudscalesdk.BucketMetricsInterval{
+					{Usage: cloudscalesdk.BucketMetricsIntervalUsage{ObjectCount: 1, StorageBytes: 2}},
+				}},
+			},
+			expectedError: `no metrics available for bucket "my-bucket"`,
+		},
+		"GivenMultipleIntervals_ThenExpectLatest": {
+			givenData: []cloudscalesdk.BucketMetricsData{
+				{Subject: cloudscalesdk.BucketMetricsDataSubject{BucketName: "my-bucket"}, TimeSeries: []cloudscalesdk.BucketMetricsInterval{
+					{Usage: cloudscalesdk.BucketMetricsIntervalUsage{ObjectCount: 1, StorageBytes: 2}},
+					{Usage: cloudscalesdk.BucketMetricsIntervalUsage{ObjectCount: 3, StorageBytes: 4}},
+				}},
+			},
+			expectedUsage: &cloudscalev1.BucketUsage{ObjectCount: 3, StorageBytes: 4},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, err := toBucketUsage(&cloudscalesdk.BucketMetrics{Data: tc.givenData}, "my-bucket")
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedUsage, result)
+		})
+	}
+}
+
+func TestProvisioningPipeline_observeUsage(t *testing.T) {
+	recently := metav1.NewTime(time.Now().Add(-1 * time.Minute))
+	longAgo := metav1.NewTime(time.Now().Add(-2 * usageSampleInterval))
+	tests := map[string]struct {
+		givenClient   *cloudscalesdk.Client
+		givenUsage    *cloudscalev1.BucketUsage
+		expectFetch   bool
+		expectedCount int64
+	}{
+		"GivenNoClient_ThenExpectNoUsage": {},
+		"GivenClient_WhenNoUsage_ThenExpectFetch": {
+			givenClient:   &cloudscalesdk.Client{},
+			expectFetch:   true,
+			expectedCount: 10,
+		},
+		"GivenClient_WhenRecentlySampled_ThenExpectNoFetch": {
+			givenClient:   &cloudscalesdk.Client{},
+			givenUsage:    &cloudscalev1.BucketUsage{ObjectCount: 5, LastSampled: recently},
+			expectedCount: 5,
+		},
+		"GivenClient_WhenSampledLongAgo_ThenExpectFetch": {
+			givenClient:   &cloudscalesdk.Client{},
+			givenUsage:    &cloudscalev1.BucketUsage{ObjectCount: 5, LastSampled: longAgo},
+			expectFetch:   true,
+			expectedCount: 10,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			currFn := getBucketMetricsFn
+			defer func() {
+				getBucketMetricsFn = currFn
+			}()
+			fetched := false
+			getBucketMetricsFn = func(ctx context.Context, csClient *cloudscalesdk.Client, req *cloudscalesdk.BucketMetricsRequest) (*cloudscalesdk.BucketMetrics, error) {
+				fetched = true
+				return &cloudscalesdk.BucketMetrics{Data: []cloudscalesdk.BucketMetricsData{
+					{Subject: cloudscalesdk.BucketMetricsDataSubject{BucketName: "my-bucket"}, TimeSeries: []cloudscalesdk.BucketMetricsInterval{
+						{Usage: cloudscalesdk.BucketMetricsIntervalUsage{ObjectCount: 10}},
+					}},
+				}}, nil
+			}
+			bucket := &cloudscalev1.Bucket{Status: cloudscalev1.BucketStatus{AtProvider: cloudscalev1.BucketObservation{
+				BucketName: "my-bucket",
+				Usage:      tc.givenUsage,
+			}}}
+
+			p := ProvisioningPipeline{csClient: tc.givenClient}
+			p.observeUsage(logr.NewContext(context.Background(), logr.Discard()), bucket)
+
+			assert.Equal(t, tc.expectFetch, fetched)
+			if tc.givenClient == nil {
+				assert.Nil(t, bucket.Status.AtProvider.Usage)
+				return
+			}
+			assert.Equal(t, tc.expectedCount, bucket.Status.AtProvider.Usage.ObjectCount)
+		})
+	}
+}
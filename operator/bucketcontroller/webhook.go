@@ -3,28 +3,33 @@ package bucketcontroller
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
 	"github.com/go-logr/logr"
 	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
 // BucketValidator validates admission requests.
 type BucketValidator struct {
-	log logr.Logger
+	log  logr.Logger
+	kube client.Reader
 }
 
 // ValidateCreate implements admission.CustomValidator.
-func (v *BucketValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+func (v *BucketValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
 	res := obj.(*cloudscalev1.Bucket)
-	v.log.V(1).Info("Validate create (noop)", "name", res.Name)
+	v.log.V(1).Info("Validate create", "name", res.Name)
 	// EndpointURL and Region are required by the API schema, no need to check.
-	return nil, nil
+	return nil, v.validateRegion(ctx, res)
 }
 
 // ValidateUpdate implements admission.CustomValidator.
-func (v *BucketValidator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+func (v *BucketValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
 	newBucket := newObj.(*cloudscalev1.Bucket)
 	oldBucket := oldObj.(*cloudscalev1.Bucket)
 	v.log.V(1).Info("Validate update")
@@ -38,10 +43,28 @@ func (v *BucketValidator) ValidateUpdate(_ context.Context, oldObj, newObj runti
 			return nil, fmt.Errorf("a bucket named %q has been created already, you cannot change the region",
 				oldBucket.Status.AtProvider.BucketName)
 		}
+		return nil, nil
+	}
+	if newBucket.Spec.ForProvider.Region != oldBucket.Spec.ForProvider.Region {
+		return nil, v.validateRegion(ctx, newBucket)
 	}
 	return nil, nil
 }
 
+// validateRegion returns an error if the region of the bucket isn't one of the regions observed by the Catalogs.
+// Without an observed Catalog, any region is accepted.
+func (v *BucketValidator) validateRegion(ctx context.Context, bucket *cloudscalev1.Bucket) error {
+	catalogs := &cloudscalev1.CatalogList{}
+	if err := v.kube.List(ctx, catalogs); err != nil {
+		return errors.Wrap(err, "cannot list catalogs")
+	}
+	regions := catalogs.RegionSlugs()
+	if len(regions) == 0 || slices.Contains(regions, bucket.Spec.ForProvider.Region) {
+		return nil
+	}
+	return fmt.Errorf("region %q isn't offered by cloudscale.ch, use one of: %s", bucket.Spec.ForProvider.Region, strings.Join(regions, ", "))
+}
+
 // ValidateDelete implements admission.CustomValidator.
 func (v *BucketValidator) ValidateDelete(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
 	res := obj.(*cloudscalev1.Bucket)
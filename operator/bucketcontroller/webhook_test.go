@@ -9,6 +9,9 @@ import (
 	"github.com/stretchr/testify/require"
 	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 func TestBucketValidator_ValidateUpdate_PreventBucketNameChange(t *testing.T) {
@@ -99,3 +102,50 @@ func TestBucketValidator_ValidateUpdate_PreventRegionChange(t *testing.T) {
 		})
 	}
 }
+
+func TestBucketValidator_ValidateCreate_Region(t *testing.T) {
+	tests := map[string]struct {
+		givenCatalogs []client.Object
+		givenRegion   string
+		expectedError string
+	}{
+		"GivenNoCatalog_ThenExpectNil": {
+			givenRegion: "anywhere",
+		},
+		"GivenCatalog_WhenRegionOffered_ThenExpectNil": {
+			givenCatalogs: []client.Object{newCatalog("cloudscale", "rma", "lpg")},
+			givenRegion:   "lpg",
+		},
+		"GivenCatalogs_WhenRegionNotOffered_ThenExpectError": {
+			givenCatalogs: []client.Object{newCatalog("cloudscale", "rma"), newCatalog("other", "lpg", "rma")},
+			givenRegion:   "zrh",
+			expectedError: `region "zrh" isn't offered by cloudscale.ch, use one of: lpg, rma`,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			require.NoError(t, cloudscalev1.SchemeBuilder.AddToScheme(scheme))
+			kube := fake.NewClientBuilder().WithScheme(scheme).WithObjects(tc.givenCatalogs...).Build()
+			bucket := &cloudscalev1.Bucket{
+				ObjectMeta: metav1.ObjectMeta{Name: "bucket"},
+				Spec:       cloudscalev1.BucketSpec{ForProvider: cloudscalev1.BucketParameters{Region: tc.givenRegion}},
+			}
+			v := &BucketValidator{log: logr.Discard(), kube: kube}
+			_, err := v.ValidateCreate(context.TODO(), bucket)
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func newCatalog(name string, regions ...string) *cloudscalev1.Catalog {
+	catalog := &cloudscalev1.Catalog{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	for _, region := range regions {
+		catalog.Status.AtProvider.Regions = append(catalog.Status.AtProvider.Regions, cloudscalev1.CatalogRegion{Slug: region})
+	}
+	return catalog
+}
@@ -0,0 +1,28 @@
+package catalogcontroller
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+type catalogConnector struct {
+	connector *cloudscaleclient.Connector
+}
+
+// Connect implements managed.ExternalConnecter.
+func (c *catalogConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.V(1).Info("Connecting resource")
+
+	catalog := fromManaged(mg)
+	csClient, providerConfig, err := c.connector.Connect(ctx, catalog)
+	if err != nil {
+		return nil, err
+	}
+	catalog.Status.AtProvider.Project = providerConfig.Spec.Project
+	return NewPipeline(cloudscaleclient.NewCatalogService(csClient)), nil
+}
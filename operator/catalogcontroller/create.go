@@ -0,0 +1,14 @@
+package catalogcontroller
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// Create implements managed.ExternalClient.
+// It's never called, since Observe always reports the catalog as existing.
+func (p *CatalogPipeline) Create(_ context.Context, _ resource.Managed) (managed.ExternalCreation, error) {
+	return managed.ExternalCreation{}, nil
+}
@@ -0,0 +1,18 @@
+package catalogcontroller
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Delete implements managed.ExternalClient.
+// It's never called, since Observe reports a deleted catalog as not existing.
+// Deleting a catalog leaves cloudscale.ch untouched.
+func (p *CatalogPipeline) Delete(ctx context.Context, _ resource.Managed) (managed.ExternalDelete, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.Info("Deleting resource")
+	return managed.ExternalDelete{}, nil
+}
@@ -0,0 +1,102 @@
+package catalogcontroller
+
+import (
+	"context"
+
+	pipeline "github.com/ccremer/go-command-pipeline"
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	"github.com/vshn/provider-cloudscale/operator/pipelineutil"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Observe implements managed.ExternalClient.
+// The catalog always exists and is up-to-date, its status is refreshed from cloudscale.ch.
+// A deleted catalog is reported as not existing, so that its finalizer is removed without calling Delete.
+func (p *CatalogPipeline) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.V(1).Info("Observing resource")
+
+	catalog := fromManaged(mg)
+	if meta.WasDeleted(catalog) {
+		return managed.ExternalObservation{}, nil
+	}
+	pctx := &pipelineContext{Context: ctx, catalog: catalog}
+	pipe := pipeline.NewPipeline[*pipelineContext]()
+	stepMetrics := pipelineutil.NewStepMetrics[*pipelineContext](cloudscalev1.CatalogKind)
+	pipe.WithBeforeHooks(pipelineutil.DebugLogger(pctx)).
+		WithSteps(stepMetrics.Steps(
+			pipe.NewStep("list regions", p.listRegions),
+			pipe.NewStep("list flavors", p.listFlavors),
+			pipe.NewStep("list images", p.listImages),
+		)...)
+	err := pipe.RunWithContext(pctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, "cannot observe catalog")
+	}
+
+	catalog.Status.AtProvider = toObservation(pctx.csRegions, pctx.csFlavors, pctx.csImages, catalog.Status.AtProvider.Project)
+	catalog.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+}
+
+func (p *CatalogPipeline) listRegions(ctx *pipelineContext) error {
+	csRegions, err := p.catalog.ListRegions(ctx)
+	ctx.csRegions = csRegions
+	return err
+}
+
+func (p *CatalogPipeline) listFlavors(ctx *pipelineContext) error {
+	csFlavors, err := p.catalog.ListFlavors(ctx)
+	ctx.csFlavors = csFlavors
+	return err
+}
+
+func (p *CatalogPipeline) listImages(ctx *pipelineContext) error {
+	csImages, err := p.catalog.ListImages(ctx)
+	ctx.csImages = csImages
+	return err
+}
+
+// toObservation returns the observed regions, zones, flavors and images in the order given by cloudscale.ch.
+func toObservation(csRegions []cloudscalesdk.Region, csFlavors []cloudscaleclient.Flavor, csImages []cloudscaleclient.Image, project string) cloudscalev1.CatalogObservation {
+	obs := cloudscalev1.CatalogObservation{Project: project}
+	for _, csRegion := range csRegions {
+		zones := zoneSlugs(csRegion.Zones)
+		obs.Regions = append(obs.Regions, cloudscalev1.CatalogRegion{Slug: csRegion.Slug, Zones: zones})
+		obs.Zones = append(obs.Zones, zones...)
+	}
+	for _, csFlavor := range csFlavors {
+		obs.Flavors = append(obs.Flavors, cloudscalev1.CatalogFlavor{
+			Slug:      csFlavor.Slug,
+			Name:      csFlavor.Name,
+			VCPUCount: csFlavor.VCPUCount,
+			MemoryGB:  csFlavor.MemoryGB,
+			Zones:     zoneSlugs(csFlavor.Zones),
+		})
+	}
+	for _, csImage := range csImages {
+		obs.Images = append(obs.Images, cloudscalev1.CatalogImage{
+			Slug:            csImage.Slug,
+			Name:            csImage.Name,
+			OperatingSystem: csImage.OperatingSystem,
+			DefaultUsername: csImage.DefaultUsername,
+			Zones:           zoneSlugs(csImage.Zones),
+		})
+	}
+	return obs
+}
+
+func zoneSlugs(csZones []cloudscalesdk.Zone) []string {
+	var slugs []string
+	for _, zone := range csZones {
+		slugs = append(slugs, zone.Slug)
+	}
+	return slugs
+}
@@ -0,0 +1,110 @@
+package catalogcontroller
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type fakeCatalogService struct {
+	regions   []cloudscalesdk.Region
+	flavors   []cloudscaleclient.Flavor
+	images    []cloudscaleclient.Image
+	imagesErr error
+}
+
+// ListRegions implements cloudscaleclient.CatalogService.
+func (f *fakeCatalogService) ListRegions(_ context.Context) ([]cloudscalesdk.Region, error) {
+	return f.regions, nil
+}
+
+// ListFlavors implements cloudscaleclient.CatalogService.
+func (f *fakeCatalogService) ListFlavors(_ context.Context) ([]cloudscaleclient.Flavor, error) {
+	return f.flavors, nil
+}
+
+// ListImages implements cloudscaleclient.CatalogService.
+func (f *fakeCatalogService) ListImages(_ context.Context) ([]cloudscaleclient.Image, error) {
+	return f.images, f.imagesErr
+}
+
+func TestCatalogPipeline_Observe(t *testing.T) {
+	tests := map[string]struct {
+		givenService *fakeCatalogService
+		givenDeleted bool
+
+		expectedError       string
+		expectedResult      managed.ExternalObservation
+		expectedReadyStatus corev1.ConditionStatus
+		expectedObservation cloudscalev1.CatalogObservation
+	}{
+		"GivenOffering_ThenExpectAvailableWithOffering": {
+			givenService: &fakeCatalogService{
+				regions: []cloudscalesdk.Region{
+					{Slug: "rma", Zones: []cloudscalesdk.Zone{{Slug: "rma1"}}},
+					{Slug: "lpg", Zones: []cloudscalesdk.Zone{{Slug: "lpg1"}}},
+				},
+				flavors: []cloudscaleclient.Flavor{
+					{Slug: "flex-4-2", Name: "Flex-4-2", VCPUCount: 2, MemoryGB: 4, Zones: []cloudscalesdk.Zone{{Slug: "rma1"}, {Slug: "lpg1"}}},
+				},
+				images: []cloudscaleclient.Image{
+					{Slug: "debian-12", Name: "Debian 12", OperatingSystem: "Debian", DefaultUsername: "debian", Zones: []cloudscalesdk.Zone{{Slug: "rma1"}}},
+				},
+			},
+			expectedResult:      managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			expectedReadyStatus: corev1.ConditionTrue,
+			expectedObservation: cloudscalev1.CatalogObservation{
+				Regions: []cloudscalev1.CatalogRegion{{Slug: "rma", Zones: []string{"rma1"}}, {Slug: "lpg", Zones: []string{"lpg1"}}},
+				Zones:   []string{"rma1", "lpg1"},
+				Flavors: []cloudscalev1.CatalogFlavor{{Slug: "flex-4-2", Name: "Flex-4-2", VCPUCount: 2, MemoryGB: 4, Zones: []string{"rma1", "lpg1"}}},
+				Images:  []cloudscalev1.CatalogImage{{Slug: "debian-12", Name: "Debian 12", OperatingSystem: "Debian", DefaultUsername: "debian", Zones: []string{"rma1"}}},
+				Project: "project",
+			},
+		},
+		"GivenDeletedCatalog_ThenExpectNotExisting": {
+			givenService:        &fakeCatalogService{imagesErr: errors.New("unavailable")},
+			givenDeleted:        true,
+			expectedResult:      managed.ExternalObservation{},
+			expectedReadyStatus: corev1.ConditionUnknown,
+			expectedObservation: cloudscalev1.CatalogObservation{Project: "project"},
+		},
+		"GivenAPIError_ThenExpectError": {
+			givenService:        &fakeCatalogService{imagesErr: errors.New("unavailable")},
+			expectedError:       "cannot observe catalog: step 'list images' failed: unavailable",
+			expectedReadyStatus: corev1.ConditionUnknown,
+			expectedObservation: cloudscalev1.CatalogObservation{Project: "project"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			p := NewPipeline(tc.givenService)
+			catalog := &cloudscalev1.Catalog{ObjectMeta: metav1.ObjectMeta{Name: "cloudscale"}}
+			catalog.Status.AtProvider.Project = "project"
+			if tc.givenDeleted {
+				catalog.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+			}
+
+			result, err := p.Observe(logr.NewContext(context.Background(), logr.Discard()), catalog)
+			if tc.expectedError != "" {
+				require.EqualError(t, err, tc.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectedResult, result)
+			assert.Equal(t, tc.expectedReadyStatus, catalog.GetCondition(xpv1.TypeReady).Status)
+			assert.Equal(t, tc.expectedObservation, catalog.Status.AtProvider)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package catalogcontroller
+
+import (
+	"context"
+
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+)
+
+// CatalogPipeline observes the offering of cloudscale.ch for Catalogs.
+// Catalogs don't exist in cloudscale.ch, so there is nothing to create, update or delete.
+type CatalogPipeline struct {
+	catalog cloudscaleclient.CatalogService
+}
+
+type pipelineContext struct {
+	context.Context
+	catalog   *cloudscalev1.Catalog
+	csRegions []cloudscalesdk.Region
+	csFlavors []cloudscaleclient.Flavor
+	csImages  []cloudscaleclient.Image
+}
+
+// ParentContext implements tracing.Context.
+func (c *pipelineContext) ParentContext() context.Context {
+	return c.Context
+}
+
+// SetParentContext implements tracing.Context.
+func (c *pipelineContext) SetParentContext(ctx context.Context) {
+	c.Context = ctx
+}
+
+// NewPipeline returns a new instance of CatalogPipeline.
+func NewPipeline(catalog cloudscaleclient.CatalogService) *CatalogPipeline {
+	return &CatalogPipeline{
+		catalog: catalog,
+	}
+}
+
+// Disconnect implements managed.ExternalClient.
+func (p *CatalogPipeline) Disconnect(_ context.Context) error {
+	return nil
+}
+
+func fromManaged(mg resource.Managed) *cloudscalev1.Catalog {
+	return mg.(*cloudscalev1.Catalog)
+}
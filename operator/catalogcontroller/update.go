@@ -0,0 +1,14 @@
+package catalogcontroller
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// Update implements managed.ExternalClient.
+// It's never called, since Observe always reports the catalog as up-to-date.
+func (p *CatalogPipeline) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
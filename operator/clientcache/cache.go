@@ -0,0 +1,92 @@
+package clientcache
+
+import (
+	"sync"
+	"time"
+)
+
+// DefaultMaxIdle is the duration after which clients that haven't been used are evicted.
+// It is longer than the poll interval of the controllers, so that clients of resources that are only polled survive between reconciles.
+const DefaultMaxIdle = 2 * time.Hour
+
+// Cache holds API clients by key, for as long as the version of the credentials they have been built with doesn't change.
+//
+// Clients are never closed by the cache.
+// When a client is evicted, because its credentials changed or it hasn't been used for a while, the cache only drops its reference.
+// Reconciles that still hold the client can finish using it.
+type Cache[T any] struct {
+	mu        sync.Mutex
+	entries   map[string]*entry[T]
+	maxIdle   time.Duration
+	now       func() time.Time
+	lastPrune time.Time
+}
+
+type entry[T any] struct {
+	version  string
+	client   T
+	lastUsed time.Time
+}
+
+// New returns a new, empty cache that evicts clients that haven't been used for the given duration.
+func New[T any](maxIdle time.Duration) *Cache[T] {
+	return &Cache[T]{
+		entries: map[string]*entry[T]{},
+		maxIdle: maxIdle,
+		now:     time.Now,
+	}
+}
+
+// Get returns the client stored for the given key if it has been built with credentials of the given version.
+// Otherwise, a new client is built with newFn and replaces the stale client.
+// Errors returned by newFn are returned as-is and leave the cache unchanged.
+func (c *Cache[T]) Get(key, version string, newFn func() (T, error)) (T, error) {
+	c.mu.Lock()
+	c.prune()
+	if e, found := c.entries[key]; found && e.version == version {
+		e.lastUsed = c.now()
+		c.mu.Unlock()
+		return e.client, nil
+	}
+	c.mu.Unlock()
+
+	// Building a client may involve API calls, so we don't want to block other keys meanwhile.
+	client, err := newFn()
+	if err != nil {
+		return client, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = &entry[T]{version: version, client: client, lastUsed: c.now()}
+	return client, nil
+}
+
+// Delete evicts the client stored for the given key.
+func (c *Cache[T]) Delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, key)
+}
+
+// Len returns the number of cached clients.
+func (c *Cache[T]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries)
+}
+
+// prune evicts the clients that haven't been used within maxIdle.
+// It runs at most once per maxIdle, and must be called with the lock held.
+func (c *Cache[T]) prune() {
+	now := c.now()
+	if now.Sub(c.lastPrune) < c.maxIdle {
+		return
+	}
+	c.lastPrune = now
+	for key, e := range c.entries {
+		if now.Sub(e.lastUsed) >= c.maxIdle {
+			delete(c.entries, key)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package clientcache
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCache_Get(t *testing.T) {
+	c := New[string](time.Hour)
+	builds := 0
+	newFn := func(client string) func() (string, error) {
+		return func() (string, error) {
+			builds++
+			return client, nil
+		}
+	}
+
+	client, err := c.Get("key", "1", newFn("first"))
+	require.NoError(t, err)
+	assert.Equal(t, "first", client)
+
+	client, err = c.Get("key", "1", newFn("second"))
+	require.NoError(t, err)
+	assert.Equal(t, "first", client, "client with same version should be reused")
+
+	client, err = c.Get("key", "2", newFn("third"))
+	require.NoError(t, err)
+	assert.Equal(t, "third", client, "client with changed version should be replaced")
+
+	_, err = c.Get("key", "3", func() (string, error) { return "", errors.New("failed") })
+	assert.EqualError(t, err, "failed")
+	client, err = c.Get("key", "2", newFn("fourth"))
+	require.NoError(t, err)
+	assert.Equal(t, "third", client, "failed build should not evict client")
+
+	assert.Equal(t, 2, builds)
+	assert.Equal(t, 1, c.Len())
+}
+
+func TestCache_Prune(t *testing.T) {
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := New[string](time.Hour)
+	c.now = func() time.Time { return now }
+	newFn := func() (string, error) { return "client", nil }
+
+	_, _ = c.Get("idle", "1", newFn)
+	now = now.Add(50 * time.Minute)
+	_, _ = c.Get("active", "1", newFn)
+	assert.Equal(t, 2, c.Len())
+
+	now = now.Add(20 * time.Minute)
+	_, _ = c.Get("active", "1", newFn)
+	assert.Equal(t, 1, c.Len(), "idle client should be evicted")
+
+	c.Delete("active")
+	assert.Equal(t, 0, c.Len())
+}
@@ -0,0 +1,24 @@
+package cloudscaleclient
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SetIDAnnotation stores the ID of a created or adopted cloudscale.ch resource in the annotation with the given key.
+//
+// Limitation by crossplane: The interface managed.ExternalClient doesn't allow updating the resource during creation except annotations.
+// But we need to somehow store the ID returned by the creation operation, because the cloudscale.ch API allows multiple resources with the same name.
+// So we store it in an annotation since that is the only allowed place to update our resource.
+// However, once we observe the resource again, we copy the ID from the annotation to the status field with IDFromAnnotation,
+// and that becomes the authoritative source of truth for future reconciliations.
+// Should the annotation get lost, the owner tag allows us to find the cloudscale.ch resource again.
+func SetIDAnnotation(obj *metav1.ObjectMeta, key, id string) {
+	metav1.SetMetaDataAnnotation(obj, key, id)
+}
+
+// IDFromAnnotation returns the ID stored with SetIDAnnotation in the annotation with the given key.
+// It returns false if the annotation doesn't exist, e.g. because the cloudscale.ch resource hasn't been created yet.
+func IDFromAnnotation(obj metav1.Object, key string) (string, bool) {
+	id, exists := obj.GetAnnotations()[key]
+	return id, exists
+}
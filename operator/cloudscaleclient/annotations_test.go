@@ -0,0 +1,20 @@
+package cloudscaleclient
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestIDAnnotation(t *testing.T) {
+	obj := &metav1.ObjectMeta{}
+
+	_, exists := IDFromAnnotation(obj, "cloudscale.crossplane.io/uuid")
+	assert.False(t, exists)
+
+	SetIDAnnotation(obj, "cloudscale.crossplane.io/uuid", "uuid")
+	id, exists := IDFromAnnotation(obj, "cloudscale.crossplane.io/uuid")
+	assert.True(t, exists)
+	assert.Equal(t, "uuid", id)
+}
@@ -0,0 +1,65 @@
+package cloudscaleclient
+
+import (
+	"context"
+
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+)
+
+const (
+	flavorBasePath = "v1/flavors"
+	imageBasePath  = "v1/images"
+)
+
+// Flavor is a server flavor offered by cloudscale.ch.
+type Flavor struct {
+	Slug      string               `json:"slug"`
+	Name      string               `json:"name"`
+	VCPUCount int                  `json:"vcpu_count"`
+	MemoryGB  int                  `json:"memory_gb"`
+	Zones     []cloudscalesdk.Zone `json:"zones"`
+}
+
+// Image is a public image offered by cloudscale.ch.
+type Image struct {
+	Slug            string               `json:"slug"`
+	Name            string               `json:"name"`
+	OperatingSystem string               `json:"operating_system"`
+	DefaultUsername string               `json:"default_username"`
+	Zones           []cloudscalesdk.Zone `json:"zones"`
+}
+
+// CatalogService lists the regions, flavors and public images offered by cloudscale.ch.
+// The cloudscale.ch SDK only supports regions.
+type CatalogService interface {
+	ListRegions(ctx context.Context) ([]cloudscalesdk.Region, error)
+	ListFlavors(ctx context.Context) ([]Flavor, error)
+	ListImages(ctx context.Context) ([]Image, error)
+}
+
+// NewCatalogService returns a CatalogService that sends requests with the given client, including its rate limiting and metrics.
+func NewCatalogService(client *cloudscalesdk.Client) CatalogService {
+	return catalogServiceOperations{
+		regions: client.Regions,
+		flavors: restClient[Flavor]{client: client, basePath: flavorBasePath},
+		images:  restClient[Image]{client: client, basePath: imageBasePath},
+	}
+}
+
+type catalogServiceOperations struct {
+	regions cloudscalesdk.RegionService
+	flavors restClient[Flavor]
+	images  restClient[Image]
+}
+
+func (s catalogServiceOperations) ListRegions(ctx context.Context) ([]cloudscalesdk.Region, error) {
+	return s.regions.List(ctx)
+}
+
+func (s catalogServiceOperations) ListFlavors(ctx context.Context) ([]Flavor, error) {
+	return s.flavors.List(ctx)
+}
+
+func (s catalogServiceOperations) ListImages(ctx context.Context) ([]Image, error) {
+	return s.images.List(ctx)
+}
@@ -0,0 +1,48 @@
+package cloudscaleclient
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCatalogServiceOperations(t *testing.T) {
+	responses := map[string]string{
+		"/v1/regions": `[{"slug":"rma","zones":[{"slug":"rma1"}]}]`,
+		"/v1/flavors": `[{"slug":"flex-4-2","name":"Flex-4-2","vcpu_count":2,"memory_gb":4,"zones":[{"slug":"rma1"}]}]`,
+		"/v1/images":  `[{"slug":"debian-12","name":"Debian 12","operating_system":"Debian","default_username":"debian","zones":[{"slug":"rma1"}]}]`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		response, exists := responses[r.URL.Path]
+		if !exists {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(response))
+	}))
+	defer server.Close()
+
+	client := cloudscalesdk.NewClient(server.Client())
+	client.BaseURL, _ = url.Parse(server.URL + "/")
+	svc := NewCatalogService(client)
+	ctx := context.Background()
+
+	regions, err := svc.ListRegions(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []cloudscalesdk.Region{{Slug: "rma", Zones: []cloudscalesdk.Zone{{Slug: "rma1"}}}}, regions)
+
+	flavors, err := svc.ListFlavors(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []Flavor{{Slug: "flex-4-2", Name: "Flex-4-2", VCPUCount: 2, MemoryGB: 4, Zones: []cloudscalesdk.Zone{{Slug: "rma1"}}}}, flavors)
+
+	images, err := svc.ListImages(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []Image{{Slug: "debian-12", Name: "Debian 12", OperatingSystem: "Debian", DefaultUsername: "debian", Zones: []cloudscalesdk.Zone{{Slug: "rma1"}}}}, images)
+}
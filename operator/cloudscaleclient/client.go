@@ -0,0 +1,141 @@
+package cloudscaleclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	providerv1 "github.com/vshn/provider-cloudscale/apis/provider/v1"
+	"github.com/vshn/provider-cloudscale/operator/apimetrics"
+	"github.com/vshn/provider-cloudscale/operator/ratelimit"
+	"golang.org/x/oauth2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// APITokenKey identifies the key in which the API token of the cloudscale.ch API is expected in a Secret.
+	APITokenKey = "CLOUDSCALE_API_TOKEN"
+)
+
+// SupportedCredentialsSources are the credentials sources from which an API token can be extracted.
+var SupportedCredentialsSources = []xpv1.CredentialsSource{
+	xpv1.CredentialsSourceSecret,
+	xpv1.CredentialsSourceEnvironment,
+	xpv1.CredentialsSourceFilesystem,
+}
+
+// ExtractAPIToken returns the API token from the credentials source configured in the given ProviderConfig.
+//
+//   - `Secret` reads the key given in `secretRef`.
+//     If `secretRef` is not set, the key `CLOUDSCALE_API_TOKEN` in `apiTokenSecretRef` is read instead.
+//   - `Environment` reads the environment variable given in `env`.
+//   - `Filesystem` reads the file given in `fs`.
+//
+// For backwards compatibility, `InjectedIdentity` is treated like `Secret` if `apiTokenSecretRef` is set.
+// All other sources are rejected.
+func ExtractAPIToken(ctx context.Context, kube client.Client, providerConfig *providerv1.ProviderConfig) (string, error) {
+	creds := providerConfig.Spec.Credentials
+	switch creds.Source {
+	case xpv1.CredentialsSourceSecret:
+		if creds.SecretRef == nil {
+			return extractFromAPITokenSecret(ctx, kube, creds.APITokenSecretRef)
+		}
+		return extractFromSelectors(ctx, kube, creds)
+	case xpv1.CredentialsSourceEnvironment, xpv1.CredentialsSourceFilesystem:
+		return extractFromSelectors(ctx, kube, creds)
+	case xpv1.CredentialsSourceInjectedIdentity:
+		if creds.APITokenSecretRef.Name != "" {
+			return extractFromAPITokenSecret(ctx, kube, creds.APITokenSecretRef)
+		}
+	}
+	return "", fmt.Errorf("credentials source %q is not supported, use one of %v", creds.Source, SupportedCredentialsSources)
+}
+
+func extractFromSelectors(ctx context.Context, kube client.Client, creds providerv1.ProviderCredentials) (string, error) {
+	data, err := resource.CommonCredentialExtractor(ctx, creds.Source, kube, creds.CommonCredentialSelectors)
+	if err != nil {
+		return "", errors.Wrapf(err, "cannot extract API token from source %q", creds.Source)
+	}
+	// Files and environment variables may contain trailing newlines.
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", fmt.Errorf("API token from source %q is empty", creds.Source)
+	}
+	return token, nil
+}
+
+func extractFromAPITokenSecret(ctx context.Context, kube client.Client, secretRef corev1.SecretReference) (string, error) {
+	secret := &corev1.Secret{}
+	err := kube.Get(ctx, types.NamespacedName{Name: secretRef.Name, Namespace: secretRef.Namespace}, secret)
+	if err != nil {
+		return "", errors.Wrap(err, "cannot get secret with API token")
+	}
+	if value, exists := secret.Data[APITokenKey]; exists && string(value) != "" {
+		return string(value), nil
+	}
+	return "", fmt.Errorf("%s doesn't exist in secret %s/%s", APITokenKey, secret.Namespace, secret.Name)
+}
+
+// CredentialsVersion returns a version of the credentials configured in the given ProviderConfig.
+// The version changes whenever the ProviderConfig or the source of the API token changes, so it can be used to find out whether a cached client is still valid.
+//
+//   - `Secret` uses the resource version of the Secret.
+//   - `Filesystem` uses the modification time of the file.
+//   - `Environment` only uses the generation of the ProviderConfig, since environment variables can't change at runtime.
+func CredentialsVersion(ctx context.Context, kube client.Client, providerConfig *providerv1.ProviderConfig) (string, error) {
+	creds := providerConfig.Spec.Credentials
+	version := fmt.Sprintf("%s/%d", providerConfig.UID, providerConfig.Generation)
+
+	switch {
+	case creds.Source == xpv1.CredentialsSourceSecret && creds.SecretRef != nil:
+		return secretVersion(ctx, kube, version, creds.SecretRef.Namespace, creds.SecretRef.Name)
+	case creds.Source == xpv1.CredentialsSourceSecret, creds.Source == xpv1.CredentialsSourceInjectedIdentity:
+		return secretVersion(ctx, kube, version, creds.APITokenSecretRef.Namespace, creds.APITokenSecretRef.Name)
+	case creds.Source == xpv1.CredentialsSourceFilesystem && creds.Fs != nil:
+		info, err := os.Stat(creds.Fs.Path)
+		if err != nil {
+			return "", errors.Wrap(err, "cannot read file with API token")
+		}
+		return fmt.Sprintf("%s/%d", version, info.ModTime().UnixNano()), nil
+	}
+	return version, nil
+}
+
+func secretVersion(ctx context.Context, kube client.Client, version, namespace, name string) (string, error) {
+	secret := &corev1.Secret{}
+	err := kube.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, secret)
+	if err != nil {
+		return "", errors.Wrap(err, "cannot get secret with API token")
+	}
+	return version + "/" + secret.ResourceVersion, nil
+}
+
+// New creates a new cloudscale.ch API client for the given ProviderConfig using the given API token.
+// The client uses the API URL of the ProviderConfig, if set.
+// The requests are instrumented with metrics labelled with the given kind of managed resource.
+// The requests are rate-limited with the limiter of the ProviderConfig.
+func New(token string, kind string, providerConfig *providerv1.ProviderConfig) (*cloudscalesdk.Client, error) {
+	hc := &http.Client{Transport: &oauth2.Transport{
+		Source: oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token}),
+		Base:   ratelimit.NewTransport(apimetrics.NewCloudscaleTransport(http.DefaultTransport, kind), string(providerConfig.UID)),
+	}}
+	csClient := cloudscalesdk.NewClient(hc)
+	if apiURL := providerConfig.Spec.APIURL; apiURL != "" {
+		// The paths of the API endpoints are resolved relative to the base URL, which therefore has to end with a slash.
+		baseURL, err := url.Parse(strings.TrimSuffix(apiURL, "/") + "/")
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot parse API URL")
+		}
+		csClient.BaseURL = baseURL
+	}
+	return csClient, nil
+}
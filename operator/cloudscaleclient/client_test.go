@@ -0,0 +1,158 @@
+package cloudscaleclient
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	providerv1 "github.com/vshn/provider-cloudscale/apis/provider/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestExtractAPIToken(t *testing.T) {
+	dir := t.TempDir()
+	tokenFile := filepath.Join(dir, "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("file-token\n"), 0600))
+	t.Setenv("TEST_CLOUDSCALE_API_TOKEN", "env-token")
+
+	secretRef := corev1.SecretReference{Name: "api-token", Namespace: "default"}
+	tests := map[string]struct {
+		givenCredentials providerv1.ProviderCredentials
+		expectedToken    string
+		expectedError    string
+	}{
+		"GivenSecretSource_WhenAPITokenSecretRef_ThenExpectToken": {
+			givenCredentials: providerv1.ProviderCredentials{Source: xpv1.CredentialsSourceSecret, APITokenSecretRef: secretRef},
+			expectedToken:    "legacy-token",
+		},
+		"GivenSecretSource_WhenSecretRef_ThenExpectToken": {
+			givenCredentials: providerv1.ProviderCredentials{Source: xpv1.CredentialsSourceSecret, CommonCredentialSelectors: xpv1.CommonCredentialSelectors{
+				SecretRef: &xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: "api-token", Namespace: "default"}, Key: "token"},
+			}},
+			expectedToken: "selector-token",
+		},
+		"GivenSecretSource_WhenSecretMissingKey_ThenExpectError": {
+			givenCredentials: providerv1.ProviderCredentials{Source: xpv1.CredentialsSourceSecret, APITokenSecretRef: corev1.SecretReference{Name: "empty", Namespace: "default"}},
+			expectedError:    "CLOUDSCALE_API_TOKEN doesn't exist in secret default/empty",
+		},
+		"GivenEnvironmentSource_ThenExpectToken": {
+			givenCredentials: providerv1.ProviderCredentials{Source: xpv1.CredentialsSourceEnvironment, CommonCredentialSelectors: xpv1.CommonCredentialSelectors{
+				Env: &xpv1.EnvSelector{Name: "TEST_CLOUDSCALE_API_TOKEN"},
+			}},
+			expectedToken: "env-token",
+		},
+		"GivenEnvironmentSource_WhenVariableEmpty_ThenExpectError": {
+			givenCredentials: providerv1.ProviderCredentials{Source: xpv1.CredentialsSourceEnvironment, CommonCredentialSelectors: xpv1.CommonCredentialSelectors{
+				Env: &xpv1.EnvSelector{Name: "TEST_CLOUDSCALE_API_TOKEN_UNSET"},
+			}},
+			expectedError: `API token from source "Environment" is empty`,
+		},
+		"GivenFilesystemSource_ThenExpectTrimmedToken": {
+			givenCredentials: providerv1.ProviderCredentials{Source: xpv1.CredentialsSourceFilesystem, CommonCredentialSelectors: xpv1.CommonCredentialSelectors{
+				Fs: &xpv1.FsSelector{Path: tokenFile},
+			}},
+			expectedToken: "file-token",
+		},
+		"GivenInjectedIdentitySource_WhenAPITokenSecretRef_ThenExpectToken": {
+			givenCredentials: providerv1.ProviderCredentials{Source: xpv1.CredentialsSourceInjectedIdentity, APITokenSecretRef: secretRef},
+			expectedToken:    "legacy-token",
+		},
+		"GivenInjectedIdentitySource_WhenNoAPITokenSecretRef_ThenExpectError": {
+			givenCredentials: providerv1.ProviderCredentials{Source: xpv1.CredentialsSourceInjectedIdentity},
+			expectedError:    `credentials source "InjectedIdentity" is not supported, use one of [Secret Environment Filesystem]`,
+		},
+		"GivenNoneSource_ThenExpectError": {
+			givenCredentials: providerv1.ProviderCredentials{Source: xpv1.CredentialsSourceNone, APITokenSecretRef: secretRef},
+			expectedError:    `credentials source "None" is not supported, use one of [Secret Environment Filesystem]`,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			kube := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "api-token", Namespace: "default"},
+					Data:       map[string][]byte{APITokenKey: []byte("legacy-token"), "token": []byte("selector-token")},
+				},
+				&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "empty", Namespace: "default"}},
+			).Build()
+			pc := &providerv1.ProviderConfig{Spec: providerv1.ProviderConfigSpec{Credentials: tc.givenCredentials}}
+
+			token, err := ExtractAPIToken(context.Background(), kube, pc)
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedToken, token)
+		})
+	}
+}
+
+func TestCredentialsVersion(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "api-token", Namespace: "default"},
+		Data:       map[string][]byte{APITokenKey: []byte("token")},
+	}
+	kube := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(secret).Build()
+	pc := &providerv1.ProviderConfig{
+		ObjectMeta: metav1.ObjectMeta{UID: "uid", Generation: 1},
+		Spec: providerv1.ProviderConfigSpec{Credentials: providerv1.ProviderCredentials{
+			Source:            xpv1.CredentialsSourceSecret,
+			APITokenSecretRef: corev1.SecretReference{Name: "api-token", Namespace: "default"},
+		}},
+	}
+
+	first, err := CredentialsVersion(context.Background(), kube, pc)
+	require.NoError(t, err)
+	same, err := CredentialsVersion(context.Background(), kube, pc)
+	require.NoError(t, err)
+	assert.Equal(t, first, same)
+
+	secret.Data[APITokenKey] = []byte("rotated")
+	require.NoError(t, kube.Update(context.Background(), secret))
+	rotated, err := CredentialsVersion(context.Background(), kube, pc)
+	require.NoError(t, err)
+	assert.NotEqual(t, first, rotated, "version should change when secret changes")
+
+	pc.Generation = 2
+	changed, err := CredentialsVersion(context.Background(), kube, pc)
+	require.NoError(t, err)
+	assert.NotEqual(t, rotated, changed, "version should change when provider config changes")
+}
+
+func TestNew(t *testing.T) {
+	tests := map[string]struct {
+		givenAPIURL     string
+		expectedBaseURL string
+	}{
+		"GivenNoAPIURL_ThenExpectDefault": {
+			expectedBaseURL: "https://api.cloudscale.ch/",
+		},
+		"GivenAPIURLWithoutSlash_ThenExpectTrailingSlash": {
+			givenAPIURL:     "http://localhost:8080",
+			expectedBaseURL: "http://localhost:8080/",
+		},
+		"GivenAPIURLWithPath_ThenExpectPathKept": {
+			givenAPIURL:     "https://api.example.com/cloudscale/",
+			expectedBaseURL: "https://api.example.com/cloudscale/",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pc := &providerv1.ProviderConfig{
+				ObjectMeta: metav1.ObjectMeta{UID: "uid"},
+				Spec:       providerv1.ProviderConfigSpec{APIURL: tc.givenAPIURL},
+			}
+			csClient, err := New("token", providerv1.ProviderConfigKind, pc)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedBaseURL, csClient.BaseURL.String())
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package cloudscaleclient
+
+import (
+	"context"
+	"net/http"
+
+	pipeline "github.com/ccremer/go-command-pipeline"
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	providerv1 "github.com/vshn/provider-cloudscale/apis/provider/v1"
+	"github.com/vshn/provider-cloudscale/operator/clientcache"
+	"github.com/vshn/provider-cloudscale/operator/pipelineutil"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Connector connects managed resources to the cloudscale.ch API with the API token of their ProviderConfig.
+// The clients are cached per ProviderConfig, so that all resources of the same kind share the same client.
+type Connector struct {
+	kube    client.Client
+	kind    string
+	clients *clientcache.Cache[*cloudscalesdk.Client]
+}
+
+type connectContext struct {
+	context.Context
+	mg             resource.Managed
+	providerConfig *providerv1.ProviderConfig
+	csClient       *cloudscalesdk.Client
+}
+
+// ParentContext implements tracing.Context.
+func (c *connectContext) ParentContext() context.Context {
+	return c.Context
+}
+
+// SetParentContext implements tracing.Context.
+func (c *connectContext) SetParentContext(ctx context.Context) {
+	c.Context = ctx
+}
+
+// NewConnector returns a new Connector for managed resources of the given kind.
+func NewConnector(kube client.Client, kind string) *Connector {
+	return &Connector{
+		kube:    kube,
+		kind:    kind,
+		clients: clientcache.New[*cloudscalesdk.Client](clientcache.DefaultMaxIdle),
+	}
+}
+
+// Connect tracks the usage of the ProviderConfig referenced by the given managed resource and returns a client using its API token.
+// The ProviderConfig is returned as well, e.g. to copy its project label.
+func (c *Connector) Connect(ctx context.Context, mg resource.Managed) (*cloudscalesdk.Client, *providerv1.ProviderConfig, error) {
+	pctx := &connectContext{Context: ctx, mg: mg}
+	p := pipeline.NewPipeline[*connectContext]()
+	stepMetrics := pipelineutil.NewStepMetrics[*connectContext](c.kind)
+	err := p.WithBeforeHooks(pipelineutil.DebugLogger(pctx)).
+		WithSteps(stepMetrics.Steps(
+			p.NewStep("track provider config", c.trackProviderConfig),
+			p.NewStep("fetch provider config", c.fetchProviderConfig),
+			p.NewStep("get cloudscale client", c.getCloudscaleClient),
+		)...).RunWithContext(pctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return pctx.csClient, pctx.providerConfig, nil
+}
+
+// trackProviderConfig ensures that the ProviderConfig is not deleted until no managed resource uses it anymore.
+// It returns an error if the ProviderConfig reference is missing.
+func (c *Connector) trackProviderConfig(ctx *connectContext) error {
+	u := resource.NewProviderConfigUsageTracker(c.kube, &providerv1.ProviderConfigUsage{})
+	return u.Track(ctx, ctx.mg)
+}
+
+func (c *Connector) fetchProviderConfig(ctx *connectContext) error {
+	config := &providerv1.ProviderConfig{}
+	err := c.kube.Get(ctx, types.NamespacedName{Name: ctx.mg.GetProviderConfigReference().Name}, config)
+	ctx.providerConfig = config
+	return errors.Wrap(err, "cannot get ProviderConfig")
+}
+
+// getCloudscaleClient returns the cached client of the ProviderConfig.
+// A new client is created if there is none yet, or if the credentials of the ProviderConfig changed since.
+func (c *Connector) getCloudscaleClient(ctx *connectContext) error {
+	version, err := CredentialsVersion(ctx, c.kube, ctx.providerConfig)
+	if err != nil {
+		return err
+	}
+	csClient, err := c.clients.Get(string(ctx.providerConfig.UID), version, func() (*cloudscalesdk.Client, error) {
+		token, err := ExtractAPIToken(ctx, c.kube, ctx.providerConfig)
+		if err != nil {
+			return nil, err
+		}
+		return New(token, c.kind, ctx.providerConfig)
+	})
+	ctx.csClient = csClient
+	return err
+}
+
+// IsNotFound returns true if the given error is a response of the cloudscale.ch API with status 404.
+func IsNotFound(err error) bool {
+	var errResp *cloudscalesdk.ErrorResponse
+	if errors.As(err, &errResp) {
+		return errResp.StatusCode == http.StatusNotFound
+	}
+	return false
+}
@@ -0,0 +1,43 @@
+package cloudscaleclient
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsNotFound(t *testing.T) {
+	tests := map[string]struct {
+		givenError   error
+		expectedBool bool
+	}{
+		"GivenNotFoundResponse_ThenExpectTrue": {
+			givenError:   &cloudscalesdk.ErrorResponse{StatusCode: http.StatusNotFound},
+			expectedBool: true,
+		},
+		"GivenNotFoundResponse_WhenWrapped_ThenExpectTrue": {
+			givenError:   fmt.Errorf("cannot get server: %w", &cloudscalesdk.ErrorResponse{StatusCode: http.StatusNotFound}),
+			expectedBool: true,
+		},
+		"GivenOtherResponse_ThenExpectFalse": {
+			givenError:   &cloudscalesdk.ErrorResponse{StatusCode: http.StatusBadRequest},
+			expectedBool: false,
+		},
+		"GivenOtherError_ThenExpectFalse": {
+			givenError:   errors.New("connection refused"),
+			expectedBool: false,
+		},
+		"GivenNil_ThenExpectFalse": {
+			expectedBool: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expectedBool, IsNotFound(tc.givenError))
+		})
+	}
+}
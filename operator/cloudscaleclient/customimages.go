@@ -0,0 +1,107 @@
+package cloudscaleclient
+
+import (
+	"context"
+	"time"
+
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+)
+
+const (
+	customImageBasePath       = "v1/custom-images"
+	customImageImportBasePath = "v1/custom-images/import"
+)
+
+const (
+	// CustomImageImportSuccess is the status of an import that has created the custom image.
+	CustomImageImportSuccess = "success"
+	// CustomImageImportFailed is the status of an import that has been aborted, see CustomImageImport.ErrorMessage.
+	CustomImageImportFailed = "failed"
+)
+
+// CustomImage is an image that has been imported into the project.
+// The cloudscale.ch SDK doesn't support the firmware type yet.
+type CustomImage struct {
+	cloudscalesdk.TaggedResource
+	HREF             string               `json:"href,omitempty"`
+	UUID             string               `json:"uuid,omitempty"`
+	Name             string               `json:"name,omitempty"`
+	Slug             string               `json:"slug,omitempty"`
+	SizeGB           int                  `json:"size_gb,omitempty"`
+	Checksums        map[string]string    `json:"checksums,omitempty"`
+	UserDataHandling string               `json:"user_data_handling,omitempty"`
+	FirmwareType     string               `json:"firmware_type,omitempty"`
+	Zones            []cloudscalesdk.Zone `json:"zones"`
+	CreatedAt        time.Time            `json:"created_at"`
+}
+
+// CustomImageImport downloads an image from a URL and creates a custom image from it.
+type CustomImageImport struct {
+	cloudscalesdk.TaggedResource
+	HREF         string                        `json:"href,omitempty"`
+	UUID         string                        `json:"uuid,omitempty"`
+	CustomImage  cloudscalesdk.CustomImageStub `json:"custom_image"`
+	URL          string                        `json:"url,omitempty"`
+	Status       string                        `json:"status,omitempty"`
+	ErrorMessage string                        `json:"error_message,omitempty"`
+}
+
+// CustomImageImportRequest starts an import.
+// The tags are given to both the import and the custom image.
+type CustomImageImportRequest struct {
+	cloudscalesdk.TaggedResourceRequest
+	URL              string   `json:"url"`
+	Name             string   `json:"name,omitempty"`
+	Slug             string   `json:"slug,omitempty"`
+	UserDataHandling string   `json:"user_data_handling,omitempty"`
+	FirmwareType     string   `json:"firmware_type,omitempty"`
+	SourceFormat     string   `json:"source_format,omitempty"`
+	Zones            []string `json:"zones,omitempty"`
+}
+
+// CustomImageService manages custom images and their imports.
+type CustomImageService interface {
+	Import(ctx context.Context, importRequest *CustomImageImportRequest) (*CustomImageImport, error)
+	GetImport(ctx context.Context, importID string) (*CustomImageImport, error)
+	ListImports(ctx context.Context, modifiers ...cloudscalesdk.ListRequestModifier) ([]CustomImageImport, error)
+	Get(ctx context.Context, customImageID string) (*CustomImage, error)
+	Update(ctx context.Context, customImageID string, updateRequest *cloudscalesdk.CustomImageRequest) error
+	Delete(ctx context.Context, customImageID string) error
+}
+
+// NewCustomImageService returns a CustomImageService that sends requests with the given client, including its rate limiting and metrics.
+func NewCustomImageService(client *cloudscalesdk.Client) CustomImageService {
+	return customImageServiceOperations{
+		images:  restClient[CustomImage]{client: client, basePath: customImageBasePath},
+		imports: restClient[CustomImageImport]{client: client, basePath: customImageImportBasePath},
+	}
+}
+
+type customImageServiceOperations struct {
+	images  restClient[CustomImage]
+	imports restClient[CustomImageImport]
+}
+
+func (s customImageServiceOperations) Import(ctx context.Context, importRequest *CustomImageImportRequest) (*CustomImageImport, error) {
+	return s.imports.create(ctx, importRequest)
+}
+
+func (s customImageServiceOperations) GetImport(ctx context.Context, importID string) (*CustomImageImport, error) {
+	return s.imports.Get(ctx, importID)
+}
+
+func (s customImageServiceOperations) ListImports(ctx context.Context, modifiers ...cloudscalesdk.ListRequestModifier) ([]CustomImageImport, error) {
+	return s.imports.List(ctx, modifiers...)
+}
+
+func (s customImageServiceOperations) Get(ctx context.Context, customImageID string) (*CustomImage, error) {
+	return s.images.Get(ctx, customImageID)
+}
+
+func (s customImageServiceOperations) Update(ctx context.Context, customImageID string, updateRequest *cloudscalesdk.CustomImageRequest) error {
+	return s.images.update(ctx, customImageID, updateRequest)
+}
+
+func (s customImageServiceOperations) Delete(ctx context.Context, customImageID string) error {
+	return s.images.Delete(ctx, customImageID)
+}
@@ -0,0 +1,81 @@
+package cloudscaleclient
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustomImageServiceOperations(t *testing.T) {
+	var givenMethod, givenPath string
+	var givenBody map[string]any
+	response := `{}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		givenMethod, givenPath = r.Method, r.URL.Path
+		givenBody = nil
+		if data, _ := io.ReadAll(r.Body); len(data) > 0 {
+			require.NoError(t, json.Unmarshal(data, &givenBody))
+		}
+		switch r.Method {
+		case http.MethodDelete, http.MethodPatch:
+			w.WriteHeader(http.StatusNoContent)
+		case http.MethodPost:
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(response))
+		default:
+			_, _ = w.Write([]byte(response))
+		}
+	}))
+	defer server.Close()
+
+	client := cloudscalesdk.NewClient(server.Client())
+	client.BaseURL, _ = url.Parse(server.URL + "/")
+	svc := NewCustomImageService(client)
+	ctx := context.Background()
+
+	response = `{"uuid":"import","status":"started","custom_image":{"uuid":"image"}}`
+	imp, err := svc.Import(ctx, &CustomImageImportRequest{
+		URL:              "https://example.com/image.raw",
+		Slug:             "my-image",
+		UserDataHandling: cloudscalesdk.UserDataHandlingPassThrough,
+		FirmwareType:     "uefi",
+		Zones:            []string{"rma1"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, http.MethodPost, givenMethod)
+	assert.Equal(t, "/v1/custom-images/import", givenPath)
+	assert.Equal(t, map[string]any{
+		"url": "https://example.com/image.raw", "slug": "my-image", "user_data_handling": "pass-through", "firmware_type": "uefi", "zones": []any{"rma1"},
+	}, givenBody)
+	assert.Equal(t, "image", imp.CustomImage.UUID)
+
+	response = `{"uuid":"import","status":"failed","error_message":"Download failed"}`
+	imp, err = svc.GetImport(ctx, "import")
+	require.NoError(t, err)
+	assert.Equal(t, "/v1/custom-images/import/import", givenPath)
+	assert.Equal(t, CustomImageImportFailed, imp.Status)
+	assert.Equal(t, "Download failed", imp.ErrorMessage)
+
+	response = `{"uuid":"image","slug":"my-image","firmware_type":"uefi","checksums":{"sha256":"abc"},"zones":[{"slug":"rma1"}]}`
+	image, err := svc.Get(ctx, "image")
+	require.NoError(t, err)
+	assert.Equal(t, "/v1/custom-images/image", givenPath)
+	assert.Equal(t, "uefi", image.FirmwareType)
+	assert.Equal(t, "abc", image.Checksums["sha256"])
+
+	require.NoError(t, svc.Update(ctx, "image", &cloudscalesdk.CustomImageRequest{Name: "renamed"}))
+	assert.Equal(t, http.MethodPatch, givenMethod)
+	assert.Equal(t, map[string]any{"name": "renamed"}, givenBody)
+
+	require.NoError(t, svc.Delete(ctx, "image"))
+	assert.Equal(t, http.MethodDelete, givenMethod)
+	assert.Equal(t, "/v1/custom-images/image", givenPath)
+}
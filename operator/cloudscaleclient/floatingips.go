@@ -0,0 +1,142 @@
+package cloudscaleclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+)
+
+const floatingIPBasePath = "v1/floating-ips"
+
+// FloatingIP is a floating IP address or IPv6 network.
+// The cloudscale.ch SDK doesn't support assigning floating IPs to load balancers yet.
+type FloatingIP struct {
+	// Region is nil for global floating IPs.
+	Region *cloudscalesdk.Region `json:"region"`
+	cloudscalesdk.TaggedResource
+	HREF           string                    `json:"href,omitempty"`
+	Network        string                    `json:"network,omitempty"`
+	IPVersion      int                       `json:"ip_version,omitempty"`
+	NextHop        string                    `json:"next_hop,omitempty"`
+	Server         *cloudscalesdk.ServerStub `json:"server"`
+	LoadBalancer   *FloatingIPLoadBalancer   `json:"load_balancer"`
+	Type           string                    `json:"type,omitempty"`
+	ReversePointer string                    `json:"reverse_ptr,omitempty"`
+	CreatedAt      time.Time                 `json:"created_at"`
+}
+
+// FloatingIPLoadBalancer is the load balancer a floating IP is assigned to.
+type FloatingIPLoadBalancer struct {
+	HREF string `json:"href,omitempty"`
+	UUID string `json:"uuid,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// IP returns the address of the floating IP without the prefix length, which identifies the floating IP in the API.
+func (f FloatingIP) IP() string {
+	return strings.Split(f.Network, "/")[0]
+}
+
+// FloatingIPCreateRequest creates a floating IP.
+type FloatingIPCreateRequest struct {
+	cloudscalesdk.RegionalResourceRequest
+	cloudscalesdk.TaggedResourceRequest
+	IPVersion      int    `json:"ip_version"`
+	Type           string `json:"type,omitempty"`
+	PrefixLength   int    `json:"prefix_length,omitempty"`
+	ReversePointer string `json:"reverse_ptr,omitempty"`
+	Server         string `json:"server,omitempty"`
+	LoadBalancer   string `json:"load_balancer,omitempty"`
+}
+
+// FloatingIPUpdateRequest updates a floating IP.
+// Giving a server or a load balancer reassigns the floating IP.
+type FloatingIPUpdateRequest struct {
+	cloudscalesdk.TaggedResourceRequest
+	ReversePointer string `json:"reverse_ptr,omitempty"`
+	Server         string `json:"server,omitempty"`
+	LoadBalancer   string `json:"load_balancer,omitempty"`
+}
+
+// FloatingIPService manages floating IPs.
+type FloatingIPService interface {
+	Create(ctx context.Context, createRequest *FloatingIPCreateRequest) (*FloatingIP, error)
+	Get(ctx context.Context, ip string) (*FloatingIP, error)
+	List(ctx context.Context, modifiers ...cloudscalesdk.ListRequestModifier) ([]FloatingIP, error)
+	Update(ctx context.Context, ip string, updateRequest *FloatingIPUpdateRequest) error
+	Delete(ctx context.Context, ip string) error
+}
+
+// FloatingIPServiceOperations implements FloatingIPService with the requests of the given SDK client.
+type FloatingIPServiceOperations struct {
+	client *cloudscalesdk.Client
+}
+
+// NewFloatingIPService returns a FloatingIPService that sends requests with the given client, including its rate limiting and metrics.
+func NewFloatingIPService(client *cloudscalesdk.Client) FloatingIPService {
+	return FloatingIPServiceOperations{client: client}
+}
+
+// Create implements FloatingIPService.
+func (s FloatingIPServiceOperations) Create(ctx context.Context, createRequest *FloatingIPCreateRequest) (*FloatingIP, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, floatingIPBasePath, createRequest)
+	if err != nil {
+		return nil, err
+	}
+	floatingIP := new(FloatingIP)
+	if err := s.client.Do(ctx, req, floatingIP); err != nil {
+		return nil, err
+	}
+	return floatingIP, nil
+}
+
+// Get implements FloatingIPService.
+func (s FloatingIPServiceOperations) Get(ctx context.Context, ip string) (*FloatingIP, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, fmt.Sprintf("%s/%s", floatingIPBasePath, ip), nil)
+	if err != nil {
+		return nil, err
+	}
+	floatingIP := new(FloatingIP)
+	if err := s.client.Do(ctx, req, floatingIP); err != nil {
+		return nil, err
+	}
+	return floatingIP, nil
+}
+
+// List implements FloatingIPService.
+func (s FloatingIPServiceOperations) List(ctx context.Context, modifiers ...cloudscalesdk.ListRequestModifier) ([]FloatingIP, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, floatingIPBasePath, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, modifier := range modifiers {
+		modifier(req)
+	}
+	floatingIPs := []FloatingIP{}
+	if err := s.client.Do(ctx, req, &floatingIPs); err != nil {
+		return nil, err
+	}
+	return floatingIPs, nil
+}
+
+// Update implements FloatingIPService.
+func (s FloatingIPServiceOperations) Update(ctx context.Context, ip string, updateRequest *FloatingIPUpdateRequest) error {
+	req, err := s.client.NewRequest(ctx, http.MethodPatch, fmt.Sprintf("%s/%s", floatingIPBasePath, ip), updateRequest)
+	if err != nil {
+		return err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// Delete implements FloatingIPService.
+func (s FloatingIPServiceOperations) Delete(ctx context.Context, ip string) error {
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, fmt.Sprintf("%s/%s", floatingIPBasePath, ip), nil)
+	if err != nil {
+		return err
+	}
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,70 @@
+package cloudscaleclient
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFloatingIPServiceOperations(t *testing.T) {
+	var givenMethod, givenPath string
+	var givenBody map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		givenMethod, givenPath = r.Method, r.URL.Path
+		givenBody = nil
+		if data, _ := io.ReadAll(r.Body); len(data) > 0 {
+			require.NoError(t, json.Unmarshal(data, &givenBody))
+		}
+		switch r.Method {
+		case http.MethodDelete, http.MethodPatch:
+			w.WriteHeader(http.StatusNoContent)
+		case http.MethodPost:
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"network":"192.0.2.1/32","ip_version":4,"type":"regional","region":{"slug":"lpg"},"load_balancer":{"uuid":"lb"}}`))
+		default:
+			_, _ = w.Write([]byte(`{"network":"2001:db8::/56","ip_version":6,"type":"global","region":null,"server":{"uuid":"server"}}`))
+		}
+	}))
+	defer server.Close()
+
+	client := cloudscalesdk.NewClient(server.Client())
+	client.BaseURL, _ = url.Parse(server.URL + "/")
+	svc := NewFloatingIPService(client)
+	ctx := context.Background()
+
+	floatingIP, err := svc.Create(ctx, &FloatingIPCreateRequest{
+		RegionalResourceRequest: cloudscalesdk.RegionalResourceRequest{Region: "lpg"},
+		IPVersion:               4,
+		LoadBalancer:            "lb",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, http.MethodPost, givenMethod)
+	assert.Equal(t, "/v1/floating-ips", givenPath)
+	assert.Equal(t, map[string]any{"region": "lpg", "ip_version": float64(4), "load_balancer": "lb"}, givenBody)
+	assert.Equal(t, "192.0.2.1", floatingIP.IP())
+	assert.Equal(t, "lpg", floatingIP.Region.Slug)
+	assert.Equal(t, "lb", floatingIP.LoadBalancer.UUID)
+
+	floatingIP, err = svc.Get(ctx, "2001:db8::")
+	require.NoError(t, err)
+	assert.Equal(t, "/v1/floating-ips/2001:db8::", givenPath)
+	assert.Nil(t, floatingIP.Region)
+	assert.Nil(t, floatingIP.LoadBalancer)
+	assert.Equal(t, "server", floatingIP.Server.UUID)
+
+	require.NoError(t, svc.Update(ctx, "192.0.2.1", &FloatingIPUpdateRequest{Server: "server"}))
+	assert.Equal(t, http.MethodPatch, givenMethod)
+	assert.Equal(t, "/v1/floating-ips/192.0.2.1", givenPath)
+	assert.Equal(t, map[string]any{"server": "server"}, givenBody)
+
+	require.NoError(t, svc.Delete(ctx, "192.0.2.1"))
+	assert.Equal(t, http.MethodDelete, givenMethod)
+}
@@ -0,0 +1,407 @@
+package cloudscaleclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+)
+
+// The cloudscale.ch SDK doesn't support load balancers yet.
+// The services in this file cover load balancers, pools, pool members, listeners and health monitors.
+
+const (
+	loadBalancerBasePath              = "v1/load-balancers"
+	loadBalancerPoolBasePath          = "v1/load-balancers/pools"
+	loadBalancerListenerBasePath      = "v1/load-balancers/listeners"
+	loadBalancerHealthMonitorBasePath = "v1/load-balancers/health-monitors"
+)
+
+// LoadBalancerStub references a load balancer.
+type LoadBalancerStub struct {
+	HREF string `json:"href,omitempty"`
+	UUID string `json:"uuid,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// LoadBalancerPoolStub references a pool of a load balancer.
+type LoadBalancerPoolStub struct {
+	HREF string `json:"href,omitempty"`
+	UUID string `json:"uuid,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// LoadBalancerFlavorStub references the flavor of a load balancer.
+type LoadBalancerFlavorStub struct {
+	Slug string `json:"slug,omitempty"`
+}
+
+// LoadBalancer is a managed load balancer.
+type LoadBalancer struct {
+	cloudscalesdk.ZonalResource
+	cloudscalesdk.TaggedResource
+	HREF         string                   `json:"href,omitempty"`
+	UUID         string                   `json:"uuid,omitempty"`
+	Name         string                   `json:"name,omitempty"`
+	Flavor       LoadBalancerFlavorStub   `json:"flavor"`
+	Status       string                   `json:"status,omitempty"`
+	VIPAddresses []LoadBalancerVIPAddress `json:"vip_addresses,omitempty"`
+	CreatedAt    time.Time                `json:"created_at"`
+}
+
+// LoadBalancerVIPAddress is a virtual IP address of a load balancer.
+type LoadBalancerVIPAddress struct {
+	Version int                      `json:"version,omitempty"`
+	Address string                   `json:"address,omitempty"`
+	Subnet  cloudscalesdk.SubnetStub `json:"subnet"`
+}
+
+// LoadBalancerVIPAddressRequest requests a virtual IP address in a private subnet.
+type LoadBalancerVIPAddressRequest struct {
+	Subnet  string `json:"subnet"`
+	Address string `json:"address,omitempty"`
+}
+
+// LoadBalancerRequest creates or updates a load balancer.
+// Flavor, zone and VIP addresses can only be given when creating the load balancer.
+type LoadBalancerRequest struct {
+	cloudscalesdk.ZonalResourceRequest
+	cloudscalesdk.TaggedResourceRequest
+	Name         string                          `json:"name,omitempty"`
+	Flavor       string                          `json:"flavor,omitempty"`
+	VIPAddresses []LoadBalancerVIPAddressRequest `json:"vip_addresses,omitempty"`
+}
+
+// LoadBalancerPool is a pool of members to which a load balancer distributes the traffic.
+type LoadBalancerPool struct {
+	cloudscalesdk.TaggedResource
+	HREF         string           `json:"href,omitempty"`
+	UUID         string           `json:"uuid,omitempty"`
+	Name         string           `json:"name,omitempty"`
+	LoadBalancer LoadBalancerStub `json:"load_balancer"`
+	Algorithm    string           `json:"algorithm,omitempty"`
+	Protocol     string           `json:"protocol,omitempty"`
+	CreatedAt    time.Time        `json:"created_at"`
+}
+
+// LoadBalancerPoolRequest creates or updates a pool.
+// Load balancer, algorithm and protocol can only be given when creating the pool.
+type LoadBalancerPoolRequest struct {
+	cloudscalesdk.TaggedResourceRequest
+	Name         string `json:"name,omitempty"`
+	LoadBalancer string `json:"load_balancer,omitempty"`
+	Algorithm    string `json:"algorithm,omitempty"`
+	Protocol     string `json:"protocol,omitempty"`
+}
+
+// LoadBalancerPoolMember is a backend of a pool.
+type LoadBalancerPoolMember struct {
+	cloudscalesdk.TaggedResource
+	HREF          string                   `json:"href,omitempty"`
+	UUID          string                   `json:"uuid,omitempty"`
+	Name          string                   `json:"name,omitempty"`
+	Pool          LoadBalancerPoolStub     `json:"pool"`
+	Enabled       bool                     `json:"enabled"`
+	ProtocolPort  int                      `json:"protocol_port,omitempty"`
+	MonitorPort   int                      `json:"monitor_port,omitempty"`
+	Address       string                   `json:"address,omitempty"`
+	Subnet        cloudscalesdk.SubnetStub `json:"subnet"`
+	MonitorStatus string                   `json:"monitor_status,omitempty"`
+	CreatedAt     time.Time                `json:"created_at"`
+}
+
+// LoadBalancerPoolMemberRequest creates or updates a pool member.
+// Address and subnet can only be given when creating the member.
+type LoadBalancerPoolMemberRequest struct {
+	cloudscalesdk.TaggedResourceRequest
+	Name         string `json:"name,omitempty"`
+	Enabled      *bool  `json:"enabled,omitempty"`
+	ProtocolPort int    `json:"protocol_port,omitempty"`
+	MonitorPort  int    `json:"monitor_port,omitempty"`
+	Address      string `json:"address,omitempty"`
+	Subnet       string `json:"subnet,omitempty"`
+}
+
+// LoadBalancerListener accepts traffic on a port of a load balancer and forwards it to a pool.
+type LoadBalancerListener struct {
+	cloudscalesdk.TaggedResource
+	HREF                   string               `json:"href,omitempty"`
+	UUID                   string               `json:"uuid,omitempty"`
+	Name                   string               `json:"name,omitempty"`
+	Pool                   LoadBalancerPoolStub `json:"pool"`
+	LoadBalancer           LoadBalancerStub     `json:"load_balancer"`
+	Protocol               string               `json:"protocol,omitempty"`
+	ProtocolPort           int                  `json:"protocol_port,omitempty"`
+	AllowedCIDRs           []string             `json:"allowed_cidrs"`
+	TimeoutClientDataMS    int                  `json:"timeout_client_data_ms,omitempty"`
+	TimeoutMemberConnectMS int                  `json:"timeout_member_connect_ms,omitempty"`
+	TimeoutMemberDataMS    int                  `json:"timeout_member_data_ms,omitempty"`
+	CreatedAt              time.Time            `json:"created_at"`
+}
+
+// LoadBalancerListenerRequest creates or updates a listener.
+// Pool and protocol can only be given when creating the listener.
+type LoadBalancerListenerRequest struct {
+	cloudscalesdk.TaggedResourceRequest
+	Name         string `json:"name,omitempty"`
+	Pool         string `json:"pool,omitempty"`
+	Protocol     string `json:"protocol,omitempty"`
+	ProtocolPort int    `json:"protocol_port,omitempty"`
+	// AllowedCIDRs restrict the clients of the listener, an empty list allows all clients.
+	AllowedCIDRs           *[]string `json:"allowed_cidrs,omitempty"`
+	TimeoutClientDataMS    int       `json:"timeout_client_data_ms,omitempty"`
+	TimeoutMemberConnectMS int       `json:"timeout_member_connect_ms,omitempty"`
+	TimeoutMemberDataMS    int       `json:"timeout_member_data_ms,omitempty"`
+}
+
+// LoadBalancerHealthMonitor checks the members of a pool.
+type LoadBalancerHealthMonitor struct {
+	cloudscalesdk.TaggedResource
+	HREF          string                         `json:"href,omitempty"`
+	UUID          string                         `json:"uuid,omitempty"`
+	Pool          LoadBalancerPoolStub           `json:"pool"`
+	LoadBalancer  LoadBalancerStub               `json:"load_balancer"`
+	DelayS        int                            `json:"delay_s,omitempty"`
+	TimeoutS      int                            `json:"timeout_s,omitempty"`
+	UpThreshold   int                            `json:"up_threshold,omitempty"`
+	DownThreshold int                            `json:"down_threshold,omitempty"`
+	Type          string                         `json:"type,omitempty"`
+	HTTP          *LoadBalancerHealthMonitorHTTP `json:"http"`
+	CreatedAt     time.Time                      `json:"created_at"`
+}
+
+// LoadBalancerHealthMonitorHTTP configures the requests of HTTP and HTTPS health monitors.
+type LoadBalancerHealthMonitorHTTP struct {
+	ExpectedCodes []string `json:"expected_codes,omitempty"`
+	Method        string   `json:"method,omitempty"`
+	URLPath       string   `json:"url_path,omitempty"`
+	Version       string   `json:"version,omitempty"`
+	Host          *string  `json:"host,omitempty"`
+}
+
+// LoadBalancerHealthMonitorRequest creates or updates a health monitor.
+// Pool and type can only be given when creating the health monitor.
+type LoadBalancerHealthMonitorRequest struct {
+	cloudscalesdk.TaggedResourceRequest
+	Pool          string                         `json:"pool,omitempty"`
+	DelayS        int                            `json:"delay_s,omitempty"`
+	TimeoutS      int                            `json:"timeout_s,omitempty"`
+	UpThreshold   int                            `json:"up_threshold,omitempty"`
+	DownThreshold int                            `json:"down_threshold,omitempty"`
+	Type          string                         `json:"type,omitempty"`
+	HTTP          *LoadBalancerHealthMonitorHTTP `json:"http,omitempty"`
+}
+
+// LoadBalancerService manages load balancers.
+type LoadBalancerService interface {
+	Create(ctx context.Context, createRequest *LoadBalancerRequest) (*LoadBalancer, error)
+	Get(ctx context.Context, loadBalancerID string) (*LoadBalancer, error)
+	List(ctx context.Context, modifiers ...cloudscalesdk.ListRequestModifier) ([]LoadBalancer, error)
+	Update(ctx context.Context, loadBalancerID string, updateRequest *LoadBalancerRequest) error
+	Delete(ctx context.Context, loadBalancerID string) error
+}
+
+// LoadBalancerPoolService manages pools of load balancers.
+type LoadBalancerPoolService interface {
+	Create(ctx context.Context, createRequest *LoadBalancerPoolRequest) (*LoadBalancerPool, error)
+	Get(ctx context.Context, poolID string) (*LoadBalancerPool, error)
+	List(ctx context.Context, modifiers ...cloudscalesdk.ListRequestModifier) ([]LoadBalancerPool, error)
+	Update(ctx context.Context, poolID string, updateRequest *LoadBalancerPoolRequest) error
+	Delete(ctx context.Context, poolID string) error
+}
+
+// LoadBalancerPoolMemberService manages the members of pools.
+type LoadBalancerPoolMemberService interface {
+	Create(ctx context.Context, poolID string, createRequest *LoadBalancerPoolMemberRequest) (*LoadBalancerPoolMember, error)
+	Get(ctx context.Context, poolID, memberID string) (*LoadBalancerPoolMember, error)
+	List(ctx context.Context, poolID string, modifiers ...cloudscalesdk.ListRequestModifier) ([]LoadBalancerPoolMember, error)
+	Update(ctx context.Context, poolID, memberID string, updateRequest *LoadBalancerPoolMemberRequest) error
+	Delete(ctx context.Context, poolID, memberID string) error
+}
+
+// LoadBalancerListenerService manages listeners of load balancers.
+type LoadBalancerListenerService interface {
+	Create(ctx context.Context, createRequest *LoadBalancerListenerRequest) (*LoadBalancerListener, error)
+	Get(ctx context.Context, listenerID string) (*LoadBalancerListener, error)
+	List(ctx context.Context, modifiers ...cloudscalesdk.ListRequestModifier) ([]LoadBalancerListener, error)
+	Update(ctx context.Context, listenerID string, updateRequest *LoadBalancerListenerRequest) error
+	Delete(ctx context.Context, listenerID string) error
+}
+
+// LoadBalancerHealthMonitorService manages health monitors of pools.
+type LoadBalancerHealthMonitorService interface {
+	Create(ctx context.Context, createRequest *LoadBalancerHealthMonitorRequest) (*LoadBalancerHealthMonitor, error)
+	Get(ctx context.Context, healthMonitorID string) (*LoadBalancerHealthMonitor, error)
+	List(ctx context.Context, modifiers ...cloudscalesdk.ListRequestModifier) ([]LoadBalancerHealthMonitor, error)
+	Update(ctx context.Context, healthMonitorID string, updateRequest *LoadBalancerHealthMonitorRequest) error
+	Delete(ctx context.Context, healthMonitorID string) error
+}
+
+// NewLoadBalancerService returns a LoadBalancerService that sends requests with the given client, including its rate limiting and metrics.
+func NewLoadBalancerService(client *cloudscalesdk.Client) LoadBalancerService {
+	return loadBalancerServiceOperations{restClient[LoadBalancer]{client: client, basePath: loadBalancerBasePath}}
+}
+
+// NewLoadBalancerPoolService returns a LoadBalancerPoolService that sends requests with the given client, including its rate limiting and metrics.
+func NewLoadBalancerPoolService(client *cloudscalesdk.Client) LoadBalancerPoolService {
+	return loadBalancerPoolServiceOperations{restClient[LoadBalancerPool]{client: client, basePath: loadBalancerPoolBasePath}}
+}
+
+// NewLoadBalancerPoolMemberService returns a LoadBalancerPoolMemberService that sends requests with the given client, including its rate limiting and metrics.
+func NewLoadBalancerPoolMemberService(client *cloudscalesdk.Client) LoadBalancerPoolMemberService {
+	return loadBalancerPoolMemberServiceOperations{client: client}
+}
+
+// NewLoadBalancerListenerService returns a LoadBalancerListenerService that sends requests with the given client, including its rate limiting and metrics.
+func NewLoadBalancerListenerService(client *cloudscalesdk.Client) LoadBalancerListenerService {
+	return loadBalancerListenerServiceOperations{restClient[LoadBalancerListener]{client: client, basePath: loadBalancerListenerBasePath}}
+}
+
+// NewLoadBalancerHealthMonitorService returns a LoadBalancerHealthMonitorService that sends requests with the given client, including its rate limiting and metrics.
+func NewLoadBalancerHealthMonitorService(client *cloudscalesdk.Client) LoadBalancerHealthMonitorService {
+	return loadBalancerHealthMonitorServiceOperations{restClient[LoadBalancerHealthMonitor]{client: client, basePath: loadBalancerHealthMonitorBasePath}}
+}
+
+type loadBalancerServiceOperations struct {
+	restClient[LoadBalancer]
+}
+
+func (s loadBalancerServiceOperations) Create(ctx context.Context, createRequest *LoadBalancerRequest) (*LoadBalancer, error) {
+	return s.create(ctx, createRequest)
+}
+
+func (s loadBalancerServiceOperations) Update(ctx context.Context, loadBalancerID string, updateRequest *LoadBalancerRequest) error {
+	return s.update(ctx, loadBalancerID, updateRequest)
+}
+
+type loadBalancerPoolServiceOperations struct {
+	restClient[LoadBalancerPool]
+}
+
+func (s loadBalancerPoolServiceOperations) Create(ctx context.Context, createRequest *LoadBalancerPoolRequest) (*LoadBalancerPool, error) {
+	return s.create(ctx, createRequest)
+}
+
+func (s loadBalancerPoolServiceOperations) Update(ctx context.Context, poolID string, updateRequest *LoadBalancerPoolRequest) error {
+	return s.update(ctx, poolID, updateRequest)
+}
+
+// loadBalancerPoolMemberServiceOperations sends the requests of pool members, whose path contains the UUID of the pool.
+type loadBalancerPoolMemberServiceOperations struct {
+	client *cloudscalesdk.Client
+}
+
+func (s loadBalancerPoolMemberServiceOperations) forPool(poolID string) restClient[LoadBalancerPoolMember] {
+	return restClient[LoadBalancerPoolMember]{client: s.client, basePath: fmt.Sprintf("%s/%s/members", loadBalancerPoolBasePath, poolID)}
+}
+
+func (s loadBalancerPoolMemberServiceOperations) Create(ctx context.Context, poolID string, createRequest *LoadBalancerPoolMemberRequest) (*LoadBalancerPoolMember, error) {
+	return s.forPool(poolID).create(ctx, createRequest)
+}
+
+func (s loadBalancerPoolMemberServiceOperations) Get(ctx context.Context, poolID, memberID string) (*LoadBalancerPoolMember, error) {
+	return s.forPool(poolID).Get(ctx, memberID)
+}
+
+func (s loadBalancerPoolMemberServiceOperations) List(ctx context.Context, poolID string, modifiers ...cloudscalesdk.ListRequestModifier) ([]LoadBalancerPoolMember, error) {
+	return s.forPool(poolID).List(ctx, modifiers...)
+}
+
+func (s loadBalancerPoolMemberServiceOperations) Update(ctx context.Context, poolID, memberID string, updateRequest *LoadBalancerPoolMemberRequest) error {
+	return s.forPool(poolID).update(ctx, memberID, updateRequest)
+}
+
+func (s loadBalancerPoolMemberServiceOperations) Delete(ctx context.Context, poolID, memberID string) error {
+	return s.forPool(poolID).Delete(ctx, memberID)
+}
+
+type loadBalancerListenerServiceOperations struct {
+	restClient[LoadBalancerListener]
+}
+
+func (s loadBalancerListenerServiceOperations) Create(ctx context.Context, createRequest *LoadBalancerListenerRequest) (*LoadBalancerListener, error) {
+	return s.create(ctx, createRequest)
+}
+
+func (s loadBalancerListenerServiceOperations) Update(ctx context.Context, listenerID string, updateRequest *LoadBalancerListenerRequest) error {
+	return s.update(ctx, listenerID, updateRequest)
+}
+
+type loadBalancerHealthMonitorServiceOperations struct {
+	restClient[LoadBalancerHealthMonitor]
+}
+
+func (s loadBalancerHealthMonitorServiceOperations) Create(ctx context.Context, createRequest *LoadBalancerHealthMonitorRequest) (*LoadBalancerHealthMonitor, error) {
+	return s.create(ctx, createRequest)
+}
+
+func (s loadBalancerHealthMonitorServiceOperations) Update(ctx context.Context, healthMonitorID string, updateRequest *LoadBalancerHealthMonitorRequest) error {
+	return s.update(ctx, healthMonitorID, updateRequest)
+}
+
+// restClient sends the requests of an API resource of type T below the given base path.
+// The typed services only add the request types for creating and updating the resource.
+type restClient[T any] struct {
+	client   *cloudscalesdk.Client
+	basePath string
+}
+
+func (c restClient[T]) create(ctx context.Context, body any) (*T, error) {
+	req, err := c.client.NewRequest(ctx, http.MethodPost, c.basePath, body)
+	if err != nil {
+		return nil, err
+	}
+	obj := new(T)
+	if err := c.client.Do(ctx, req, obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// Get fetches the object with the given ID.
+func (c restClient[T]) Get(ctx context.Context, id string) (*T, error) {
+	req, err := c.client.NewRequest(ctx, http.MethodGet, fmt.Sprintf("%s/%s", c.basePath, id), nil)
+	if err != nil {
+		return nil, err
+	}
+	obj := new(T)
+	if err := c.client.Do(ctx, req, obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// List fetches all objects, filtered by the given modifiers.
+func (c restClient[T]) List(ctx context.Context, modifiers ...cloudscalesdk.ListRequestModifier) ([]T, error) {
+	req, err := c.client.NewRequest(ctx, http.MethodGet, c.basePath, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, modifier := range modifiers {
+		modifier(req)
+	}
+	objs := []T{}
+	if err := c.client.Do(ctx, req, &objs); err != nil {
+		return nil, err
+	}
+	return objs, nil
+}
+
+func (c restClient[T]) update(ctx context.Context, id string, body any) error {
+	req, err := c.client.NewRequest(ctx, http.MethodPatch, fmt.Sprintf("%s/%s", c.basePath, id), body)
+	if err != nil {
+		return err
+	}
+	return c.client.Do(ctx, req, nil)
+}
+
+// Delete deletes the object with the given ID.
+func (c restClient[T]) Delete(ctx context.Context, id string) error {
+	req, err := c.client.NewRequest(ctx, http.MethodDelete, fmt.Sprintf("%s/%s", c.basePath, id), nil)
+	if err != nil {
+		return err
+	}
+	return c.client.Do(ctx, req, nil)
+}
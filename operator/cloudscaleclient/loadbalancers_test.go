@@ -0,0 +1,108 @@
+package cloudscaleclient
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadBalancerServices(t *testing.T) {
+	var givenMethod, givenPath string
+	var givenBody map[string]any
+	response := `{}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		givenMethod, givenPath = r.Method, r.URL.Path
+		givenBody = nil
+		if data, _ := io.ReadAll(r.Body); len(data) > 0 {
+			require.NoError(t, json.Unmarshal(data, &givenBody))
+		}
+		switch r.Method {
+		case http.MethodDelete, http.MethodPatch:
+			w.WriteHeader(http.StatusNoContent)
+		case http.MethodPost:
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(response))
+		default:
+			_, _ = w.Write([]byte(response))
+		}
+	}))
+	defer server.Close()
+
+	client := cloudscalesdk.NewClient(server.Client())
+	client.BaseURL, _ = url.Parse(server.URL + "/")
+	ctx := context.Background()
+
+	t.Run("LoadBalancer", func(t *testing.T) {
+		svc := NewLoadBalancerService(client)
+		response = `{"uuid":"lb","status":"changing","flavor":{"slug":"lb-standard"},"vip_addresses":[{"version":4,"address":"10.0.0.5","subnet":{"uuid":"subnet"}}]}`
+
+		lb, err := svc.Create(ctx, &LoadBalancerRequest{
+			Name:         "my-lb",
+			Flavor:       "lb-standard",
+			VIPAddresses: []LoadBalancerVIPAddressRequest{{Subnet: "subnet"}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, http.MethodPost, givenMethod)
+		assert.Equal(t, "/v1/load-balancers", givenPath)
+		assert.Equal(t, map[string]any{"name": "my-lb", "flavor": "lb-standard", "vip_addresses": []any{map[string]any{"subnet": "subnet"}}}, givenBody)
+		assert.Equal(t, "lb-standard", lb.Flavor.Slug)
+		assert.Equal(t, "subnet", lb.VIPAddresses[0].Subnet.UUID)
+
+		_, err = svc.Get(ctx, "lb")
+		require.NoError(t, err)
+		assert.Equal(t, "/v1/load-balancers/lb", givenPath)
+	})
+
+	t.Run("PoolMember", func(t *testing.T) {
+		svc := NewLoadBalancerPoolMemberService(client)
+		response = `{"uuid":"member","enabled":true,"monitor_status":"up","pool":{"uuid":"pool"}}`
+
+		member, err := svc.Get(ctx, "pool", "member")
+		require.NoError(t, err)
+		assert.Equal(t, "/v1/load-balancers/pools/pool/members/member", givenPath)
+		assert.Equal(t, "up", member.MonitorStatus)
+
+		disabled := false
+		require.NoError(t, svc.Update(ctx, "pool", "member", &LoadBalancerPoolMemberRequest{Enabled: &disabled}))
+		assert.Equal(t, http.MethodPatch, givenMethod)
+		assert.Equal(t, "/v1/load-balancers/pools/pool/members/member", givenPath)
+		assert.Equal(t, map[string]any{"enabled": false}, givenBody)
+
+		response = `[{"uuid":"member"}]`
+		members, err := svc.List(ctx, "pool")
+		require.NoError(t, err)
+		assert.Equal(t, "/v1/load-balancers/pools/pool/members", givenPath)
+		assert.Len(t, members, 1)
+	})
+
+	t.Run("Listener", func(t *testing.T) {
+		svc := NewLoadBalancerListenerService(client)
+
+		require.NoError(t, svc.Update(ctx, "listener", &LoadBalancerListenerRequest{AllowedCIDRs: &[]string{}}))
+		assert.Equal(t, "/v1/load-balancers/listeners/listener", givenPath)
+		assert.Equal(t, map[string]any{"allowed_cidrs": []any{}}, givenBody)
+
+		require.NoError(t, svc.Delete(ctx, "listener"))
+		assert.Equal(t, http.MethodDelete, givenMethod)
+		assert.Equal(t, "/v1/load-balancers/listeners/listener", givenPath)
+	})
+
+	t.Run("HealthMonitor", func(t *testing.T) {
+		svc := NewLoadBalancerHealthMonitorService(client)
+		response = `{"uuid":"monitor","type":"http","http":{"expected_codes":["200"],"method":"GET","url_path":"/healthz"}}`
+
+		monitor, err := svc.Create(ctx, &LoadBalancerHealthMonitorRequest{Pool: "pool", Type: "http"})
+		require.NoError(t, err)
+		assert.Equal(t, "/v1/load-balancers/health-monitors", givenPath)
+		assert.Equal(t, map[string]any{"pool": "pool", "type": "http"}, givenBody)
+		assert.Equal(t, "/healthz", monitor.HTTP.URLPath)
+	})
+}
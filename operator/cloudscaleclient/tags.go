@@ -0,0 +1,44 @@
+package cloudscaleclient
+
+import (
+	"maps"
+
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// OwnerTagKey is the tag key in cloudscale.ch where the UID of the managed resource is stored.
+// It marks the cloudscale.ch resource as being owned by the managed resource.
+const OwnerTagKey = cloudscalev1.Group + "/owner-uid"
+
+// DesiredTags returns the given tags together with the owner tag of the given UID.
+// The owner tag is omitted if the UID is empty.
+func DesiredTags(tags cloudscalev1.Tags, uid types.UID) cloudscalev1.Tags {
+	desired := make(cloudscalev1.Tags, len(tags)+1)
+	maps.Copy(desired, tags)
+	if uid != "" {
+		desired[OwnerTagKey] = string(uid)
+	}
+	return desired
+}
+
+// ToTagMap converts the given tags to a tag map of a cloudscale.ch request.
+// An empty map is returned if there are no tags, so that existing tags are removed.
+func ToTagMap(tags cloudscalev1.Tags) *cloudscalesdk.TagMap {
+	tagMap := make(cloudscalesdk.TagMap, len(tags))
+	maps.Copy(tagMap, tags)
+	return &tagMap
+}
+
+// FromTagMap converts the given tag map of a cloudscale.ch resource to tags.
+func FromTagMap(tagMap cloudscalesdk.TagMap) cloudscalev1.Tags {
+	tags := make(cloudscalev1.Tags, len(tagMap))
+	maps.Copy(tags, tagMap)
+	return tags
+}
+
+// TagsNeedUpdate returns true if the observed tags differ from the desired tags.
+func TagsNeedUpdate(desired cloudscalev1.Tags, observed cloudscalesdk.TagMap) bool {
+	return !maps.Equal(map[string]string(desired), map[string]string(observed))
+}
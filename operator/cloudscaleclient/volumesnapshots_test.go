@@ -0,0 +1,65 @@
+package cloudscaleclient
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVolumeSnapshotServiceOperations(t *testing.T) {
+	var givenMethod, givenPath string
+	var givenBody map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		givenMethod, givenPath = r.Method, r.URL.Path
+		givenBody = nil
+		if data, _ := io.ReadAll(r.Body); len(data) > 0 {
+			require.NoError(t, json.Unmarshal(data, &givenBody))
+		}
+		switch r.Method {
+		case http.MethodDelete, http.MethodPatch:
+			w.WriteHeader(http.StatusNoContent)
+		case http.MethodPost:
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"uuid":"new","size_gb":50}`))
+		default:
+			_, _ = w.Write([]byte(`{"uuid":"snapshot","size_gb":50,"status":"available","source_volume":{"uuid":"volume"}}`))
+		}
+	}))
+	defer server.Close()
+
+	client := cloudscalesdk.NewClient(server.Client())
+	client.BaseURL, _ = url.Parse(server.URL + "/")
+	svc := NewVolumeSnapshotService(client)
+	ctx := context.Background()
+
+	snapshot, err := svc.Create(ctx, &VolumeSnapshotRequest{Name: "snap", SourceVolume: "volume"})
+	require.NoError(t, err)
+	assert.Equal(t, "new", snapshot.UUID)
+	assert.Equal(t, http.MethodPost, givenMethod)
+	assert.Equal(t, "/v1/volume-snapshots", givenPath)
+	assert.Equal(t, map[string]any{"name": "snap", "source_volume": "volume"}, givenBody)
+
+	snapshot, err = svc.Get(ctx, "snapshot")
+	require.NoError(t, err)
+	assert.Equal(t, "/v1/volume-snapshots/snapshot", givenPath)
+	assert.Equal(t, "available", snapshot.Status)
+	assert.Equal(t, "volume", snapshot.SourceVolume.UUID)
+
+	require.NoError(t, svc.Delete(ctx, "snapshot"))
+	assert.Equal(t, http.MethodDelete, givenMethod)
+	assert.Equal(t, "/v1/volume-snapshots/snapshot", givenPath)
+
+	volume, err := svc.Restore(ctx, "snapshot", &cloudscalesdk.VolumeRequest{Name: "restored"})
+	require.NoError(t, err)
+	assert.Equal(t, "new", volume.UUID)
+	assert.Equal(t, "/v1/volumes", givenPath)
+	assert.Equal(t, map[string]any{"name": "restored", "volume_snapshot_uuid": "snapshot"}, givenBody)
+}
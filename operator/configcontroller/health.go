@@ -0,0 +1,105 @@
+package configcontroller
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	providerv1 "github.com/vshn/provider-cloudscale/apis/provider/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	"github.com/vshn/provider-cloudscale/operator/controlleropts"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// DefaultPollInterval is the default interval in which the API token of a ProviderConfig is validated again, even if the ProviderConfig didn't change.
+const DefaultPollInterval = 10 * time.Minute
+
+// validateTokenFn performs a cheap, read-only request against the cloudscale.ch API to find out whether the API token is accepted.
+// It is a variable so that it can be replaced in tests.
+var validateTokenFn = func(ctx context.Context, csClient *cloudscalesdk.Client) error {
+	_, err := csClient.Regions.List(ctx)
+	return err
+}
+
+// healthReconciler validates the API token of ProviderConfigs and reports the result in the Healthy condition.
+type healthReconciler struct {
+	kube     client.Client
+	recorder event.Recorder
+	opts     controlleropts.Options
+}
+
+// Reconcile implements reconcile.Reconciler.
+func (r *healthReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+
+	providerConfig := &providerv1.ProviderConfig{}
+	if err := r.kube.Get(ctx, req.NamespacedName, providerConfig); err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+	if meta.WasDeleted(providerConfig) {
+		return reconcile.Result{}, nil
+	}
+
+	previous := providerConfig.GetCondition(xpv1.TypeHealthy)
+	condition := r.checkHealth(ctx, providerConfig)
+	log.V(1).Info("Checked API token", "healthy", condition.Status, "reason", condition.Reason)
+
+	if !condition.Equal(previous) {
+		// Events are only emitted if the status or reason changes, not if only the message does.
+		healthChanged := condition.Status != previous.Status || condition.Reason != previous.Reason
+		if healthChanged && condition.Reason != providerv1.ReasonTokenValid {
+			r.recorder.Event(providerConfig, event.Warning(event.Reason(condition.Reason), errors.New(condition.Message)))
+		} else if healthChanged && previous.Status == corev1.ConditionFalse {
+			r.recorder.Event(providerConfig, event.Normal(event.Reason(condition.Reason), "API token is valid again"))
+		}
+		providerConfig.SetConditions(condition)
+		if err := r.kube.Status().Update(ctx, providerConfig); err != nil {
+			if apierrors.IsConflict(err) {
+				return reconcile.Result{Requeue: true}, nil
+			}
+			return reconcile.Result{}, errors.Wrap(err, "cannot update ProviderConfig status")
+		}
+	}
+	return reconcile.Result{RequeueAfter: r.opts.WithJitter(r.opts.PollInterval)}, nil
+}
+
+// checkHealth extracts the API token of the given ProviderConfig and returns the condition resulting from validating the token.
+// If the API can't be reached, the condition has a fixed message and the error is only logged, since it varies between checks, e.g. by timeouts and addresses.
+func (r *healthReconciler) checkHealth(ctx context.Context, providerConfig *providerv1.ProviderConfig) xpv1.Condition {
+	log := controllerruntime.LoggerFrom(ctx)
+	token, err := cloudscaleclient.ExtractAPIToken(ctx, r.kube, providerConfig)
+	if err != nil {
+		return providerv1.Unhealthy(providerv1.ReasonCredentialsUnavailable, err.Error())
+	}
+
+	csClient, err := cloudscaleclient.New(token, providerv1.ProviderConfigKind, providerConfig)
+	if err != nil {
+		log.Info("Cannot create cloudscale.ch API client", "error", err.Error())
+		return providerv1.Unhealthy(providerv1.ReasonAPIUnavailable, "cloudscale.ch API client cannot be created, check spec.apiURL")
+	}
+	err = validateTokenFn(ctx, csClient)
+	if err == nil {
+		return providerv1.Healthy(providerConfig.Spec.Project)
+	}
+
+	var errResp *cloudscalesdk.ErrorResponse
+	if errors.As(err, &errResp) {
+		switch errResp.StatusCode {
+		case http.StatusUnauthorized:
+			return providerv1.Unhealthy(providerv1.ReasonTokenInvalid, "API token is invalid, expired or revoked: "+err.Error())
+		case http.StatusForbidden:
+			return providerv1.Unhealthy(providerv1.ReasonPermissionDenied, "API token lacks permissions: "+err.Error())
+		}
+	}
+	log.Info("Cannot reach cloudscale.ch API", "error", err.Error())
+	return providerv1.Unhealthy(providerv1.ReasonAPIUnavailable, "cloudscale.ch API is unreachable")
+}
@@ -0,0 +1,191 @@
+package configcontroller
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	providerv1 "github.com/vshn/provider-cloudscale/apis/provider/v1"
+	"github.com/vshn/provider-cloudscale/operator/controlleropts"
+	"github.com/vshn/provider-cloudscale/operator/operatortest"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestHealthReconciler_Reconcile(t *testing.T) {
+	tests := map[string]struct {
+		givenCondition      *xpv1.Condition
+		givenProject        string
+		givenValidateErr    error
+		expectedStatus      corev1.ConditionStatus
+		expectedReason      xpv1.ConditionReason
+		expectedMessage     string
+		expectedEventReason event.Reason
+	}{
+		"GivenValidToken_ThenExpectHealthy": {
+			expectedStatus:  corev1.ConditionTrue,
+			expectedReason:  providerv1.ReasonTokenValid,
+			expectedMessage: "API token is valid, the project is unknown since the cloudscale.ch API doesn't expose it, set spec.project to label it",
+		},
+		"GivenValidToken_WhenProject_ThenExpectHealthyWithProject": {
+			givenProject:    "production",
+			expectedStatus:  corev1.ConditionTrue,
+			expectedReason:  providerv1.ReasonTokenValid,
+			expectedMessage: "API token is valid for project production",
+		},
+		"GivenRevokedToken_ThenExpectTokenInvalidWithEvent": {
+			givenValidateErr:    &cloudscalesdk.ErrorResponse{StatusCode: http.StatusUnauthorized},
+			expectedStatus:      corev1.ConditionFalse,
+			expectedReason:      providerv1.ReasonTokenInvalid,
+			expectedEventReason: event.Reason(providerv1.ReasonTokenInvalid),
+		},
+		"GivenTokenWithoutPermissions_ThenExpectPermissionDeniedWithEvent": {
+			givenValidateErr:    &cloudscalesdk.ErrorResponse{StatusCode: http.StatusForbidden},
+			expectedStatus:      corev1.ConditionFalse,
+			expectedReason:      providerv1.ReasonPermissionDenied,
+			expectedEventReason: event.Reason(providerv1.ReasonPermissionDenied),
+		},
+		"GivenUnreachableAPI_ThenExpectAPIUnavailableWithEvent": {
+			givenValidateErr:    errors.New("dial tcp 1.2.3.4:443: connection refused"),
+			expectedStatus:      corev1.ConditionFalse,
+			expectedReason:      providerv1.ReasonAPIUnavailable,
+			expectedMessage:     "cloudscale.ch API is unreachable",
+			expectedEventReason: event.Reason(providerv1.ReasonAPIUnavailable),
+		},
+		"GivenPreviouslyUnreachableAPI_WhenStillUnreachable_ThenExpectNoEvent": {
+			givenCondition:   ptr(providerv1.Unhealthy(providerv1.ReasonAPIUnavailable, "dial tcp 1.2.3.4:443: i/o timeout")),
+			givenValidateErr: errors.New("dial tcp 5.6.7.8:443: connection refused"),
+			expectedStatus:   corev1.ConditionFalse,
+			expectedReason:   providerv1.ReasonAPIUnavailable,
+			expectedMessage:  "cloudscale.ch API is unreachable",
+		},
+		"GivenPreviouslyInvalidToken_WhenTokenValid_ThenExpectRecoveryEvent": {
+			givenCondition:      ptr(providerv1.Unhealthy(providerv1.ReasonTokenInvalid, "revoked")),
+			expectedStatus:      corev1.ConditionTrue,
+			expectedReason:      providerv1.ReasonTokenValid,
+			expectedEventReason: event.Reason(providerv1.ReasonTokenValid),
+		},
+		"GivenAlreadyHealthy_WhenTokenValid_ThenExpectNoEvent": {
+			givenCondition: ptr(providerv1.Healthy("")),
+			expectedStatus: corev1.ConditionTrue,
+			expectedReason: providerv1.ReasonTokenValid,
+		},
+		"GivenAlreadyHealthy_WhenProjectChanged_ThenExpectNoEvent": {
+			givenCondition:  ptr(providerv1.Healthy("staging")),
+			givenProject:    "production",
+			expectedStatus:  corev1.ConditionTrue,
+			expectedReason:  providerv1.ReasonTokenValid,
+			expectedMessage: "API token is valid for project production",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			replaceValidateTokenFn(t, func(_ context.Context, _ *cloudscalesdk.Client) error {
+				return tc.givenValidateErr
+			})
+			pc := newProviderConfig()
+			pc.Spec.Project = tc.givenProject
+			if tc.givenCondition != nil {
+				pc.SetConditions(*tc.givenCondition)
+			}
+			kube := fake.NewClientBuilder().
+				WithScheme(newScheme(t)).
+				WithObjects(pc, newAPITokenSecret()).
+				WithStatusSubresource(pc).
+				Build()
+			recorder := &operatortest.Recorder{}
+			r := &healthReconciler{kube: kube, recorder: recorder, opts: controlleropts.Options{PollInterval: DefaultPollInterval}}
+
+			result, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Name: pc.Name}})
+			require.NoError(t, err)
+			assert.Equal(t, DefaultPollInterval, result.RequeueAfter)
+
+			actual := &providerv1.ProviderConfig{}
+			require.NoError(t, kube.Get(context.Background(), types.NamespacedName{Name: pc.Name}, actual))
+			condition := actual.GetCondition(xpv1.TypeHealthy)
+			assert.Equal(t, tc.expectedStatus, condition.Status)
+			assert.Equal(t, tc.expectedReason, condition.Reason)
+			if tc.expectedMessage != "" {
+				assert.Equal(t, tc.expectedMessage, condition.Message)
+			}
+			if tc.expectedEventReason == "" {
+				assert.Empty(t, recorder.Events)
+			} else {
+				require.Len(t, recorder.Events, 1)
+				assert.Equal(t, tc.expectedEventReason, recorder.Events[0].Reason)
+			}
+		})
+	}
+}
+
+func TestHealthReconciler_Reconcile_CredentialsUnavailable(t *testing.T) {
+	replaceValidateTokenFn(t, func(_ context.Context, _ *cloudscalesdk.Client) error {
+		t.Fatal("token should not be validated without credentials")
+		return nil
+	})
+	pc := newProviderConfig()
+	kube := fake.NewClientBuilder().
+		WithScheme(newScheme(t)).
+		WithObjects(pc).
+		WithStatusSubresource(pc).
+		Build()
+	recorder := &operatortest.Recorder{}
+	r := &healthReconciler{kube: kube, recorder: recorder, opts: controlleropts.Options{PollInterval: DefaultPollInterval}}
+
+	_, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Name: pc.Name}})
+	require.NoError(t, err)
+
+	actual := &providerv1.ProviderConfig{}
+	require.NoError(t, kube.Get(context.Background(), types.NamespacedName{Name: pc.Name}, actual))
+	condition := actual.GetCondition(xpv1.TypeHealthy)
+	assert.Equal(t, corev1.ConditionFalse, condition.Status)
+	assert.Equal(t, providerv1.ReasonCredentialsUnavailable, condition.Reason)
+	require.Len(t, recorder.Events, 1)
+	assert.Equal(t, event.TypeWarning, recorder.Events[0].Type)
+}
+
+// replaceValidateTokenFn replaces validateTokenFn until the test has finished.
+func replaceValidateTokenFn(t *testing.T, fn func(context.Context, *cloudscalesdk.Client) error) {
+	original := validateTokenFn
+	t.Cleanup(func() { validateTokenFn = original })
+	validateTokenFn = fn
+}
+
+func newScheme(t *testing.T) *runtime.Scheme {
+	s := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(s))
+	require.NoError(t, providerv1.SchemeBuilder.AddToScheme(s))
+	return s
+}
+
+func newProviderConfig() *providerv1.ProviderConfig {
+	return &providerv1.ProviderConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "provider-config", Generation: 1},
+		Spec: providerv1.ProviderConfigSpec{Credentials: providerv1.ProviderCredentials{
+			Source:            xpv1.CredentialsSourceSecret,
+			APITokenSecretRef: corev1.SecretReference{Name: "api-token", Namespace: "default"},
+		}},
+	}
+}
+
+func newAPITokenSecret() *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "api-token", Namespace: "default"},
+		Data:       map[string][]byte{"CLOUDSCALE_API_TOKEN": []byte("token")},
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
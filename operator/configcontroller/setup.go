@@ -1,9 +1,15 @@
 package configcontroller
 
 import (
+	"strings"
+
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
 	providerv1 "github.com/vshn/provider-cloudscale/apis/provider/v1"
+	"github.com/vshn/provider-cloudscale/operator/controlleropts"
+	"github.com/vshn/provider-cloudscale/operator/tracing"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/providerconfig"
@@ -11,7 +17,7 @@ import (
 )
 
 // SetupController adds a controller that reconciles ProviderConfigs by accounting for their current usage.
-func SetupController(mgr ctrl.Manager) error {
+func SetupController(mgr ctrl.Manager, opts controlleropts.Options) error {
 	name := providerconfig.ControllerName(providerv1.ProviderConfigGroupKind)
 
 	of := resource.ProviderConfigKinds{
@@ -26,7 +32,43 @@ func SetupController(mgr ctrl.Manager) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		For(&providerv1.ProviderConfig{}).
+		For(&providerv1.ProviderConfig{}, builder.WithPredicates(opts.Predicates...)).
 		Watches(&providerv1.ProviderConfigUsage{}, &resource.EnqueueRequestForProviderConfig{}).
-		Complete(r)
+		WithOptions(opts.ForControllerRuntime()).
+		Complete(tracing.NewReconciler(providerv1.ProviderConfigKind, r))
+}
+
+// SetupStoreConfigController adds a controller that verifies the secret stores configured by StoreConfigs.
+func SetupStoreConfigController(mgr ctrl.Manager, opts controlleropts.Options) error {
+	name := strings.ToLower(providerv1.StoreConfigGroupKind)
+
+	r := &storeConfigReconciler{
+		kube: mgr.GetClient(),
+	}
+	if opts.ESS != nil {
+		r.tlsConfig = opts.ESS.TLSConfig
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&providerv1.StoreConfig{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		WithOptions(opts.ForControllerRuntime()).
+		Complete(tracing.NewReconciler(providerv1.StoreConfigKind, r))
+}
+
+// SetupHealthController adds a controller that validates the API token of ProviderConfigs whenever they change and periodically thereafter.
+func SetupHealthController(mgr ctrl.Manager, opts controlleropts.Options) error {
+	name := providerconfig.ControllerName(providerv1.ProviderConfigGroupKind) + "/health"
+
+	r := &healthReconciler{
+		kube:     mgr.GetClient(),
+		recorder: event.NewAPIRecorder(mgr.GetEventRecorderFor(name)),
+		opts:     opts,
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&providerv1.ProviderConfig{}, builder.WithPredicates(append([]predicate.Predicate{predicate.GenerationChangedPredicate{}}, opts.Predicates...)...)).
+		WithOptions(opts.ForControllerRuntime()).
+		Complete(tracing.NewReconciler(providerv1.ProviderConfigKind, r))
 }
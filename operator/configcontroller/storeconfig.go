@@ -0,0 +1,65 @@
+package configcontroller
+
+import (
+	"context"
+	"crypto/tls"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	providerv1 "github.com/vshn/provider-cloudscale/apis/provider/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// StoreRetryInterval is the interval in which the secret store of a StoreConfig is checked again while it's unavailable.
+// The store may become available without a change of the StoreConfig, e.g. once the plugin has been started.
+const StoreRetryInterval = 1 * time.Minute
+
+// buildStoreFn builds the secret store of a StoreConfig.
+// It is a variable so that it can be replaced in tests.
+var buildStoreFn connection.StoreBuilderFn = connection.RuntimeStoreBuilder
+
+// storeConfigReconciler verifies that the secret store of StoreConfigs can be set up and reports the result in the Ready condition.
+type storeConfigReconciler struct {
+	kube      client.Client
+	tlsConfig *tls.Config
+}
+
+// Reconcile implements reconcile.Reconciler.
+func (r *storeConfigReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+
+	storeConfig := &providerv1.StoreConfig{}
+	if err := r.kube.Get(ctx, req.NamespacedName, storeConfig); err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+	if meta.WasDeleted(storeConfig) {
+		return reconcile.Result{}, nil
+	}
+
+	condition := xpv1.Available()
+	if _, err := buildStoreFn(ctx, r.kube, r.tlsConfig, storeConfig.GetStoreConfig()); err != nil {
+		condition = xpv1.Unavailable().WithMessage(err.Error())
+	}
+	log.V(1).Info("Checked secret store", "ready", condition.Status)
+
+	if !condition.Equal(storeConfig.GetCondition(xpv1.TypeReady)) {
+		storeConfig.SetConditions(condition)
+		if err := r.kube.Status().Update(ctx, storeConfig); err != nil {
+			if apierrors.IsConflict(err) {
+				return reconcile.Result{Requeue: true}, nil
+			}
+			return reconcile.Result{}, errors.Wrap(err, "cannot update StoreConfig status")
+		}
+	}
+	if condition.Status != corev1.ConditionTrue {
+		return reconcile.Result{RequeueAfter: StoreRetryInterval}, nil
+	}
+	return reconcile.Result{}, nil
+}
@@ -0,0 +1,83 @@
+package configcontroller
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	providerv1 "github.com/vshn/provider-cloudscale/apis/provider/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestStoreConfigReconciler_Reconcile(t *testing.T) {
+	tests := map[string]struct {
+		givenCondition  *xpv1.Condition
+		givenBuildErr   error
+		expectedStatus  corev1.ConditionStatus
+		expectedMessage string
+		expectedResult  reconcile.Result
+	}{
+		"GivenValidStore_ThenExpectAvailable": {
+			expectedStatus: corev1.ConditionTrue,
+		},
+		"GivenInvalidStore_ThenExpectUnavailableAndRetry": {
+			givenBuildErr:   errors.New("cannot connect to plugin"),
+			expectedStatus:  corev1.ConditionFalse,
+			expectedMessage: "cannot connect to plugin",
+			expectedResult:  reconcile.Result{RequeueAfter: StoreRetryInterval},
+		},
+		"GivenInvalidStore_WhenAlreadyUnavailable_ThenExpectRetry": {
+			givenCondition:  ptr(xpv1.Unavailable().WithMessage("cannot connect to plugin")),
+			givenBuildErr:   errors.New("cannot connect to plugin"),
+			expectedStatus:  corev1.ConditionFalse,
+			expectedMessage: "cannot connect to plugin",
+			expectedResult:  reconcile.Result{RequeueAfter: StoreRetryInterval},
+		},
+		"GivenValidStore_WhenPreviouslyUnavailable_ThenExpectAvailable": {
+			givenCondition: ptr(xpv1.Unavailable().WithMessage("cannot connect to plugin")),
+			expectedStatus: corev1.ConditionTrue,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			original := buildStoreFn
+			t.Cleanup(func() { buildStoreFn = original })
+			buildStoreFn = func(_ context.Context, _ client.Client, _ *tls.Config, _ xpv1.SecretStoreConfig) (connection.Store, error) {
+				return nil, tc.givenBuildErr
+			}
+			sc := &providerv1.StoreConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "default", Generation: 1},
+				Spec:       providerv1.StoreConfigSpec{SecretStoreConfig: xpv1.SecretStoreConfig{DefaultScope: "crossplane-system"}},
+			}
+			if tc.givenCondition != nil {
+				sc.SetConditions(*tc.givenCondition)
+			}
+			kube := fake.NewClientBuilder().
+				WithScheme(newScheme(t)).
+				WithObjects(sc).
+				WithStatusSubresource(sc).
+				Build()
+			r := &storeConfigReconciler{kube: kube}
+
+			result, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Name: sc.Name}})
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, result)
+
+			actual := &providerv1.StoreConfig{}
+			require.NoError(t, kube.Get(context.Background(), types.NamespacedName{Name: sc.Name}, actual))
+			condition := actual.GetCondition(xpv1.TypeReady)
+			assert.Equal(t, tc.expectedStatus, condition.Status)
+			assert.Equal(t, tc.expectedMessage, condition.Message)
+		})
+	}
+}
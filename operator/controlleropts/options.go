@@ -0,0 +1,72 @@
+package controlleropts
+
+import (
+	"crypto/tls"
+	"math/rand/v2"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	providerv1 "github.com/vshn/provider-cloudscale/apis/provider/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// Options configure a single controller.
+type Options struct {
+	// PollInterval is the interval after which a resource is reconciled again, even if it didn't change.
+	PollInterval time.Duration
+	// PollJitter is the maximum duration that is randomly added to or subtracted from PollInterval.
+	// It spreads the reconciles of resources that have been created at the same time.
+	PollJitter time.Duration
+	// MaxConcurrentReconciles is the number of resources that are reconciled in parallel.
+	MaxConcurrentReconciles int
+	// Predicates filter the resources that are reconciled.
+	Predicates []predicate.Predicate
+	// ESS enables publishing connection details to External Secret Stores configured by StoreConfigs.
+	// If nil, connection details are only written to the secret given by `writeConnectionSecretToRef`.
+	ESS *ESSOptions
+}
+
+// ESSOptions configure External Secret Stores.
+type ESSOptions struct {
+	// TLSConfig is used to connect to External Secret Store plugins.
+	TLSConfig *tls.Config
+}
+
+// WithJitter returns the given interval with a random jitter of at most PollJitter applied.
+func (o Options) WithJitter(interval time.Duration) time.Duration {
+	if o.PollJitter <= 0 {
+		return interval
+	}
+	return interval + time.Duration((rand.Float64()-0.5)*2*float64(o.PollJitter))
+}
+
+// PollIntervalHook returns a managed.PollIntervalHook that applies jitter to the interval returned by the given hook.
+// If the given hook is nil, jitter is applied to the poll interval.
+func (o Options) PollIntervalHook(hook managed.PollIntervalHook) managed.PollIntervalHook {
+	return func(mg resource.Managed, pollInterval time.Duration) time.Duration {
+		if hook != nil {
+			pollInterval = hook(mg, pollInterval)
+		}
+		return o.WithJitter(pollInterval)
+	}
+}
+
+// ConnectionPublishers returns the publishers of connection details of managed resources.
+func (o Options) ConnectionPublishers(mgr ctrl.Manager) []managed.ConnectionPublisher {
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.ESS != nil {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), providerv1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESS.TLSConfig)))
+	}
+	return cps
+}
+
+// ForControllerRuntime returns the options for the controller-runtime controller.
+func (o Options) ForControllerRuntime() controller.Options {
+	return controller.Options{
+		MaxConcurrentReconciles: o.MaxConcurrentReconciles,
+	}
+}
@@ -0,0 +1,26 @@
+package controlleropts
+
+import (
+	"testing"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOptions_PollIntervalHook(t *testing.T) {
+	hook := func(_ resource.Managed, pollInterval time.Duration) time.Duration {
+		return pollInterval / 2
+	}
+
+	withoutJitter := Options{}.PollIntervalHook(hook)
+	assert.Equal(t, 30*time.Minute, withoutJitter(nil, time.Hour))
+	assert.Equal(t, time.Hour, Options{}.PollIntervalHook(nil)(nil, time.Hour), "nil hook")
+
+	withJitter := Options{PollJitter: 5 * time.Minute}.PollIntervalHook(hook)
+	for i := 0; i < 100; i++ {
+		interval := withJitter(nil, time.Hour)
+		assert.GreaterOrEqual(t, interval, 25*time.Minute)
+		assert.LessOrEqual(t, interval, 35*time.Minute)
+	}
+}
@@ -0,0 +1,119 @@
+package customimagecontroller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	pipeline "github.com/ccremer/go-command-pipeline"
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	"github.com/vshn/provider-cloudscale/operator/pipelineutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Create implements managed.ExternalClient.
+// It starts the import of the image, the image itself is created by cloudscale.ch once the import has succeeded.
+func (p *CustomImagePipeline) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.Info("Creating resource")
+
+	image := fromManaged(mg)
+	pctx := &pipelineContext{Context: ctx, image: image}
+	pipe := pipeline.NewPipeline[*pipelineContext]()
+	stepMetrics := pipelineutil.NewStepMetrics[*pipelineContext](cloudscalev1.CustomImageKind)
+	pipe.WithBeforeHooks(pipelineutil.DebugLogger(pctx)).
+		WithSteps(stepMetrics.Steps(
+			pipe.NewStep("find import by owner tag", p.findImportByOwnerTag),
+			pipe.When(isImportMissing, "start import", p.startImport),
+			pipe.NewStep("set import annotations", p.setImportAnnotations),
+			pipe.NewStep("emit event", p.emitCreationEvent),
+		)...)
+	err := pipe.RunWithContext(pctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, "cannot create custom image")
+	}
+
+	return managed.ExternalCreation{}, nil
+}
+
+// findImportByOwnerTag searches for an import that has been started by a previous reconciliation of this resource.
+// See servercontroller for why this is needed.
+// Only imports that are still running are adopted, a finished import either failed or its image has been deleted in the meantime.
+func (p *CustomImagePipeline) findImportByOwnerTag(ctx *pipelineContext) error {
+	log := controllerruntime.LoggerFrom(ctx)
+	image := ctx.image
+
+	if image.UID == "" {
+		return nil
+	}
+	csImports, err := p.images.ListImports(ctx, cloudscalesdk.WithTagFilter(cloudscalesdk.TagMap{cloudscaleclient.OwnerTagKey: string(image.UID)}))
+	if err != nil {
+		return errors.Wrap(err, "cannot list imports by owner tag")
+	}
+	running := make([]cloudscaleclient.CustomImageImport, 0, len(csImports))
+	for _, csImport := range csImports {
+		if isImporting(csImport.Status) {
+			running = append(running, csImport)
+		}
+	}
+	switch len(running) {
+	case 0:
+		return nil
+	case 1:
+		ctx.csImport = &running[0]
+		log.V(1).Info("Adopting running import in cloudscale", "uuid", ctx.csImport.UUID, "url", ctx.csImport.URL)
+		return nil
+	default:
+		return fmt.Errorf("found %d running imports with tag %s=%s, expected at most one", len(running), cloudscaleclient.OwnerTagKey, image.UID)
+	}
+}
+
+// startImport starts downloading the image from the URL.
+func (p *CustomImagePipeline) startImport(ctx *pipelineContext) error {
+	log := controllerruntime.LoggerFrom(ctx)
+	image := ctx.image
+	params := image.Spec.ForProvider
+
+	csImport, err := p.images.Import(ctx, &cloudscaleclient.CustomImageImportRequest{
+		URL:              params.URL,
+		Name:             image.GetCustomImageName(),
+		Slug:             params.Slug,
+		UserDataHandling: image.GetUserDataHandling(),
+		FirmwareType:     params.FirmwareType,
+		SourceFormat:     params.SourceFormat,
+		Zones:            params.Zones,
+		TaggedResourceRequest: cloudscalesdk.TaggedResourceRequest{
+			Tags: cloudscaleclient.ToTagMap(cloudscaleclient.DesiredTags(params.Tags, image.UID)),
+		},
+	})
+	if err != nil {
+		return err
+	}
+	log.V(1).Info("Started import in cloudscale", "uuid", csImport.UUID, "url", csImport.URL, "slug", params.Slug)
+	ctx.csImport = csImport
+	return nil
+}
+
+// setImportAnnotations stores the UUID of the started or adopted import and the generation of the spec in annotations.
+// The generation tells whether a failed import should be retried, since the spec has changed in the meantime.
+func (p *CustomImagePipeline) setImportAnnotations(ctx *pipelineContext) error {
+	cloudscaleclient.SetIDAnnotation(&ctx.image.ObjectMeta, ImportUUIDAnnotationKey, ctx.csImport.UUID)
+	metav1.SetMetaDataAnnotation(&ctx.image.ObjectMeta, ImportGenerationAnnotationKey, strconv.FormatInt(ctx.image.Generation, 10))
+	return nil
+}
+
+func (p *CustomImagePipeline) emitCreationEvent(ctx *pipelineContext) error {
+	p.recorder.Event(ctx.image, event.Event{
+		Type:    event.TypeNormal,
+		Reason:  "Created",
+		Message: "Import successfully started",
+	})
+	return nil
+}
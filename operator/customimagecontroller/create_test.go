@@ -0,0 +1,75 @@
+package customimagecontroller
+
+import (
+	"context"
+	"testing"
+
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type fakeImportService struct {
+	cloudscaleclient.CustomImageService
+	imports  []cloudscaleclient.CustomImageImport
+	imported *cloudscaleclient.CustomImageImportRequest
+}
+
+// ListImports implements cloudscaleclient.CustomImageService.
+func (f *fakeImportService) ListImports(_ context.Context, _ ...cloudscalesdk.ListRequestModifier) ([]cloudscaleclient.CustomImageImport, error) {
+	return f.imports, nil
+}
+
+// Import implements cloudscaleclient.CustomImageService.
+func (f *fakeImportService) Import(_ context.Context, req *cloudscaleclient.CustomImageImportRequest) (*cloudscaleclient.CustomImageImport, error) {
+	f.imported = req
+	return &cloudscaleclient.CustomImageImport{UUID: "new-import", URL: req.URL, Status: "started"}, nil
+}
+
+func TestCustomImagePipeline_Create(t *testing.T) {
+	tests := map[string]struct {
+		givenImports []cloudscaleclient.CustomImageImport
+
+		expectedImportUUID string
+		expectImport       bool
+	}{
+		"GivenNoImport_ThenExpectNewImport": {
+			expectedImportUUID: "new-import",
+			expectImport:       true,
+		},
+		"GivenRunningImport_ThenExpectAdopted": {
+			givenImports:       []cloudscaleclient.CustomImageImport{{UUID: "running-import", Status: "in_progress"}},
+			expectedImportUUID: "running-import",
+		},
+		"GivenFailedImport_ThenExpectNewImport": {
+			givenImports:       []cloudscaleclient.CustomImageImport{{UUID: "failed-import", Status: cloudscaleclient.CustomImageImportFailed}},
+			expectedImportUUID: "new-import",
+			expectImport:       true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			svc := &fakeImportService{imports: tc.givenImports}
+			p := NewPipeline(event.NewNopRecorder(), svc)
+			image := &cloudscalev1.CustomImage{ObjectMeta: metav1.ObjectMeta{Name: "my-image", UID: "uid", Generation: 3}}
+			image.Spec.ForProvider.URL = "https://example.com/fixed.raw"
+			image.Spec.ForProvider.Slug = "my-image"
+
+			_, err := p.Create(logr.NewContext(context.Background(), logr.Discard()), image)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedImportUUID, image.Annotations[ImportUUIDAnnotationKey])
+			assert.Equal(t, "3", image.Annotations[ImportGenerationAnnotationKey])
+			if tc.expectImport {
+				require.NotNil(t, svc.imported)
+				assert.Equal(t, "https://example.com/fixed.raw", svc.imported.URL)
+			} else {
+				assert.Nil(t, svc.imported)
+			}
+		})
+	}
+}
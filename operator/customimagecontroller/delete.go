@@ -0,0 +1,64 @@
+package customimagecontroller
+
+import (
+	"context"
+	"fmt"
+
+	pipeline "github.com/ccremer/go-command-pipeline"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	"github.com/vshn/provider-cloudscale/operator/pipelineutil"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Delete implements managed.ExternalClient.
+func (p *CustomImagePipeline) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.Info("Deleting resource")
+
+	image := fromManaged(mg)
+	pctx := &pipelineContext{Context: ctx, image: image}
+	pipe := pipeline.NewPipeline[*pipelineContext]()
+	stepMetrics := pipelineutil.NewStepMetrics[*pipelineContext](cloudscalev1.CustomImageKind)
+	pipe.WithBeforeHooks(pipelineutil.DebugLogger(pctx)).
+		WithSteps(stepMetrics.Steps(
+			pipe.NewStep("delete custom image", p.deleteCustomImage),
+			pipe.NewStep("emit event", p.emitDeletionEvent),
+		)...)
+	err := pipe.RunWithContext(pctx)
+	return managed.ExternalDelete{}, errors.Wrap(err, "cannot deprovision custom image")
+}
+
+// deleteCustomImage deletes the image once the import has finished.
+// A running import can't be aborted, the deletion is retried until the image exists.
+// A failed import leaves nothing to delete, Observe already reports it as not existing.
+func (p *CustomImagePipeline) deleteCustomImage(ctx *pipelineContext) error {
+	log := controllerruntime.LoggerFrom(ctx)
+	obs := ctx.image.Status.AtProvider
+
+	if obs.CustomImageUUID == "" {
+		if !isImporting(obs.ImportStatus) {
+			return nil
+		}
+		return fmt.Errorf("import %s is %s, the image can only be deleted after the import has finished", obs.ImportUUID, obs.ImportStatus)
+	}
+	err := p.images.Delete(ctx, obs.CustomImageUUID)
+	if err != nil {
+		return resource.Ignore(cloudscaleclient.IsNotFound, err)
+	}
+	log.V(1).Info("Deleted custom image in cloudscale", "uuid", obs.CustomImageUUID)
+	return nil
+}
+
+func (p *CustomImagePipeline) emitDeletionEvent(ctx *pipelineContext) error {
+	p.recorder.Event(ctx.image, event.Event{
+		Type:    event.TypeNormal,
+		Reason:  "Deleted",
+		Message: "Custom image deleted",
+	})
+	return nil
+}
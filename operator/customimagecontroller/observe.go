@@ -0,0 +1,156 @@
+package customimagecontroller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Observe implements managed.ExternalClient.
+// Until the import has succeeded, the import is observed, afterwards the custom image.
+func (p *CustomImagePipeline) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.V(1).Info("Observing resource")
+
+	image := fromManaged(mg)
+	obs := &image.Status.AtProvider
+	importUUID := image.Annotations[ImportUUIDAnnotationKey]
+	if importUUID == "" {
+		importUUID = obs.ImportUUID
+	}
+	if importUUID == "" {
+		// New resource, start import first
+		return managed.ExternalObservation{}, nil
+	}
+	if importUUID != obs.ImportUUID {
+		// Create() started a new import, e.g. because the image of the previous import has been deleted.
+		// Create() isn't allowed to update the status, so the image of the previous import is forgotten here.
+		obs.ImportUUID = importUUID
+		obs.ImportStatus = ""
+		obs.CustomImageUUID = ""
+	}
+
+	pctx := &pipelineContext{Context: ctx, image: image}
+	if obs.CustomImageUUID == "" {
+		err := p.getImport(pctx)
+		if err != nil {
+			return managed.ExternalObservation{}, resource.Ignore(cloudscaleclient.IsNotFound, err)
+		}
+		csImport := pctx.csImport
+		obs.ImportStatus = csImport.Status
+		switch csImport.Status {
+		case cloudscaleclient.CustomImageImportSuccess:
+			obs.CustomImageUUID = csImport.CustomImage.UUID
+		case cloudscaleclient.CustomImageImportFailed:
+			image.SetConditions(xpv1.Unavailable().WithMessage("import failed: " + csImport.ErrorMessage))
+			if meta.WasDeleted(image) || isImportOutdated(image) {
+				// A failed import leaves no image behind.
+				// Reporting it as not existing lets a deleted resource go and retries the import with the changed spec.
+				return managed.ExternalObservation{}, nil
+			}
+			return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+		default:
+			image.SetConditions(xpv1.Unavailable().WithMessage("import is " + csImport.Status))
+			return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+		}
+	}
+
+	err := p.getCustomImage(pctx)
+	if err != nil {
+		return managed.ExternalObservation{}, resource.Ignore(cloudscaleclient.IsNotFound, err)
+	}
+
+	csImage := pctx.csImage
+	image.Status.AtProvider = toObservation(csImage, *obs)
+	if msg := checksumMismatch(image, csImage); msg != "" {
+		image.SetConditions(xpv1.Unavailable().WithMessage(msg))
+	} else {
+		image.SetConditions(xpv1.Available())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: isUpToDate(image, csImage),
+	}, nil
+}
+
+// getImport fetches the import from the project associated with the API token.
+func (p *CustomImagePipeline) getImport(ctx *pipelineContext) error {
+	log := controllerruntime.LoggerFrom(ctx)
+
+	csImport, err := p.images.GetImport(ctx, ctx.image.Status.AtProvider.ImportUUID)
+	if err != nil {
+		return err
+	}
+	ctx.csImport = csImport
+	log.V(1).Info("Fetched import in cloudscale", "uuid", csImport.UUID, "status", csImport.Status)
+	return nil
+}
+
+// getCustomImage fetches the imported custom image from the project associated with the API token.
+func (p *CustomImagePipeline) getCustomImage(ctx *pipelineContext) error {
+	log := controllerruntime.LoggerFrom(ctx)
+
+	csImage, err := p.images.Get(ctx, ctx.image.Status.AtProvider.CustomImageUUID)
+	if err != nil {
+		return err
+	}
+	ctx.csImage = csImage
+	log.V(1).Info("Fetched custom image in cloudscale", "uuid", csImage.UUID, "slug", csImage.Slug)
+	return nil
+}
+
+// isImportOutdated returns true if the spec has changed since the import has been started.
+func isImportOutdated(image *cloudscalev1.CustomImage) bool {
+	return image.Annotations[ImportGenerationAnnotationKey] != strconv.FormatInt(image.Generation, 10)
+}
+
+// checksumMismatch returns a message if the SHA-256 checksum given in the spec doesn't match the imported image.
+func checksumMismatch(image *cloudscalev1.CustomImage, csImage *cloudscaleclient.CustomImage) string {
+	expected := image.Spec.ForProvider.SHA256Checksum
+	if expected == "" || expected == csImage.Checksums["sha256"] {
+		return ""
+	}
+	return fmt.Sprintf("sha256 checksum of the imported image is %q, expected %q", csImage.Checksums["sha256"], expected)
+}
+
+// isUpToDate returns true if the name, slug, user data handling and tags of the image match the spec.
+// The URL, firmware type, source format and zones only apply to the import and are ignored.
+func isUpToDate(image *cloudscalev1.CustomImage, csImage *cloudscaleclient.CustomImage) bool {
+	return image.GetCustomImageName() == csImage.Name &&
+		image.Spec.ForProvider.Slug == csImage.Slug &&
+		image.GetUserDataHandling() == csImage.UserDataHandling &&
+		!cloudscaleclient.TagsNeedUpdate(cloudscaleclient.DesiredTags(image.Spec.ForProvider.Tags, image.UID), csImage.Tags)
+}
+
+// toObservation returns the observed fields of the given image.
+// The import fields and the project are taken from the given observation.
+func toObservation(csImage *cloudscaleclient.CustomImage, obs cloudscalev1.CustomImageObservation) cloudscalev1.CustomImageObservation {
+	var zones []string
+	for _, zone := range csImage.Zones {
+		zones = append(zones, zone.Slug)
+	}
+	return cloudscalev1.CustomImageObservation{
+		CustomImageUUID:  csImage.UUID,
+		ImportUUID:       obs.ImportUUID,
+		ImportStatus:     obs.ImportStatus,
+		CustomImageName:  csImage.Name,
+		Slug:             csImage.Slug,
+		ImageSlug:        "custom:" + csImage.Slug,
+		SizeGB:           csImage.SizeGB,
+		Checksums:        csImage.Checksums,
+		UserDataHandling: csImage.UserDataHandling,
+		FirmwareType:     csImage.FirmwareType,
+		Zones:            zones,
+		Tags:             cloudscaleclient.FromTagMap(csImage.Tags),
+		Project:          obs.Project,
+	}
+}
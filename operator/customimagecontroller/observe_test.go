@@ -0,0 +1,174 @@
+package customimagecontroller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type fakeCustomImageService struct {
+	cloudscaleclient.CustomImageService
+	csImport *cloudscaleclient.CustomImageImport
+	csImage  *cloudscaleclient.CustomImage
+}
+
+// GetImport implements cloudscaleclient.CustomImageService.
+func (f *fakeCustomImageService) GetImport(_ context.Context, _ string) (*cloudscaleclient.CustomImageImport, error) {
+	return f.csImport, nil
+}
+
+// Get implements cloudscaleclient.CustomImageService.
+func (f *fakeCustomImageService) Get(_ context.Context, _ string) (*cloudscaleclient.CustomImage, error) {
+	return f.csImage, nil
+}
+
+func TestCustomImagePipeline_Observe(t *testing.T) {
+	importedImage := &cloudscaleclient.CustomImage{
+		UUID: "image", Name: "my-image", Slug: "my-image", SizeGB: 2, UserDataHandling: "pass-through", FirmwareType: "bios",
+		Checksums: map[string]string{"sha256": "abc"},
+		Zones:     []cloudscalesdk.Zone{{Slug: "rma1"}},
+	}
+	tests := map[string]struct {
+		givenAnnotations map[string]string
+		givenGeneration  int64
+		givenDeleted     bool
+		givenChecksum    string
+		givenImport      *cloudscaleclient.CustomImageImport
+		givenImage       *cloudscaleclient.CustomImage
+
+		expectedResult      managed.ExternalObservation
+		expectedReadyStatus corev1.ConditionStatus
+		expectedMessage     string
+		expectedObservation cloudscalev1.CustomImageObservation
+	}{
+		"GivenNewImage_ThenExpectNotExisting": {
+			expectedResult:      managed.ExternalObservation{},
+			expectedReadyStatus: corev1.ConditionUnknown,
+		},
+		"GivenRunningImport_ThenExpectUnavailable": {
+			givenAnnotations:    map[string]string{ImportUUIDAnnotationKey: "import"},
+			givenImport:         &cloudscaleclient.CustomImageImport{UUID: "import", Status: "in_progress"},
+			expectedResult:      managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			expectedReadyStatus: corev1.ConditionFalse,
+			expectedMessage:     "import is in_progress",
+			expectedObservation: cloudscalev1.CustomImageObservation{ImportUUID: "import", ImportStatus: "in_progress"},
+		},
+		"GivenFailedImport_ThenExpectUnavailableWithErrorMessage": {
+			givenAnnotations:    map[string]string{ImportUUIDAnnotationKey: "import", ImportGenerationAnnotationKey: "1"},
+			givenGeneration:     1,
+			givenImport:         &cloudscaleclient.CustomImageImport{UUID: "import", Status: cloudscaleclient.CustomImageImportFailed, ErrorMessage: "Expected HTTP 200, got HTTP 404"},
+			expectedResult:      managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			expectedReadyStatus: corev1.ConditionFalse,
+			expectedMessage:     "import failed: Expected HTTP 200, got HTTP 404",
+			expectedObservation: cloudscalev1.CustomImageObservation{ImportUUID: "import", ImportStatus: cloudscaleclient.CustomImageImportFailed},
+		},
+		"GivenFailedImport_WhenSpecChanged_ThenExpectNotExisting": {
+			givenAnnotations:    map[string]string{ImportUUIDAnnotationKey: "import", ImportGenerationAnnotationKey: "1"},
+			givenGeneration:     2,
+			givenImport:         &cloudscaleclient.CustomImageImport{UUID: "import", Status: cloudscaleclient.CustomImageImportFailed, ErrorMessage: "Expected HTTP 200, got HTTP 404"},
+			expectedResult:      managed.ExternalObservation{},
+			expectedReadyStatus: corev1.ConditionFalse,
+			expectedMessage:     "import failed: Expected HTTP 200, got HTTP 404",
+			expectedObservation: cloudscalev1.CustomImageObservation{ImportUUID: "import", ImportStatus: cloudscaleclient.CustomImageImportFailed},
+		},
+		"GivenFailedImport_WhenDeleted_ThenExpectNotExisting": {
+			givenAnnotations:    map[string]string{ImportUUIDAnnotationKey: "import", ImportGenerationAnnotationKey: "1"},
+			givenGeneration:     1,
+			givenDeleted:        true,
+			givenImport:         &cloudscaleclient.CustomImageImport{UUID: "import", Status: cloudscaleclient.CustomImageImportFailed, ErrorMessage: "Expected HTTP 200, got HTTP 404"},
+			expectedResult:      managed.ExternalObservation{},
+			expectedReadyStatus: corev1.ConditionFalse,
+			expectedMessage:     "import failed: Expected HTTP 200, got HTTP 404",
+			expectedObservation: cloudscalev1.CustomImageObservation{ImportUUID: "import", ImportStatus: cloudscaleclient.CustomImageImportFailed},
+		},
+		"GivenSuccessfulImport_ThenExpectAvailableWithImageSlug": {
+			givenAnnotations: map[string]string{ImportUUIDAnnotationKey: "import"},
+			givenChecksum:    "abc",
+			givenImport: &cloudscaleclient.CustomImageImport{
+				UUID: "import", Status: cloudscaleclient.CustomImageImportSuccess,
+				CustomImage: cloudscalesdk.CustomImageStub{UUID: "image"},
+			},
+			givenImage:          importedImage,
+			expectedResult:      managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			expectedReadyStatus: corev1.ConditionTrue,
+			expectedObservation: cloudscalev1.CustomImageObservation{
+				CustomImageUUID: "image", ImportUUID: "import", ImportStatus: cloudscaleclient.CustomImageImportSuccess,
+				CustomImageName: "my-image", Slug: "my-image", ImageSlug: "custom:my-image", SizeGB: 2,
+				Checksums: map[string]string{"sha256": "abc"}, UserDataHandling: "pass-through", FirmwareType: "bios",
+				Zones: []string{"rma1"}, Tags: cloudscalev1.Tags{},
+			},
+		},
+		"GivenSuccessfulImport_WhenChecksumDiffers_ThenExpectUnavailable": {
+			givenAnnotations: map[string]string{ImportUUIDAnnotationKey: "import"},
+			givenChecksum:    "def",
+			givenImport: &cloudscaleclient.CustomImageImport{
+				UUID: "import", Status: cloudscaleclient.CustomImageImportSuccess,
+				CustomImage: cloudscalesdk.CustomImageStub{UUID: "image"},
+			},
+			givenImage:          importedImage,
+			expectedResult:      managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			expectedReadyStatus: corev1.ConditionFalse,
+			expectedMessage:     `sha256 checksum of the imported image is "abc", expected "def"`,
+			expectedObservation: cloudscalev1.CustomImageObservation{
+				CustomImageUUID: "image", ImportUUID: "import", ImportStatus: cloudscaleclient.CustomImageImportSuccess,
+				CustomImageName: "my-image", Slug: "my-image", ImageSlug: "custom:my-image", SizeGB: 2,
+				Checksums: map[string]string{"sha256": "abc"}, UserDataHandling: "pass-through", FirmwareType: "bios",
+				Zones: []string{"rma1"}, Tags: cloudscalev1.Tags{},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			p := NewPipeline(nil, &fakeCustomImageService{csImport: tc.givenImport, csImage: tc.givenImage})
+			image := &cloudscalev1.CustomImage{ObjectMeta: metav1.ObjectMeta{Name: "my-image", Annotations: tc.givenAnnotations, Generation: tc.givenGeneration}}
+			if tc.givenDeleted {
+				image.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+			}
+			image.Spec.ForProvider.Slug = "my-image"
+			image.Spec.ForProvider.SHA256Checksum = tc.givenChecksum
+
+			result, err := p.Observe(logr.NewContext(context.Background(), logr.Discard()), image)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, result)
+			assert.Equal(t, tc.expectedReadyStatus, image.GetCondition(xpv1.TypeReady).Status)
+			assert.Equal(t, tc.expectedMessage, image.GetCondition(xpv1.TypeReady).Message)
+			assert.Equal(t, tc.expectedObservation, image.Status.AtProvider)
+		})
+	}
+}
+
+func TestCustomImagePipeline_Observe_GivenNewImport_ThenExpectPreviousImageForgotten(t *testing.T) {
+	p := NewPipeline(nil, &fakeCustomImageService{csImport: &cloudscaleclient.CustomImageImport{UUID: "new-import", Status: "started"}})
+	image := &cloudscalev1.CustomImage{ObjectMeta: metav1.ObjectMeta{Name: "my-image", Annotations: map[string]string{ImportUUIDAnnotationKey: "new-import"}}}
+	image.Status.AtProvider = cloudscalev1.CustomImageObservation{
+		CustomImageUUID: "deleted-image", ImportUUID: "old-import", ImportStatus: cloudscaleclient.CustomImageImportSuccess,
+	}
+
+	result, err := p.Observe(logr.NewContext(context.Background(), logr.Discard()), image)
+	require.NoError(t, err)
+	assert.Equal(t, managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, result)
+	assert.Equal(t, cloudscalev1.CustomImageObservation{ImportUUID: "new-import", ImportStatus: "started"}, image.Status.AtProvider)
+}
+
+func TestPendingPollInterval(t *testing.T) {
+	image := &cloudscalev1.CustomImage{}
+	image.Status.AtProvider.ImportStatus = "in_progress"
+	assert.Equal(t, PendingPollInterval, pendingPollInterval(image, time.Hour))
+
+	image.Status.AtProvider.ImportStatus = cloudscaleclient.CustomImageImportSuccess
+	assert.Equal(t, time.Hour, pendingPollInterval(image, time.Hour))
+
+	image.Status.AtProvider.ImportStatus = cloudscaleclient.CustomImageImportFailed
+	assert.Equal(t, time.Hour, pendingPollInterval(image, time.Hour))
+}
@@ -0,0 +1,66 @@
+package customimagecontroller
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+)
+
+const (
+	// ImportUUIDAnnotationKey is the annotation key where the UUID of the import is stored.
+	ImportUUIDAnnotationKey = "cloudscale.crossplane.io/custom-image-import-uuid"
+	// ImportGenerationAnnotationKey is the annotation key where the generation of the spec is stored that the import has been started with.
+	ImportGenerationAnnotationKey = "cloudscale.crossplane.io/custom-image-import-generation"
+)
+
+// CustomImagePipeline provisions CustomImages on cloudscale.ch
+type CustomImagePipeline struct {
+	recorder event.Recorder
+	images   cloudscaleclient.CustomImageService
+}
+
+type pipelineContext struct {
+	context.Context
+	image    *cloudscalev1.CustomImage
+	csImport *cloudscaleclient.CustomImageImport
+	csImage  *cloudscaleclient.CustomImage
+}
+
+// ParentContext implements tracing.Context.
+func (c *pipelineContext) ParentContext() context.Context {
+	return c.Context
+}
+
+// SetParentContext implements tracing.Context.
+func (c *pipelineContext) SetParentContext(ctx context.Context) {
+	c.Context = ctx
+}
+
+// NewPipeline returns a new instance of CustomImagePipeline.
+func NewPipeline(recorder event.Recorder, images cloudscaleclient.CustomImageService) *CustomImagePipeline {
+	return &CustomImagePipeline{
+		recorder: recorder,
+		images:   images,
+	}
+}
+
+// Disconnect implements managed.ExternalClient.
+func (p *CustomImagePipeline) Disconnect(_ context.Context) error {
+	return nil
+}
+
+func isImportMissing(ctx *pipelineContext) bool {
+	return ctx.csImport == nil
+}
+
+// isImporting returns true if the import hasn't finished yet.
+func isImporting(importStatus string) bool {
+	return importStatus != cloudscaleclient.CustomImageImportSuccess && importStatus != cloudscaleclient.CustomImageImportFailed
+}
+
+func fromManaged(mg resource.Managed) *cloudscalev1.CustomImage {
+	return mg.(*cloudscalev1.CustomImage)
+}
@@ -0,0 +1,59 @@
+package customimagecontroller
+
+import (
+	"strings"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	"github.com/vshn/provider-cloudscale/operator/controlleropts"
+	"github.com/vshn/provider-cloudscale/operator/ratelimit"
+	"github.com/vshn/provider-cloudscale/operator/tracing"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+)
+
+// DefaultPollInterval is the default interval in which custom images are observed again.
+// Images rarely change once they've been imported.
+const DefaultPollInterval = 1 * time.Hour
+
+// PendingPollInterval is the interval in which custom images are observed again until the import has finished.
+const PendingPollInterval = 30 * time.Second
+
+// SetupController adds a controller that reconciles cloudscalev1.CustomImage managed resources.
+func SetupController(mgr ctrl.Manager, opts controlleropts.Options) error {
+	name := strings.ToLower(cloudscalev1.CustomImageGroupKind)
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+	throttler := ratelimit.NewThrottler()
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(cloudscalev1.CustomImageGroupVersionKind),
+		managed.WithExternalConnecter(throttler.WrapConnecter(&customImageConnector{
+			recorder:  recorder,
+			connector: cloudscaleclient.NewConnector(mgr.GetClient(), cloudscalev1.CustomImageKind),
+		})),
+		managed.WithLogger(logging.NewLogrLogger(mgr.GetLogger().WithValues("controller", name))),
+		managed.WithRecorder(recorder),
+		managed.WithPollIntervalHook(opts.PollIntervalHook(pendingPollInterval)),
+		managed.WithPollInterval(opts.PollInterval),
+		managed.WithConnectionPublishers(opts.ConnectionPublishers(mgr)...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&cloudscalev1.CustomImage{}, builder.WithPredicates(opts.Predicates...)).
+		WithOptions(opts.ForControllerRuntime()).
+		Complete(tracing.NewReconciler(cloudscalev1.CustomImageKind, throttler.WrapReconciler(r)))
+}
+
+// pendingPollInterval returns a shorter interval while the import is running, so that the image becomes ready soon after it has been imported.
+func pendingPollInterval(mg resource.Managed, pollInterval time.Duration) time.Duration {
+	if isImporting(fromManaged(mg).Status.AtProvider.ImportStatus) {
+		return min(PendingPollInterval, pollInterval)
+	}
+	return pollInterval
+}
@@ -0,0 +1,55 @@
+package customimagecontroller
+
+import (
+	"context"
+
+	pipeline "github.com/ccremer/go-command-pipeline"
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	"github.com/vshn/provider-cloudscale/operator/pipelineutil"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Update implements managed.ExternalClient.
+func (p *CustomImagePipeline) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.Info("Updating resource")
+	image := fromManaged(mg)
+
+	pctx := &pipelineContext{Context: ctx, image: image}
+	pipe := pipeline.NewPipeline[*pipelineContext]()
+	stepMetrics := pipelineutil.NewStepMetrics[*pipelineContext](cloudscalev1.CustomImageKind)
+	pipe.WithBeforeHooks(pipelineutil.DebugLogger(pctx)).
+		WithSteps(stepMetrics.Steps(
+			pipe.NewStep("update custom image", p.updateCustomImage),
+		)...)
+	err := pipe.RunWithContext(pctx)
+
+	return managed.ExternalUpdate{}, errors.Wrap(err, "cannot update custom image")
+}
+
+// updateCustomImage updates the name, slug, user data handling and tags of the image identified by UUID.
+func (p *CustomImagePipeline) updateCustomImage(ctx *pipelineContext) error {
+	log := controllerruntime.LoggerFrom(ctx)
+	image := ctx.image
+	uuid := image.Status.AtProvider.CustomImageUUID
+	tags := cloudscaleclient.DesiredTags(image.Spec.ForProvider.Tags, image.UID)
+
+	err := p.images.Update(ctx, uuid, &cloudscalesdk.CustomImageRequest{
+		Name:             image.GetCustomImageName(),
+		Slug:             image.Spec.ForProvider.Slug,
+		UserDataHandling: image.GetUserDataHandling(),
+		TaggedResourceRequest: cloudscalesdk.TaggedResourceRequest{
+			Tags: cloudscaleclient.ToTagMap(tags),
+		},
+	})
+	if err != nil {
+		return err
+	}
+	log.V(1).Info("Updated custom image in cloudscale", "uuid", uuid, "name", image.GetCustomImageName(), "slug", image.Spec.ForProvider.Slug, "tags", tags)
+	return nil
+}
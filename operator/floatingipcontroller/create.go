@@ -0,0 +1,114 @@
+package floatingipcontroller
+
+import (
+	"context"
+	"fmt"
+
+	pipeline "github.com/ccremer/go-command-pipeline"
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	"github.com/vshn/provider-cloudscale/operator/pipelineutil"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Create implements managed.ExternalClient.
+func (p *FloatingIPPipeline) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.Info("Creating resource")
+
+	floatingIP := fromManaged(mg)
+	if floatingIP.Status.AtProvider.Network != "" {
+		// Floating IP already exists
+		return managed.ExternalCreation{}, nil
+	}
+
+	pctx := &pipelineContext{Context: ctx, floatingIP: floatingIP}
+	pipe := pipeline.NewPipeline[*pipelineContext]()
+	stepMetrics := pipelineutil.NewStepMetrics[*pipelineContext](cloudscalev1.FloatingIPKind)
+	pipe.WithBeforeHooks(pipelineutil.DebugLogger(pctx)).
+		WithSteps(stepMetrics.Steps(
+			pipe.NewStep("check target", checkTarget),
+			pipe.NewStep("find floating IP by owner tag", p.findFloatingIPByOwnerTag),
+			pipe.When(isFloatingIPMissing, "create floating IP", p.createFloatingIP),
+			pipe.NewStep("set floating IP network annotation", p.setFloatingIPNetworkAnnotation),
+			pipe.NewStep("emit event", p.emitCreationEvent),
+		)...)
+	err := pipe.RunWithContext(pctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, "cannot create floating IP")
+	}
+
+	return managed.ExternalCreation{ConnectionDetails: toConnectionDetails(pctx.csFloatingIP)}, nil
+}
+
+// findFloatingIPByOwnerTag searches for a floating IP that has been created by a previous reconciliation of this resource.
+// See servercontroller for why this is needed.
+// If exactly one floating IP is found, it is adopted.
+func (p *FloatingIPPipeline) findFloatingIPByOwnerTag(ctx *pipelineContext) error {
+	log := controllerruntime.LoggerFrom(ctx)
+	floatingIP := ctx.floatingIP
+
+	if floatingIP.UID == "" {
+		return nil
+	}
+	csFloatingIPs, err := p.floatingIPs.List(ctx, cloudscalesdk.WithTagFilter(cloudscalesdk.TagMap{cloudscaleclient.OwnerTagKey: string(floatingIP.UID)}))
+	if err != nil {
+		return errors.Wrap(err, "cannot list floating IPs by owner tag")
+	}
+	switch len(csFloatingIPs) {
+	case 0:
+		return nil
+	case 1:
+		ctx.csFloatingIP = &csFloatingIPs[0]
+		log.V(1).Info("Adopting existing floating IP in cloudscale", "network", ctx.csFloatingIP.Network)
+		return nil
+	default:
+		return fmt.Errorf("found %d floating IPs with tag %s=%s, expected at most one", len(csFloatingIPs), cloudscaleclient.OwnerTagKey, floatingIP.UID)
+	}
+}
+
+// createFloatingIP allocates a new floating IP in the project associated with the API token and assigns it to the desired server or load balancer.
+func (p *FloatingIPPipeline) createFloatingIP(ctx *pipelineContext) error {
+	log := controllerruntime.LoggerFrom(ctx)
+	floatingIP := ctx.floatingIP
+	params := floatingIP.Spec.ForProvider
+
+	csFloatingIP, err := p.floatingIPs.Create(ctx, &cloudscaleclient.FloatingIPCreateRequest{
+		RegionalResourceRequest: cloudscalesdk.RegionalResourceRequest{Region: params.Region},
+		TaggedResourceRequest: cloudscalesdk.TaggedResourceRequest{
+			Tags: cloudscaleclient.ToTagMap(cloudscaleclient.DesiredTags(params.Tags, floatingIP.UID)),
+		},
+		IPVersion:      params.IPVersion,
+		Type:           string(params.Type),
+		PrefixLength:   params.PrefixLength,
+		ReversePointer: params.ReversePointer,
+		Server:         params.ServerUUID,
+		LoadBalancer:   params.LoadBalancerUUID,
+	})
+	if err != nil {
+		return err
+	}
+	log.V(1).Info("Created floating IP in cloudscale", "network", csFloatingIP.Network)
+	ctx.csFloatingIP = csFloatingIP
+	return nil
+}
+
+// setFloatingIPNetworkAnnotation stores the network of the created or adopted floating IP in an annotation.
+func (p *FloatingIPPipeline) setFloatingIPNetworkAnnotation(ctx *pipelineContext) error {
+	cloudscaleclient.SetIDAnnotation(&ctx.floatingIP.ObjectMeta, FloatingIPNetworkAnnotationKey, ctx.csFloatingIP.Network)
+	return nil
+}
+
+func (p *FloatingIPPipeline) emitCreationEvent(ctx *pipelineContext) error {
+	p.recorder.Event(ctx.floatingIP, event.Event{
+		Type:    event.TypeNormal,
+		Reason:  "Created",
+		Message: fmt.Sprintf("Floating IP %s successfully created", ctx.csFloatingIP.Network),
+	})
+	return nil
+}
@@ -0,0 +1,55 @@
+package floatingipcontroller
+
+import (
+	"context"
+
+	pipeline "github.com/ccremer/go-command-pipeline"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	"github.com/vshn/provider-cloudscale/operator/pipelineutil"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Delete implements managed.ExternalClient.
+func (p *FloatingIPPipeline) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.Info("Deleting resource")
+
+	floatingIP := fromManaged(mg)
+	pctx := &pipelineContext{Context: ctx, floatingIP: floatingIP}
+	pipe := pipeline.NewPipeline[*pipelineContext]()
+	stepMetrics := pipelineutil.NewStepMetrics[*pipelineContext](cloudscalev1.FloatingIPKind)
+	pipe.WithBeforeHooks(pipelineutil.DebugLogger(pctx)).
+		WithSteps(stepMetrics.Steps(
+			pipe.NewStep("delete floating IP", p.deleteFloatingIP),
+			pipe.NewStep("emit event", p.emitDeletionEvent),
+		)...)
+	err := pipe.RunWithContext(pctx)
+	return managed.ExternalDelete{}, errors.Wrap(err, "cannot deprovision floating IP")
+}
+
+// deleteFloatingIP releases the floating IP, which also unassigns it.
+func (p *FloatingIPPipeline) deleteFloatingIP(ctx *pipelineContext) error {
+	log := controllerruntime.LoggerFrom(ctx)
+	network := ctx.floatingIP.Status.AtProvider.Network
+
+	err := p.floatingIPs.Delete(ctx, addressOf(network))
+	if err != nil {
+		return resource.Ignore(cloudscaleclient.IsNotFound, err)
+	}
+	log.V(1).Info("Deleted floating IP in cloudscale", "network", network)
+	return nil
+}
+
+func (p *FloatingIPPipeline) emitDeletionEvent(ctx *pipelineContext) error {
+	p.recorder.Event(ctx.floatingIP, event.Event{
+		Type:    event.TypeNormal,
+		Reason:  "Deleted",
+		Message: "Floating IP deleted",
+	})
+	return nil
+}
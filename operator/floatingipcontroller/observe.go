@@ -0,0 +1,97 @@
+package floatingipcontroller
+
+import (
+	"context"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Observe implements managed.ExternalClient.
+func (p *FloatingIPPipeline) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.V(1).Info("Observing resource")
+
+	floatingIP := fromManaged(mg)
+	if floatingIP.Status.AtProvider.Network == "" {
+		if network, exists := cloudscaleclient.IDFromAnnotation(floatingIP, FloatingIPNetworkAnnotationKey); exists {
+			floatingIP.Status.AtProvider.Network = network
+		} else {
+			// New resource, create floating IP first
+			return managed.ExternalObservation{}, nil
+		}
+	}
+
+	pctx := &pipelineContext{Context: ctx, floatingIP: floatingIP}
+	err := p.getFloatingIP(pctx)
+	if err != nil {
+		return managed.ExternalObservation{}, resource.Ignore(cloudscaleclient.IsNotFound, err)
+	}
+
+	csFloatingIP := pctx.csFloatingIP
+	floatingIP.Status.AtProvider = toObservation(csFloatingIP, floatingIP.Status.AtProvider.Project)
+	floatingIP.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  isUpToDate(floatingIP, csFloatingIP),
+		ConnectionDetails: toConnectionDetails(csFloatingIP),
+	}, nil
+}
+
+// getFloatingIP fetches an existing floating IP from the project associated with the API token.
+func (p *FloatingIPPipeline) getFloatingIP(ctx *pipelineContext) error {
+	log := controllerruntime.LoggerFrom(ctx)
+
+	csFloatingIP, err := p.floatingIPs.Get(ctx, addressOf(ctx.floatingIP.Status.AtProvider.Network))
+	if err != nil {
+		return err
+	}
+	ctx.csFloatingIP = csFloatingIP
+	log.V(1).Info("Fetched floating IP in cloudscale", "network", csFloatingIP.Network, "nextHop", csFloatingIP.NextHop)
+	return nil
+}
+
+// isUpToDate returns true if the updatable fields of the floating IP match the spec.
+// Fields that can't be changed after creation are ignored, and an empty reverse pointer accepts the one chosen by cloudscale.ch.
+// A floating IP without a desired target is left assigned to wherever it has been assigned.
+func isUpToDate(floatingIP *cloudscalev1.FloatingIP, csFloatingIP *cloudscaleclient.FloatingIP) bool {
+	params := floatingIP.Spec.ForProvider
+	obs := floatingIP.Status.AtProvider
+	return (params.ReversePointer == "" || params.ReversePointer == csFloatingIP.ReversePointer) &&
+		!cloudscaleclient.TagsNeedUpdate(cloudscaleclient.DesiredTags(params.Tags, floatingIP.UID), csFloatingIP.Tags) &&
+		!isReassignmentRequested(params, obs)
+}
+
+// isReassignmentRequested returns true if the floating IP should be assigned to another server or load balancer.
+func isReassignmentRequested(params cloudscalev1.FloatingIPParameters, obs cloudscalev1.FloatingIPObservation) bool {
+	return (params.ServerUUID != "" && params.ServerUUID != obs.ServerUUID) ||
+		(params.LoadBalancerUUID != "" && params.LoadBalancerUUID != obs.LoadBalancerUUID)
+}
+
+// toObservation returns the observed fields of the given floating IP.
+func toObservation(csFloatingIP *cloudscaleclient.FloatingIP, project string) cloudscalev1.FloatingIPObservation {
+	obs := cloudscalev1.FloatingIPObservation{
+		Network:        csFloatingIP.Network,
+		IPVersion:      csFloatingIP.IPVersion,
+		Type:           cloudscalev1.FloatingIPType(csFloatingIP.Type),
+		NextHop:        csFloatingIP.NextHop,
+		ReversePointer: csFloatingIP.ReversePointer,
+		Tags:           cloudscaleclient.FromTagMap(csFloatingIP.Tags),
+		Project:        project,
+	}
+	if csFloatingIP.Region != nil {
+		obs.Region = csFloatingIP.Region.Slug
+	}
+	if csFloatingIP.Server != nil {
+		obs.ServerUUID = csFloatingIP.Server.UUID
+	}
+	if csFloatingIP.LoadBalancer != nil {
+		obs.LoadBalancerUUID = csFloatingIP.LoadBalancer.UUID
+	}
+	return obs
+}
@@ -0,0 +1,53 @@
+package floatingipcontroller
+
+import (
+	"testing"
+
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/stretchr/testify/assert"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+)
+
+func TestToObservation(t *testing.T) {
+	tests := map[string]struct {
+		givenFloatingIP     *cloudscaleclient.FloatingIP
+		expectedObservation cloudscalev1.FloatingIPObservation
+	}{
+		"GivenRegionalIPv4_WhenAssignedToServer_ThenExpectRegionAndServer": {
+			givenFloatingIP: &cloudscaleclient.FloatingIP{
+				Network: "192.0.2.1/32", IPVersion: 4, Type: "regional", NextHop: "198.51.100.1",
+				Region: &cloudscalesdk.Region{Slug: "lpg"},
+				Server: &cloudscalesdk.ServerStub{UUID: "server"},
+			},
+			expectedObservation: cloudscalev1.FloatingIPObservation{
+				Network: "192.0.2.1/32", IPVersion: 4, Type: cloudscalev1.FloatingIPRegional, NextHop: "198.51.100.1",
+				Region: "lpg", ServerUUID: "server", Tags: cloudscalev1.Tags{}, Project: "project",
+			},
+		},
+		"GivenGlobalIPv6_WhenAssignedToLoadBalancer_ThenExpectLoadBalancer": {
+			givenFloatingIP: &cloudscaleclient.FloatingIP{
+				Network: "2001:db8::/56", IPVersion: 6, Type: "global",
+				LoadBalancer: &cloudscaleclient.FloatingIPLoadBalancer{UUID: "lb"},
+			},
+			expectedObservation: cloudscalev1.FloatingIPObservation{
+				Network: "2001:db8::/56", IPVersion: 6, Type: cloudscalev1.FloatingIPGlobal,
+				LoadBalancerUUID: "lb", Tags: cloudscalev1.Tags{}, Project: "project",
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expectedObservation, toObservation(tc.givenFloatingIP, "project"))
+		})
+	}
+}
+
+func TestToConnectionDetails(t *testing.T) {
+	result := toConnectionDetails(&cloudscaleclient.FloatingIP{Network: "192.0.2.1/32"})
+	assert.Equal(t, managed.ConnectionDetails{
+		cloudscalev1.FloatingIPAddressName: []byte("192.0.2.1"),
+		cloudscalev1.FloatingIPNetworkName: []byte("192.0.2.1/32"),
+	}, result)
+}
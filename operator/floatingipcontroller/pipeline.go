@@ -0,0 +1,83 @@
+package floatingipcontroller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+)
+
+const (
+	// FloatingIPNetworkAnnotationKey is the annotation key where the network of the floating IP is stored.
+	// Floating IPs have no UUID, they are identified by their address.
+	FloatingIPNetworkAnnotationKey = "cloudscale.crossplane.io/floating-ip-network"
+)
+
+// FloatingIPPipeline provisions FloatingIPs on cloudscale.ch
+type FloatingIPPipeline struct {
+	recorder    event.Recorder
+	floatingIPs cloudscaleclient.FloatingIPService
+}
+
+type pipelineContext struct {
+	context.Context
+	floatingIP   *cloudscalev1.FloatingIP
+	csFloatingIP *cloudscaleclient.FloatingIP
+}
+
+// ParentContext implements tracing.Context.
+func (c *pipelineContext) ParentContext() context.Context {
+	return c.Context
+}
+
+// SetParentContext implements tracing.Context.
+func (c *pipelineContext) SetParentContext(ctx context.Context) {
+	c.Context = ctx
+}
+
+// NewPipeline returns a new instance of FloatingIPPipeline.
+func NewPipeline(recorder event.Recorder, floatingIPs cloudscaleclient.FloatingIPService) *FloatingIPPipeline {
+	return &FloatingIPPipeline{
+		recorder:    recorder,
+		floatingIPs: floatingIPs,
+	}
+}
+
+// Disconnect implements managed.ExternalClient.
+func (p *FloatingIPPipeline) Disconnect(_ context.Context) error {
+	return nil
+}
+
+func isFloatingIPMissing(ctx *pipelineContext) bool {
+	return ctx.csFloatingIP == nil
+}
+
+// checkTarget returns an error if the floating IP should be assigned to both a server and a load balancer.
+func checkTarget(ctx *pipelineContext) error {
+	params := ctx.floatingIP.Spec.ForProvider
+	if params.ServerUUID != "" && params.LoadBalancerUUID != "" {
+		return fmt.Errorf("floating IP can be assigned to either server %s or load balancer %s, not both", params.ServerUUID, params.LoadBalancerUUID)
+	}
+	return nil
+}
+
+// addressOf returns the address of the given network without the prefix length, which identifies the floating IP in the API.
+func addressOf(network string) string {
+	return strings.Split(network, "/")[0]
+}
+
+func toConnectionDetails(csFloatingIP *cloudscaleclient.FloatingIP) managed.ConnectionDetails {
+	return managed.ConnectionDetails{
+		cloudscalev1.FloatingIPAddressName: []byte(csFloatingIP.IP()),
+		cloudscalev1.FloatingIPNetworkName: []byte(csFloatingIP.Network),
+	}
+}
+
+func fromManaged(mg resource.Managed) *cloudscalev1.FloatingIP {
+	return mg.(*cloudscalev1.FloatingIP)
+}
@@ -0,0 +1,81 @@
+package floatingipcontroller
+
+import (
+	"context"
+	"fmt"
+
+	pipeline "github.com/ccremer/go-command-pipeline"
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	"github.com/vshn/provider-cloudscale/operator/pipelineutil"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Update implements managed.ExternalClient.
+func (p *FloatingIPPipeline) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.Info("Updating resource")
+	floatingIP := fromManaged(mg)
+
+	pctx := &pipelineContext{Context: ctx, floatingIP: floatingIP}
+	pipe := pipeline.NewPipeline[*pipelineContext]()
+	stepMetrics := pipelineutil.NewStepMetrics[*pipelineContext](cloudscalev1.FloatingIPKind)
+	pipe.WithBeforeHooks(pipelineutil.DebugLogger(pctx)).
+		WithSteps(stepMetrics.Steps(
+			pipe.NewStep("check target", checkTarget),
+			pipe.NewStep("update floating IP", p.updateFloatingIP),
+			pipe.When(hasReassignmentRequest, "emit event", p.emitReassignmentEvent),
+		)...)
+	err := pipe.RunWithContext(pctx)
+
+	return managed.ExternalUpdate{}, errors.Wrap(err, "cannot update floating IP")
+}
+
+func hasReassignmentRequest(ctx *pipelineContext) bool {
+	return isReassignmentRequested(ctx.floatingIP.Spec.ForProvider, ctx.floatingIP.Status.AtProvider)
+}
+
+// updateFloatingIP updates the reverse pointer and tags of the floating IP.
+// The server or load balancer is only sent if it changed, which reassigns the floating IP without allocating a new address.
+func (p *FloatingIPPipeline) updateFloatingIP(ctx *pipelineContext) error {
+	log := controllerruntime.LoggerFrom(ctx)
+	floatingIP := ctx.floatingIP
+	params := floatingIP.Spec.ForProvider
+	network := floatingIP.Status.AtProvider.Network
+	tags := cloudscaleclient.DesiredTags(params.Tags, floatingIP.UID)
+
+	req := &cloudscaleclient.FloatingIPUpdateRequest{
+		TaggedResourceRequest: cloudscalesdk.TaggedResourceRequest{
+			Tags: cloudscaleclient.ToTagMap(tags),
+		},
+		ReversePointer: params.ReversePointer,
+	}
+	if hasReassignmentRequest(ctx) {
+		req.Server = params.ServerUUID
+		req.LoadBalancer = params.LoadBalancerUUID
+	}
+	if err := p.floatingIPs.Update(ctx, addressOf(network), req); err != nil {
+		return err
+	}
+	log.V(1).Info("Updated floating IP in cloudscale", "network", network, "server", req.Server, "loadBalancer", req.LoadBalancer, "tags", tags)
+	return nil
+}
+
+func (p *FloatingIPPipeline) emitReassignmentEvent(ctx *pipelineContext) error {
+	params := ctx.floatingIP.Spec.ForProvider
+	target := "server " + params.ServerUUID
+	if params.LoadBalancerUUID != "" {
+		target = "load balancer " + params.LoadBalancerUUID
+	}
+	p.recorder.Event(ctx.floatingIP, event.Event{
+		Type:    event.TypeNormal,
+		Reason:  "Reassigned",
+		Message: fmt.Sprintf("Floating IP reassigned to %s", target),
+	})
+	return nil
+}
@@ -0,0 +1,95 @@
+package floatingipcontroller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	"github.com/vshn/provider-cloudscale/operator/operatortest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type fakeFloatingIPService struct {
+	cloudscaleclient.FloatingIPService
+	givenIP       string
+	givenRequests []*cloudscaleclient.FloatingIPUpdateRequest
+}
+
+// Update implements cloudscaleclient.FloatingIPService.
+func (f *fakeFloatingIPService) Update(_ context.Context, ip string, updateRequest *cloudscaleclient.FloatingIPUpdateRequest) error {
+	f.givenIP = ip
+	f.givenRequests = append(f.givenRequests, updateRequest)
+	return nil
+}
+
+func TestFloatingIPPipeline_Update(t *testing.T) {
+	tests := map[string]struct {
+		givenParams cloudscalev1.FloatingIPParameters
+		givenStatus cloudscalev1.FloatingIPObservation
+
+		expectedServer       string
+		expectedLoadBalancer string
+		expectedEvents       []string
+		expectedError        string
+	}{
+		"GivenSameServer_ThenExpectNoReassignment": {
+			givenParams: cloudscalev1.FloatingIPParameters{ServerUUID: "server", ReversePointer: "www.example.com"},
+			givenStatus: cloudscalev1.FloatingIPObservation{ServerUUID: "server"},
+		},
+		"GivenNoTarget_ThenExpectNoReassignment": {
+			givenParams: cloudscalev1.FloatingIPParameters{},
+			givenStatus: cloudscalev1.FloatingIPObservation{ServerUUID: "server"},
+		},
+		"GivenOtherServer_ThenExpectReassignedToServer": {
+			givenParams:    cloudscalev1.FloatingIPParameters{ServerUUID: "other"},
+			givenStatus:    cloudscalev1.FloatingIPObservation{ServerUUID: "server"},
+			expectedServer: "other",
+			expectedEvents: []string{"Floating IP reassigned to server other"},
+		},
+		"GivenLoadBalancer_WhenAssignedToServer_ThenExpectReassignedToLoadBalancer": {
+			givenParams:          cloudscalev1.FloatingIPParameters{LoadBalancerUUID: "lb"},
+			givenStatus:          cloudscalev1.FloatingIPObservation{ServerUUID: "server"},
+			expectedLoadBalancer: "lb",
+			expectedEvents:       []string{"Floating IP reassigned to load balancer lb"},
+		},
+		"GivenServerAndLoadBalancer_ThenExpectError": {
+			givenParams:   cloudscalev1.FloatingIPParameters{ServerUUID: "server", LoadBalancerUUID: "lb"},
+			expectedError: "cannot update floating IP: step 'check target' failed: floating IP can be assigned to either server server or load balancer lb, not both",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			svc := &fakeFloatingIPService{}
+			recorder := &operatortest.Recorder{}
+			p := NewPipeline(recorder, svc)
+			tc.givenStatus.Network = "192.0.2.1/32"
+			floatingIP := &cloudscalev1.FloatingIP{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-floating-ip"},
+				Spec:       cloudscalev1.FloatingIPSpec{ForProvider: tc.givenParams},
+				Status:     cloudscalev1.FloatingIPStatus{AtProvider: tc.givenStatus},
+			}
+
+			_, err := p.Update(logr.NewContext(context.Background(), logr.Discard()), floatingIP)
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				assert.Empty(t, svc.givenRequests)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, svc.givenRequests, 1)
+			assert.Equal(t, "192.0.2.1", svc.givenIP)
+			assert.Equal(t, tc.givenParams.ReversePointer, svc.givenRequests[0].ReversePointer)
+			assert.Equal(t, tc.expectedServer, svc.givenRequests[0].Server)
+			assert.Equal(t, tc.expectedLoadBalancer, svc.givenRequests[0].LoadBalancer)
+			var messages []string
+			for _, e := range recorder.Events {
+				messages = append(messages, e.Message)
+			}
+			assert.Equal(t, tc.expectedEvents, messages)
+		})
+	}
+}
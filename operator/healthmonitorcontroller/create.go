@@ -0,0 +1,133 @@
+package healthmonitorcontroller
+
+import (
+	"context"
+	"fmt"
+
+	pipeline "github.com/ccremer/go-command-pipeline"
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	"github.com/vshn/provider-cloudscale/operator/pipelineutil"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Create implements managed.ExternalClient.
+func (p *HealthMonitorPipeline) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.Info("Creating resource")
+
+	monitor := fromManaged(mg)
+	if monitor.Status.AtProvider.HealthMonitorUUID != "" {
+		// Health monitor already exists
+		return managed.ExternalCreation{}, nil
+	}
+
+	pctx := &pipelineContext{Context: ctx, monitor: monitor}
+	pipe := pipeline.NewPipeline[*pipelineContext]()
+	stepMetrics := pipelineutil.NewStepMetrics[*pipelineContext](cloudscalev1.HealthMonitorKind)
+	pipe.WithBeforeHooks(pipelineutil.DebugLogger(pctx)).
+		WithSteps(stepMetrics.Steps(
+			pipe.NewStep("find health monitor by owner tag", p.findHealthMonitorByOwnerTag),
+			pipe.When(isHealthMonitorMissing, "create health monitor", p.createHealthMonitor),
+			pipe.NewStep("set health monitor UUID annotation", p.setHealthMonitorUUIDAnnotation),
+			pipe.NewStep("emit event", p.emitCreationEvent),
+		)...)
+	err := pipe.RunWithContext(pctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, "cannot create health monitor")
+	}
+
+	return managed.ExternalCreation{}, nil
+}
+
+// findHealthMonitorByOwnerTag searches for a health monitor that has been created by a previous reconciliation of this resource.
+// See servercontroller for why this is needed.
+// If exactly one health monitor is found, it is adopted.
+func (p *HealthMonitorPipeline) findHealthMonitorByOwnerTag(ctx *pipelineContext) error {
+	log := controllerruntime.LoggerFrom(ctx)
+	monitor := ctx.monitor
+
+	if monitor.UID == "" {
+		return nil
+	}
+	csMonitors, err := p.monitors.List(ctx, cloudscalesdk.WithTagFilter(cloudscalesdk.TagMap{cloudscaleclient.OwnerTagKey: string(monitor.UID)}))
+	if err != nil {
+		return errors.Wrap(err, "cannot list health monitors by owner tag")
+	}
+	switch len(csMonitors) {
+	case 0:
+		return nil
+	case 1:
+		ctx.csMonitor = &csMonitors[0]
+		log.V(1).Info("Adopting existing health monitor in cloudscale", "uuid", ctx.csMonitor.UUID)
+		return nil
+	default:
+		return fmt.Errorf("found %d health monitors with tag %s=%s, expected at most one", len(csMonitors), cloudscaleclient.OwnerTagKey, monitor.UID)
+	}
+}
+
+// createHealthMonitor creates a new health monitor for the referenced pool.
+func (p *HealthMonitorPipeline) createHealthMonitor(ctx *pipelineContext) error {
+	log := controllerruntime.LoggerFrom(ctx)
+	monitor := ctx.monitor
+	params := monitor.Spec.ForProvider
+
+	if params.PoolUUID == "" {
+		return errors.New("pool of the health monitor is not resolved yet")
+	}
+	csMonitor, err := p.monitors.Create(ctx, &cloudscaleclient.LoadBalancerHealthMonitorRequest{
+		TaggedResourceRequest: cloudscalesdk.TaggedResourceRequest{
+			Tags: cloudscaleclient.ToTagMap(cloudscaleclient.DesiredTags(params.Tags, monitor.UID)),
+		},
+		Pool:          params.PoolUUID,
+		Type:          params.Type,
+		DelayS:        params.DelaySeconds,
+		TimeoutS:      params.TimeoutSeconds,
+		UpThreshold:   params.UpThreshold,
+		DownThreshold: params.DownThreshold,
+		HTTP:          toHTTPRequest(params.HTTP),
+	})
+	if err != nil {
+		return err
+	}
+	log.V(1).Info("Created health monitor in cloudscale", "uuid", csMonitor.UUID, "type", csMonitor.Type, "pool", params.PoolUUID)
+	ctx.csMonitor = csMonitor
+	return nil
+}
+
+// setHealthMonitorUUIDAnnotation stores the UUID of the created or adopted health monitor in an annotation.
+func (p *HealthMonitorPipeline) setHealthMonitorUUIDAnnotation(ctx *pipelineContext) error {
+	cloudscaleclient.SetIDAnnotation(&ctx.monitor.ObjectMeta, HealthMonitorUUIDAnnotationKey, ctx.csMonitor.UUID)
+	return nil
+}
+
+func (p *HealthMonitorPipeline) emitCreationEvent(ctx *pipelineContext) error {
+	p.recorder.Event(ctx.monitor, event.Event{
+		Type:    event.TypeNormal,
+		Reason:  "Created",
+		Message: "Health monitor successfully created",
+	})
+	return nil
+}
+
+// toHTTPRequest returns the HTTP options of a request, or nil if none are given.
+func toHTTPRequest(http *cloudscalev1.HealthMonitorHTTP) *cloudscaleclient.LoadBalancerHealthMonitorHTTP {
+	if http == nil {
+		return nil
+	}
+	req := &cloudscaleclient.LoadBalancerHealthMonitorHTTP{
+		ExpectedCodes: http.ExpectedCodes,
+		Method:        http.Method,
+		URLPath:       http.URLPath,
+		Version:       http.Version,
+	}
+	if http.Host != "" {
+		req.Host = &http.Host
+	}
+	return req
+}
@@ -0,0 +1,55 @@
+package healthmonitorcontroller
+
+import (
+	"context"
+
+	pipeline "github.com/ccremer/go-command-pipeline"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	"github.com/vshn/provider-cloudscale/operator/pipelineutil"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Delete implements managed.ExternalClient.
+func (p *HealthMonitorPipeline) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.Info("Deleting resource")
+
+	monitor := fromManaged(mg)
+	pctx := &pipelineContext{Context: ctx, monitor: monitor}
+	pipe := pipeline.NewPipeline[*pipelineContext]()
+	stepMetrics := pipelineutil.NewStepMetrics[*pipelineContext](cloudscalev1.HealthMonitorKind)
+	pipe.WithBeforeHooks(pipelineutil.DebugLogger(pctx)).
+		WithSteps(stepMetrics.Steps(
+			pipe.NewStep("delete health monitor", p.deleteHealthMonitor),
+			pipe.NewStep("emit event", p.emitDeletionEvent),
+		)...)
+	err := pipe.RunWithContext(pctx)
+	return managed.ExternalDelete{}, errors.Wrap(err, "cannot deprovision health monitor")
+}
+
+// deleteHealthMonitor deletes the health monitor.
+func (p *HealthMonitorPipeline) deleteHealthMonitor(ctx *pipelineContext) error {
+	log := controllerruntime.LoggerFrom(ctx)
+	uuid := ctx.monitor.Status.AtProvider.HealthMonitorUUID
+
+	err := p.monitors.Delete(ctx, uuid)
+	if err != nil {
+		return resource.Ignore(cloudscaleclient.IsNotFound, err)
+	}
+	log.V(1).Info("Deleted health monitor in cloudscale", "uuid", uuid)
+	return nil
+}
+
+func (p *HealthMonitorPipeline) emitDeletionEvent(ctx *pipelineContext) error {
+	p.recorder.Event(ctx.monitor, event.Event{
+		Type:    event.TypeNormal,
+		Reason:  "Deleted",
+		Message: "Health monitor deleted",
+	})
+	return nil
+}
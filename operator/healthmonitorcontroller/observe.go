@@ -0,0 +1,122 @@
+package healthmonitorcontroller
+
+import (
+	"context"
+	"slices"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Observe implements managed.ExternalClient.
+func (p *HealthMonitorPipeline) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.V(1).Info("Observing resource")
+
+	monitor := fromManaged(mg)
+	if monitor.Status.AtProvider.HealthMonitorUUID == "" {
+		if uuid, exists := cloudscaleclient.IDFromAnnotation(monitor, HealthMonitorUUIDAnnotationKey); exists {
+			monitor.Status.AtProvider.HealthMonitorUUID = uuid
+		} else {
+			// New resource, create health monitor first
+			return managed.ExternalObservation{}, nil
+		}
+	}
+
+	pctx := &pipelineContext{Context: ctx, monitor: monitor}
+	err := p.getHealthMonitor(pctx)
+	if err != nil {
+		return managed.ExternalObservation{}, resource.Ignore(cloudscaleclient.IsNotFound, err)
+	}
+
+	monitor.Status.AtProvider = toObservation(pctx.csMonitor, monitor.Status.AtProvider.Project)
+	monitor.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: isUpToDate(monitor, pctx.csMonitor),
+	}, nil
+}
+
+// getHealthMonitor fetches an existing health monitor from the project associated with the API token.
+func (p *HealthMonitorPipeline) getHealthMonitor(ctx *pipelineContext) error {
+	log := controllerruntime.LoggerFrom(ctx)
+
+	csMonitor, err := p.monitors.Get(ctx, ctx.monitor.Status.AtProvider.HealthMonitorUUID)
+	if err != nil {
+		return err
+	}
+	ctx.csMonitor = csMonitor
+	log.V(1).Info("Fetched health monitor in cloudscale", "uuid", csMonitor.UUID, "type", csMonitor.Type)
+	return nil
+}
+
+// isUpToDate returns true if the updatable fields of the health monitor match the spec.
+// Fields that aren't set in the spec are ignored, since cloudscale.ch sets defaults for them.
+func isUpToDate(monitor *cloudscalev1.HealthMonitor, csMonitor *cloudscaleclient.LoadBalancerHealthMonitor) bool {
+	params := monitor.Spec.ForProvider
+	return intUpToDate(params.DelaySeconds, csMonitor.DelayS) &&
+		intUpToDate(params.TimeoutSeconds, csMonitor.TimeoutS) &&
+		intUpToDate(params.UpThreshold, csMonitor.UpThreshold) &&
+		intUpToDate(params.DownThreshold, csMonitor.DownThreshold) &&
+		httpUpToDate(params.HTTP, csMonitor.HTTP) &&
+		!cloudscaleclient.TagsNeedUpdate(cloudscaleclient.DesiredTags(params.Tags, monitor.UID), csMonitor.Tags)
+}
+
+func intUpToDate(desired, observed int) bool {
+	return desired == 0 || desired == observed
+}
+
+func stringUpToDate(desired, observed string) bool {
+	return desired == "" || desired == observed
+}
+
+func httpUpToDate(desired *cloudscalev1.HealthMonitorHTTP, observed *cloudscaleclient.LoadBalancerHealthMonitorHTTP) bool {
+	if desired == nil {
+		return true
+	}
+	if observed == nil {
+		return false
+	}
+	return (len(desired.ExpectedCodes) == 0 || slices.Equal(desired.ExpectedCodes, observed.ExpectedCodes)) &&
+		stringUpToDate(desired.Method, observed.Method) &&
+		stringUpToDate(desired.URLPath, observed.URLPath) &&
+		stringUpToDate(desired.Version, observed.Version) &&
+		stringUpToDate(desired.Host, ptrValue(observed.Host))
+}
+
+// toObservation returns the observed fields of the given health monitor.
+func toObservation(csMonitor *cloudscaleclient.LoadBalancerHealthMonitor, project string) cloudscalev1.HealthMonitorObservation {
+	obs := cloudscalev1.HealthMonitorObservation{
+		HealthMonitorUUID: csMonitor.UUID,
+		PoolUUID:          csMonitor.Pool.UUID,
+		Type:              csMonitor.Type,
+		DelaySeconds:      csMonitor.DelayS,
+		TimeoutSeconds:    csMonitor.TimeoutS,
+		UpThreshold:       csMonitor.UpThreshold,
+		DownThreshold:     csMonitor.DownThreshold,
+		Tags:              cloudscaleclient.FromTagMap(csMonitor.Tags),
+		Project:           project,
+	}
+	if http := csMonitor.HTTP; http != nil {
+		obs.HTTP = &cloudscalev1.HealthMonitorHTTP{
+			ExpectedCodes: http.ExpectedCodes,
+			Method:        http.Method,
+			URLPath:       http.URLPath,
+			Version:       http.Version,
+			Host:          ptrValue(http.Host),
+		}
+	}
+	return obs
+}
+
+func ptrValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,55 @@
+package healthmonitorcontroller
+
+import (
+	"testing"
+
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/stretchr/testify/assert"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestIsUpToDate(t *testing.T) {
+	tests := map[string]struct {
+		givenDelay int
+		givenHTTP  *cloudscalev1.HealthMonitorHTTP
+		expected   bool
+	}{
+		"GivenDefaults_ThenExpectUpToDate": {
+			expected: true,
+		},
+		"GivenDifferentDelay_ThenExpectNotUpToDate": {
+			givenDelay: 10, expected: false,
+		},
+		"GivenSameURLPath_ThenExpectUpToDate": {
+			givenHTTP: &cloudscalev1.HealthMonitorHTTP{URLPath: "/healthz"}, expected: true,
+		},
+		"GivenDifferentHost_ThenExpectNotUpToDate": {
+			givenHTTP: &cloudscalev1.HealthMonitorHTTP{Host: "example.com"}, expected: false,
+		},
+		"GivenDifferentExpectedCodes_ThenExpectNotUpToDate": {
+			givenHTTP: &cloudscalev1.HealthMonitorHTTP{ExpectedCodes: []string{"200", "204"}}, expected: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			monitor := &cloudscalev1.HealthMonitor{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-monitor", UID: "uid"},
+				Spec: cloudscalev1.HealthMonitorSpec{ForProvider: cloudscalev1.HealthMonitorParameters{
+					Type:         "http",
+					DelaySeconds: tc.givenDelay,
+					HTTP:         tc.givenHTTP,
+				}},
+			}
+			csMonitor := &cloudscaleclient.LoadBalancerHealthMonitor{
+				Type: "http", DelayS: 2, TimeoutS: 1, UpThreshold: 2, DownThreshold: 3,
+				HTTP: &cloudscaleclient.LoadBalancerHealthMonitorHTTP{
+					ExpectedCodes: []string{"200"}, Method: "GET", URLPath: "/healthz", Version: "1.1",
+				},
+				TaggedResource: cloudscalesdk.TaggedResource{Tags: cloudscalesdk.TagMap{cloudscaleclient.OwnerTagKey: "uid"}},
+			}
+			assert.Equal(t, tc.expected, isUpToDate(monitor, csMonitor))
+		})
+	}
+}
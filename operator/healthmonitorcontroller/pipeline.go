@@ -0,0 +1,58 @@
+package healthmonitorcontroller
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+)
+
+const (
+	// HealthMonitorUUIDAnnotationKey is the annotation key where the health monitor UUID is stored.
+	HealthMonitorUUIDAnnotationKey = "cloudscale.crossplane.io/health-monitor-uuid"
+)
+
+// HealthMonitorPipeline provisions HealthMonitors on cloudscale.ch
+type HealthMonitorPipeline struct {
+	recorder event.Recorder
+	monitors cloudscaleclient.LoadBalancerHealthMonitorService
+}
+
+type pipelineContext struct {
+	context.Context
+	monitor   *cloudscalev1.HealthMonitor
+	csMonitor *cloudscaleclient.LoadBalancerHealthMonitor
+}
+
+// ParentContext implements tracing.Context.
+func (c *pipelineContext) ParentContext() context.Context {
+	return c.Context
+}
+
+// SetParentContext implements tracing.Context.
+func (c *pipelineContext) SetParentContext(ctx context.Context) {
+	c.Context = ctx
+}
+
+// NewPipeline returns a new instance of HealthMonitorPipeline.
+func NewPipeline(recorder event.Recorder, monitors cloudscaleclient.LoadBalancerHealthMonitorService) *HealthMonitorPipeline {
+	return &HealthMonitorPipeline{
+		recorder: recorder,
+		monitors: monitors,
+	}
+}
+
+// Disconnect implements managed.ExternalClient.
+func (p *HealthMonitorPipeline) Disconnect(_ context.Context) error {
+	return nil
+}
+
+func isHealthMonitorMissing(ctx *pipelineContext) bool {
+	return ctx.csMonitor == nil
+}
+
+func fromManaged(mg resource.Managed) *cloudscalev1.HealthMonitor {
+	return mg.(*cloudscalev1.HealthMonitor)
+}
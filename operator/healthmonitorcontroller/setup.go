@@ -0,0 +1,48 @@
+package healthmonitorcontroller
+
+import (
+	"strings"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	"github.com/vshn/provider-cloudscale/operator/controlleropts"
+	"github.com/vshn/provider-cloudscale/operator/ratelimit"
+	"github.com/vshn/provider-cloudscale/operator/tracing"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+)
+
+// DefaultPollInterval is the default interval in which health monitors are observed again.
+// Health monitors rarely change outside of Kubernetes.
+const DefaultPollInterval = 1 * time.Hour
+
+// SetupController adds a controller that reconciles cloudscalev1.HealthMonitor managed resources.
+func SetupController(mgr ctrl.Manager, opts controlleropts.Options) error {
+	name := strings.ToLower(cloudscalev1.HealthMonitorGroupKind)
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+	throttler := ratelimit.NewThrottler()
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(cloudscalev1.HealthMonitorGroupVersionKind),
+		managed.WithExternalConnecter(throttler.WrapConnecter(&monitorConnector{
+			recorder:  recorder,
+			connector: cloudscaleclient.NewConnector(mgr.GetClient(), cloudscalev1.HealthMonitorKind),
+		})),
+		managed.WithLogger(logging.NewLogrLogger(mgr.GetLogger().WithValues("controller", name))),
+		managed.WithRecorder(recorder),
+		managed.WithPollIntervalHook(opts.PollIntervalHook(nil)),
+		managed.WithPollInterval(opts.PollInterval),
+		managed.WithConnectionPublishers(opts.ConnectionPublishers(mgr)...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&cloudscalev1.HealthMonitor{}, builder.WithPredicates(opts.Predicates...)).
+		WithOptions(opts.ForControllerRuntime()).
+		Complete(tracing.NewReconciler(cloudscalev1.HealthMonitorKind, throttler.WrapReconciler(r)))
+}
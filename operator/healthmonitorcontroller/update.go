@@ -0,0 +1,59 @@
+package healthmonitorcontroller
+
+import (
+	"context"
+
+	pipeline "github.com/ccremer/go-command-pipeline"
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	"github.com/vshn/provider-cloudscale/operator/pipelineutil"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Update implements managed.ExternalClient.
+func (p *HealthMonitorPipeline) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.Info("Updating resource")
+	monitor := fromManaged(mg)
+
+	pctx := &pipelineContext{Context: ctx, monitor: monitor}
+	pipe := pipeline.NewPipeline[*pipelineContext]()
+	stepMetrics := pipelineutil.NewStepMetrics[*pipelineContext](cloudscalev1.HealthMonitorKind)
+	pipe.WithBeforeHooks(pipelineutil.DebugLogger(pctx)).
+		WithSteps(stepMetrics.Steps(
+			pipe.NewStep("update health monitor", p.updateHealthMonitor),
+		)...)
+	err := pipe.RunWithContext(pctx)
+
+	return managed.ExternalUpdate{}, errors.Wrap(err, "cannot update health monitor")
+}
+
+// updateHealthMonitor updates the mutable fields of the health monitor identified by UUID.
+// Fields that aren't set in the spec are omitted and keep their current value.
+func (p *HealthMonitorPipeline) updateHealthMonitor(ctx *pipelineContext) error {
+	log := controllerruntime.LoggerFrom(ctx)
+	monitor := ctx.monitor
+	params := monitor.Spec.ForProvider
+	uuid := monitor.Status.AtProvider.HealthMonitorUUID
+	tags := cloudscaleclient.DesiredTags(params.Tags, monitor.UID)
+
+	err := p.monitors.Update(ctx, uuid, &cloudscaleclient.LoadBalancerHealthMonitorRequest{
+		TaggedResourceRequest: cloudscalesdk.TaggedResourceRequest{
+			Tags: cloudscaleclient.ToTagMap(tags),
+		},
+		DelayS:        params.DelaySeconds,
+		TimeoutS:      params.TimeoutSeconds,
+		UpThreshold:   params.UpThreshold,
+		DownThreshold: params.DownThreshold,
+		HTTP:          toHTTPRequest(params.HTTP),
+	})
+	if err != nil {
+		return err
+	}
+	log.V(1).Info("Updated health monitor in cloudscale", "uuid", uuid, "tags", tags)
+	return nil
+}
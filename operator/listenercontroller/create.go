@@ -0,0 +1,126 @@
+package listenercontroller
+
+import (
+	"context"
+	"fmt"
+
+	pipeline "github.com/ccremer/go-command-pipeline"
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	"github.com/vshn/provider-cloudscale/operator/pipelineutil"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Create implements managed.ExternalClient.
+func (p *ListenerPipeline) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.Info("Creating resource")
+
+	listener := fromManaged(mg)
+	if listener.Status.AtProvider.ListenerUUID != "" {
+		// Listener already exists
+		return managed.ExternalCreation{}, nil
+	}
+
+	pctx := &pipelineContext{Context: ctx, listener: listener}
+	pipe := pipeline.NewPipeline[*pipelineContext]()
+	stepMetrics := pipelineutil.NewStepMetrics[*pipelineContext](cloudscalev1.ListenerKind)
+	pipe.WithBeforeHooks(pipelineutil.DebugLogger(pctx)).
+		WithSteps(stepMetrics.Steps(
+			pipe.NewStep("find listener by owner tag", p.findListenerByOwnerTag),
+			pipe.When(isListenerMissing, "create listener", p.createListener),
+			pipe.NewStep("set listener UUID annotation", p.setListenerUUIDAnnotation),
+			pipe.NewStep("emit event", p.emitCreationEvent),
+		)...)
+	err := pipe.RunWithContext(pctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, "cannot create listener")
+	}
+
+	return managed.ExternalCreation{}, nil
+}
+
+// findListenerByOwnerTag searches for a listener that has been created by a previous reconciliation of this resource.
+// See servercontroller for why this is needed.
+// If exactly one listener is found, it is adopted.
+func (p *ListenerPipeline) findListenerByOwnerTag(ctx *pipelineContext) error {
+	log := controllerruntime.LoggerFrom(ctx)
+	listener := ctx.listener
+
+	if listener.UID == "" {
+		return nil
+	}
+	csListeners, err := p.listeners.List(ctx, cloudscalesdk.WithTagFilter(cloudscalesdk.TagMap{cloudscaleclient.OwnerTagKey: string(listener.UID)}))
+	if err != nil {
+		return errors.Wrap(err, "cannot list listeners by owner tag")
+	}
+	switch len(csListeners) {
+	case 0:
+		return nil
+	case 1:
+		ctx.csListener = &csListeners[0]
+		log.V(1).Info("Adopting existing listener in cloudscale", "uuid", ctx.csListener.UUID, "name", ctx.csListener.Name)
+		return nil
+	default:
+		return fmt.Errorf("found %d listeners with tag %s=%s, expected at most one", len(csListeners), cloudscaleclient.OwnerTagKey, listener.UID)
+	}
+}
+
+// createListener creates a new listener that forwards traffic to the referenced pool.
+func (p *ListenerPipeline) createListener(ctx *pipelineContext) error {
+	log := controllerruntime.LoggerFrom(ctx)
+	listener := ctx.listener
+	params := listener.Spec.ForProvider
+
+	if params.PoolUUID == "" {
+		return errors.New("pool of the listener is not resolved yet")
+	}
+	csListener, err := p.listeners.Create(ctx, &cloudscaleclient.LoadBalancerListenerRequest{
+		TaggedResourceRequest: cloudscalesdk.TaggedResourceRequest{
+			Tags: cloudscaleclient.ToTagMap(cloudscaleclient.DesiredTags(params.Tags, listener.UID)),
+		},
+		Name:                   listener.GetListenerName(),
+		Pool:                   params.PoolUUID,
+		Protocol:               params.Protocol,
+		ProtocolPort:           params.ProtocolPort,
+		AllowedCIDRs:           allowedCIDRs(params.AllowedCIDRs),
+		TimeoutClientDataMS:    params.TimeoutClientDataMS,
+		TimeoutMemberConnectMS: params.TimeoutMemberConnectMS,
+		TimeoutMemberDataMS:    params.TimeoutMemberDataMS,
+	})
+	if err != nil {
+		return err
+	}
+	log.V(1).Info("Created listener in cloudscale", "uuid", csListener.UUID, "name", csListener.Name, "port", csListener.ProtocolPort)
+	ctx.csListener = csListener
+	return nil
+}
+
+// setListenerUUIDAnnotation stores the UUID of the created or adopted listener in an annotation.
+func (p *ListenerPipeline) setListenerUUIDAnnotation(ctx *pipelineContext) error {
+	cloudscaleclient.SetIDAnnotation(&ctx.listener.ObjectMeta, ListenerUUIDAnnotationKey, ctx.csListener.UUID)
+	return nil
+}
+
+func (p *ListenerPipeline) emitCreationEvent(ctx *pipelineContext) error {
+	p.recorder.Event(ctx.listener, event.Event{
+		Type:    event.TypeNormal,
+		Reason:  "Created",
+		Message: "Listener successfully created",
+	})
+	return nil
+}
+
+// allowedCIDRs returns the given CIDRs for a request.
+// The list is never nil, so that removing all CIDRs from the spec allows all clients again.
+func allowedCIDRs(cidrs []string) *[]string {
+	if cidrs == nil {
+		cidrs = []string{}
+	}
+	return &cidrs
+}
@@ -0,0 +1,55 @@
+package listenercontroller
+
+import (
+	"context"
+
+	pipeline "github.com/ccremer/go-command-pipeline"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	"github.com/vshn/provider-cloudscale/operator/pipelineutil"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Delete implements managed.ExternalClient.
+func (p *ListenerPipeline) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.Info("Deleting resource")
+
+	listener := fromManaged(mg)
+	pctx := &pipelineContext{Context: ctx, listener: listener}
+	pipe := pipeline.NewPipeline[*pipelineContext]()
+	stepMetrics := pipelineutil.NewStepMetrics[*pipelineContext](cloudscalev1.ListenerKind)
+	pipe.WithBeforeHooks(pipelineutil.DebugLogger(pctx)).
+		WithSteps(stepMetrics.Steps(
+			pipe.NewStep("delete listener", p.deleteListener),
+			pipe.NewStep("emit event", p.emitDeletionEvent),
+		)...)
+	err := pipe.RunWithContext(pctx)
+	return managed.ExternalDelete{}, errors.Wrap(err, "cannot deprovision listener")
+}
+
+// deleteListener deletes the listener.
+func (p *ListenerPipeline) deleteListener(ctx *pipelineContext) error {
+	log := controllerruntime.LoggerFrom(ctx)
+	uuid := ctx.listener.Status.AtProvider.ListenerUUID
+
+	err := p.listeners.Delete(ctx, uuid)
+	if err != nil {
+		return resource.Ignore(cloudscaleclient.IsNotFound, err)
+	}
+	log.V(1).Info("Deleted listener in cloudscale", "uuid", uuid)
+	return nil
+}
+
+func (p *ListenerPipeline) emitDeletionEvent(ctx *pipelineContext) error {
+	p.recorder.Event(ctx.listener, event.Event{
+		Type:    event.TypeNormal,
+		Reason:  "Deleted",
+		Message: "Listener deleted",
+	})
+	return nil
+}
@@ -0,0 +1,93 @@
+package listenercontroller
+
+import (
+	"context"
+	"slices"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Observe implements managed.ExternalClient.
+func (p *ListenerPipeline) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.V(1).Info("Observing resource")
+
+	listener := fromManaged(mg)
+	if listener.Status.AtProvider.ListenerUUID == "" {
+		if uuid, exists := cloudscaleclient.IDFromAnnotation(listener, ListenerUUIDAnnotationKey); exists {
+			listener.Status.AtProvider.ListenerUUID = uuid
+		} else {
+			// New resource, create listener first
+			return managed.ExternalObservation{}, nil
+		}
+	}
+
+	pctx := &pipelineContext{Context: ctx, listener: listener}
+	err := p.getListener(pctx)
+	if err != nil {
+		return managed.ExternalObservation{}, resource.Ignore(cloudscaleclient.IsNotFound, err)
+	}
+
+	listener.Status.AtProvider = toObservation(pctx.csListener, listener.Status.AtProvider.Project)
+	listener.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: isUpToDate(listener, pctx.csListener),
+	}, nil
+}
+
+// getListener fetches an existing listener from the project associated with the API token.
+func (p *ListenerPipeline) getListener(ctx *pipelineContext) error {
+	log := controllerruntime.LoggerFrom(ctx)
+
+	csListener, err := p.listeners.Get(ctx, ctx.listener.Status.AtProvider.ListenerUUID)
+	if err != nil {
+		return err
+	}
+	ctx.csListener = csListener
+	log.V(1).Info("Fetched listener in cloudscale", "uuid", csListener.UUID, "name", csListener.Name)
+	return nil
+}
+
+// isUpToDate returns true if the updatable fields of the listener match the spec.
+// Timeouts are only compared if they're set in the spec, otherwise the defaults of cloudscale.ch are accepted.
+func isUpToDate(listener *cloudscalev1.Listener, csListener *cloudscaleclient.LoadBalancerListener) bool {
+	params := listener.Spec.ForProvider
+	if params.TimeoutClientDataMS != 0 && params.TimeoutClientDataMS != csListener.TimeoutClientDataMS {
+		return false
+	}
+	if params.TimeoutMemberConnectMS != 0 && params.TimeoutMemberConnectMS != csListener.TimeoutMemberConnectMS {
+		return false
+	}
+	if params.TimeoutMemberDataMS != 0 && params.TimeoutMemberDataMS != csListener.TimeoutMemberDataMS {
+		return false
+	}
+	return listener.GetListenerName() == csListener.Name &&
+		params.ProtocolPort == csListener.ProtocolPort &&
+		slices.Equal(params.AllowedCIDRs, csListener.AllowedCIDRs) &&
+		!cloudscaleclient.TagsNeedUpdate(cloudscaleclient.DesiredTags(params.Tags, listener.UID), csListener.Tags)
+}
+
+// toObservation returns the observed fields of the given listener.
+func toObservation(csListener *cloudscaleclient.LoadBalancerListener, project string) cloudscalev1.ListenerObservation {
+	return cloudscalev1.ListenerObservation{
+		ListenerUUID:           csListener.UUID,
+		ListenerName:           csListener.Name,
+		PoolUUID:               csListener.Pool.UUID,
+		LoadBalancerUUID:       csListener.LoadBalancer.UUID,
+		Protocol:               csListener.Protocol,
+		ProtocolPort:           csListener.ProtocolPort,
+		AllowedCIDRs:           csListener.AllowedCIDRs,
+		TimeoutClientDataMS:    csListener.TimeoutClientDataMS,
+		TimeoutMemberConnectMS: csListener.TimeoutMemberConnectMS,
+		TimeoutMemberDataMS:    csListener.TimeoutMemberDataMS,
+		Tags:                   cloudscaleclient.FromTagMap(csListener.Tags),
+		Project:                project,
+	}
+}
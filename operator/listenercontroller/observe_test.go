@@ -0,0 +1,52 @@
+package listenercontroller
+
+import (
+	"testing"
+
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/stretchr/testify/assert"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestIsUpToDate(t *testing.T) {
+	tests := map[string]struct {
+		givenAllowedCIDRs []string
+		givenTimeout      int
+		expected          bool
+	}{
+		"GivenNoAllowedCIDRsAndTimeout_ThenExpectUpToDate": {
+			expected: true,
+		},
+		"GivenSameTimeout_ThenExpectUpToDate": {
+			givenTimeout: 50000, expected: true,
+		},
+		"GivenDifferentTimeout_ThenExpectNotUpToDate": {
+			givenTimeout: 10000, expected: false,
+		},
+		"GivenAllowedCIDRs_ThenExpectNotUpToDate": {
+			givenAllowedCIDRs: []string{"192.0.2.0/24"}, expected: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			listener := &cloudscalev1.Listener{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-listener", UID: "uid"},
+				Spec: cloudscalev1.ListenerSpec{ForProvider: cloudscalev1.ListenerParameters{
+					ProtocolPort:        80,
+					AllowedCIDRs:        tc.givenAllowedCIDRs,
+					TimeoutClientDataMS: tc.givenTimeout,
+				}},
+			}
+			csListener := &cloudscaleclient.LoadBalancerListener{
+				Name:                "my-listener",
+				ProtocolPort:        80,
+				AllowedCIDRs:        []string{},
+				TimeoutClientDataMS: 50000,
+				TaggedResource:      cloudscalesdk.TaggedResource{Tags: cloudscalesdk.TagMap{cloudscaleclient.OwnerTagKey: "uid"}},
+			}
+			assert.Equal(t, tc.expected, isUpToDate(listener, csListener))
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package listenercontroller
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+)
+
+const (
+	// ListenerUUIDAnnotationKey is the annotation key where the listener UUID is stored.
+	ListenerUUIDAnnotationKey = "cloudscale.crossplane.io/listener-uuid"
+)
+
+// ListenerPipeline provisions Listeners on cloudscale.ch
+type ListenerPipeline struct {
+	recorder  event.Recorder
+	listeners cloudscaleclient.LoadBalancerListenerService
+}
+
+type pipelineContext struct {
+	context.Context
+	listener   *cloudscalev1.Listener
+	csListener *cloudscaleclient.LoadBalancerListener
+}
+
+// ParentContext implements tracing.Context.
+func (c *pipelineContext) ParentContext() context.Context {
+	return c.Context
+}
+
+// SetParentContext implements tracing.Context.
+func (c *pipelineContext) SetParentContext(ctx context.Context) {
+	c.Context = ctx
+}
+
+// NewPipeline returns a new instance of ListenerPipeline.
+func NewPipeline(recorder event.Recorder, listeners cloudscaleclient.LoadBalancerListenerService) *ListenerPipeline {
+	return &ListenerPipeline{
+		recorder:  recorder,
+		listeners: listeners,
+	}
+}
+
+// Disconnect implements managed.ExternalClient.
+func (p *ListenerPipeline) Disconnect(_ context.Context) error {
+	return nil
+}
+
+func isListenerMissing(ctx *pipelineContext) bool {
+	return ctx.csListener == nil
+}
+
+func fromManaged(mg resource.Managed) *cloudscalev1.Listener {
+	return mg.(*cloudscalev1.Listener)
+}
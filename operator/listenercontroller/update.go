@@ -0,0 +1,59 @@
+package listenercontroller
+
+import (
+	"context"
+
+	pipeline "github.com/ccremer/go-command-pipeline"
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	"github.com/vshn/provider-cloudscale/operator/pipelineutil"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Update implements managed.ExternalClient.
+func (p *ListenerPipeline) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.Info("Updating resource")
+	listener := fromManaged(mg)
+
+	pctx := &pipelineContext{Context: ctx, listener: listener}
+	pipe := pipeline.NewPipeline[*pipelineContext]()
+	stepMetrics := pipelineutil.NewStepMetrics[*pipelineContext](cloudscalev1.ListenerKind)
+	pipe.WithBeforeHooks(pipelineutil.DebugLogger(pctx)).
+		WithSteps(stepMetrics.Steps(
+			pipe.NewStep("update listener", p.updateListener),
+		)...)
+	err := pipe.RunWithContext(pctx)
+
+	return managed.ExternalUpdate{}, errors.Wrap(err, "cannot update listener")
+}
+
+// updateListener updates the mutable fields of the listener identified by UUID.
+func (p *ListenerPipeline) updateListener(ctx *pipelineContext) error {
+	log := controllerruntime.LoggerFrom(ctx)
+	listener := ctx.listener
+	params := listener.Spec.ForProvider
+	uuid := listener.Status.AtProvider.ListenerUUID
+	tags := cloudscaleclient.DesiredTags(params.Tags, listener.UID)
+
+	err := p.listeners.Update(ctx, uuid, &cloudscaleclient.LoadBalancerListenerRequest{
+		TaggedResourceRequest: cloudscalesdk.TaggedResourceRequest{
+			Tags: cloudscaleclient.ToTagMap(tags),
+		},
+		Name:                   listener.GetListenerName(),
+		ProtocolPort:           params.ProtocolPort,
+		AllowedCIDRs:           allowedCIDRs(params.AllowedCIDRs),
+		TimeoutClientDataMS:    params.TimeoutClientDataMS,
+		TimeoutMemberConnectMS: params.TimeoutMemberConnectMS,
+		TimeoutMemberDataMS:    params.TimeoutMemberDataMS,
+	})
+	if err != nil {
+		return err
+	}
+	log.V(1).Info("Updated listener in cloudscale", "uuid", uuid, "name", listener.GetListenerName(), "tags", tags)
+	return nil
+}
@@ -0,0 +1,113 @@
+package loadbalancercontroller
+
+import (
+	"context"
+	"fmt"
+
+	pipeline "github.com/ccremer/go-command-pipeline"
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	"github.com/vshn/provider-cloudscale/operator/pipelineutil"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Create implements managed.ExternalClient.
+func (p *LoadBalancerPipeline) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.Info("Creating resource")
+
+	loadBalancer := fromManaged(mg)
+	if loadBalancer.Status.AtProvider.LoadBalancerUUID != "" {
+		// Load balancer already exists
+		return managed.ExternalCreation{}, nil
+	}
+
+	pctx := &pipelineContext{Context: ctx, loadBalancer: loadBalancer}
+	pipe := pipeline.NewPipeline[*pipelineContext]()
+	stepMetrics := pipelineutil.NewStepMetrics[*pipelineContext](cloudscalev1.LoadBalancerKind)
+	pipe.WithBeforeHooks(pipelineutil.DebugLogger(pctx)).
+		WithSteps(stepMetrics.Steps(
+			pipe.NewStep("find load balancer by owner tag", p.findLoadBalancerByOwnerTag),
+			pipe.When(isLoadBalancerMissing, "create load balancer", p.createLoadBalancer),
+			pipe.NewStep("set load balancer UUID annotation", p.setLoadBalancerUUIDAnnotation),
+			pipe.NewStep("emit event", p.emitCreationEvent),
+		)...)
+	err := pipe.RunWithContext(pctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, "cannot create load balancer")
+	}
+
+	return managed.ExternalCreation{}, nil
+}
+
+// findLoadBalancerByOwnerTag searches for a load balancer that has been created by a previous reconciliation of this resource.
+// See servercontroller for why this is needed.
+// If exactly one load balancer is found, it is adopted.
+func (p *LoadBalancerPipeline) findLoadBalancerByOwnerTag(ctx *pipelineContext) error {
+	log := controllerruntime.LoggerFrom(ctx)
+	loadBalancer := ctx.loadBalancer
+
+	if loadBalancer.UID == "" {
+		return nil
+	}
+	csLoadBalancers, err := p.loadBalancers.List(ctx, cloudscalesdk.WithTagFilter(cloudscalesdk.TagMap{cloudscaleclient.OwnerTagKey: string(loadBalancer.UID)}))
+	if err != nil {
+		return errors.Wrap(err, "cannot list load balancers by owner tag")
+	}
+	switch len(csLoadBalancers) {
+	case 0:
+		return nil
+	case 1:
+		ctx.csLoadBalancer = &csLoadBalancers[0]
+		log.V(1).Info("Adopting existing load balancer in cloudscale", "uuid", ctx.csLoadBalancer.UUID, "name", ctx.csLoadBalancer.Name)
+		return nil
+	default:
+		return fmt.Errorf("found %d load balancers with tag %s=%s, expected at most one", len(csLoadBalancers), cloudscaleclient.OwnerTagKey, loadBalancer.UID)
+	}
+}
+
+// createLoadBalancer creates a new load balancer in the project associated with the API token.
+func (p *LoadBalancerPipeline) createLoadBalancer(ctx *pipelineContext) error {
+	log := controllerruntime.LoggerFrom(ctx)
+	loadBalancer := ctx.loadBalancer
+	params := loadBalancer.Spec.ForProvider
+
+	req := &cloudscaleclient.LoadBalancerRequest{
+		ZonalResourceRequest: cloudscalesdk.ZonalResourceRequest{Zone: params.Zone},
+		TaggedResourceRequest: cloudscalesdk.TaggedResourceRequest{
+			Tags: cloudscaleclient.ToTagMap(cloudscaleclient.DesiredTags(params.Tags, loadBalancer.UID)),
+		},
+		Name:   loadBalancer.GetLoadBalancerName(),
+		Flavor: params.Flavor,
+	}
+	for _, vip := range params.VIPAddresses {
+		req.VIPAddresses = append(req.VIPAddresses, cloudscaleclient.LoadBalancerVIPAddressRequest{Subnet: vip.SubnetUUID, Address: vip.Address})
+	}
+	csLoadBalancer, err := p.loadBalancers.Create(ctx, req)
+	if err != nil {
+		return err
+	}
+	log.V(1).Info("Created load balancer in cloudscale", "uuid", csLoadBalancer.UUID, "name", csLoadBalancer.Name)
+	ctx.csLoadBalancer = csLoadBalancer
+	return nil
+}
+
+// setLoadBalancerUUIDAnnotation stores the UUID of the created or adopted load balancer in an annotation.
+func (p *LoadBalancerPipeline) setLoadBalancerUUIDAnnotation(ctx *pipelineContext) error {
+	cloudscaleclient.SetIDAnnotation(&ctx.loadBalancer.ObjectMeta, LoadBalancerUUIDAnnotationKey, ctx.csLoadBalancer.UUID)
+	return nil
+}
+
+func (p *LoadBalancerPipeline) emitCreationEvent(ctx *pipelineContext) error {
+	p.recorder.Event(ctx.loadBalancer, event.Event{
+		Type:    event.TypeNormal,
+		Reason:  "Created",
+		Message: "Load balancer successfully created",
+	})
+	return nil
+}
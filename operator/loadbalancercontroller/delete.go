@@ -0,0 +1,55 @@
+package loadbalancercontroller
+
+import (
+	"context"
+
+	pipeline "github.com/ccremer/go-command-pipeline"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	"github.com/vshn/provider-cloudscale/operator/pipelineutil"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Delete implements managed.ExternalClient.
+func (p *LoadBalancerPipeline) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.Info("Deleting resource")
+
+	loadBalancer := fromManaged(mg)
+	pctx := &pipelineContext{Context: ctx, loadBalancer: loadBalancer}
+	pipe := pipeline.NewPipeline[*pipelineContext]()
+	stepMetrics := pipelineutil.NewStepMetrics[*pipelineContext](cloudscalev1.LoadBalancerKind)
+	pipe.WithBeforeHooks(pipelineutil.DebugLogger(pctx)).
+		WithSteps(stepMetrics.Steps(
+			pipe.NewStep("delete load balancer", p.deleteLoadBalancer),
+			pipe.NewStep("emit event", p.emitDeletionEvent),
+		)...)
+	err := pipe.RunWithContext(pctx)
+	return managed.ExternalDelete{}, errors.Wrap(err, "cannot deprovision load balancer")
+}
+
+// deleteLoadBalancer deletes the load balancer.
+func (p *LoadBalancerPipeline) deleteLoadBalancer(ctx *pipelineContext) error {
+	log := controllerruntime.LoggerFrom(ctx)
+	uuid := ctx.loadBalancer.Status.AtProvider.LoadBalancerUUID
+
+	err := p.loadBalancers.Delete(ctx, uuid)
+	if err != nil {
+		return resource.Ignore(cloudscaleclient.IsNotFound, err)
+	}
+	log.V(1).Info("Deleted load balancer in cloudscale", "uuid", uuid)
+	return nil
+}
+
+func (p *LoadBalancerPipeline) emitDeletionEvent(ctx *pipelineContext) error {
+	p.recorder.Event(ctx.loadBalancer, event.Event{
+		Type:    event.TypeNormal,
+		Reason:  "Deleted",
+		Message: "Load balancer deleted",
+	})
+	return nil
+}
@@ -0,0 +1,89 @@
+package loadbalancercontroller
+
+import (
+	"context"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Observe implements managed.ExternalClient.
+func (p *LoadBalancerPipeline) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.V(1).Info("Observing resource")
+
+	loadBalancer := fromManaged(mg)
+	if loadBalancer.Status.AtProvider.LoadBalancerUUID == "" {
+		if uuid, exists := cloudscaleclient.IDFromAnnotation(loadBalancer, LoadBalancerUUIDAnnotationKey); exists {
+			loadBalancer.Status.AtProvider.LoadBalancerUUID = uuid
+		} else {
+			// New resource, create load balancer first
+			return managed.ExternalObservation{}, nil
+		}
+	}
+
+	pctx := &pipelineContext{Context: ctx, loadBalancer: loadBalancer}
+	err := p.getLoadBalancer(pctx)
+	if err != nil {
+		return managed.ExternalObservation{}, resource.Ignore(cloudscaleclient.IsNotFound, err)
+	}
+
+	csLoadBalancer := pctx.csLoadBalancer
+	loadBalancer.Status.AtProvider = toObservation(csLoadBalancer, loadBalancer.Status.AtProvider.Project)
+	if csLoadBalancer.Status == LoadBalancerRunning {
+		loadBalancer.SetConditions(xpv1.Available())
+	} else {
+		loadBalancer.SetConditions(xpv1.Unavailable().WithMessage("load balancer is " + csLoadBalancer.Status))
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: isUpToDate(loadBalancer, csLoadBalancer),
+	}, nil
+}
+
+// getLoadBalancer fetches an existing load balancer from the project associated with the API token.
+func (p *LoadBalancerPipeline) getLoadBalancer(ctx *pipelineContext) error {
+	log := controllerruntime.LoggerFrom(ctx)
+
+	csLoadBalancer, err := p.loadBalancers.Get(ctx, ctx.loadBalancer.Status.AtProvider.LoadBalancerUUID)
+	if err != nil {
+		return err
+	}
+	ctx.csLoadBalancer = csLoadBalancer
+	log.V(1).Info("Fetched load balancer in cloudscale", "uuid", csLoadBalancer.UUID, "name", csLoadBalancer.Name, "status", csLoadBalancer.Status)
+	return nil
+}
+
+// isUpToDate returns true if the updatable fields of the load balancer match the spec.
+// Fields that can't be changed after creation are ignored.
+func isUpToDate(loadBalancer *cloudscalev1.LoadBalancer, csLoadBalancer *cloudscaleclient.LoadBalancer) bool {
+	params := loadBalancer.Spec.ForProvider
+	return loadBalancer.GetLoadBalancerName() == csLoadBalancer.Name &&
+		!cloudscaleclient.TagsNeedUpdate(cloudscaleclient.DesiredTags(params.Tags, loadBalancer.UID), csLoadBalancer.Tags)
+}
+
+// toObservation returns the observed fields of the given load balancer.
+func toObservation(csLoadBalancer *cloudscaleclient.LoadBalancer, project string) cloudscalev1.LoadBalancerObservation {
+	obs := cloudscalev1.LoadBalancerObservation{
+		LoadBalancerUUID: csLoadBalancer.UUID,
+		LoadBalancerName: csLoadBalancer.Name,
+		Flavor:           csLoadBalancer.Flavor.Slug,
+		Zone:             csLoadBalancer.Zone.Slug,
+		Status:           csLoadBalancer.Status,
+		Tags:             cloudscaleclient.FromTagMap(csLoadBalancer.Tags),
+		Project:          project,
+	}
+	for _, vip := range csLoadBalancer.VIPAddresses {
+		obs.VIPAddresses = append(obs.VIPAddresses, cloudscalev1.LoadBalancerVIPAddressObservation{
+			Version:    vip.Version,
+			Address:    vip.Address,
+			SubnetUUID: vip.Subnet.UUID,
+		})
+	}
+	return obs
+}
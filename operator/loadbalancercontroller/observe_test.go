@@ -0,0 +1,97 @@
+package loadbalancercontroller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type fakeLoadBalancerService struct {
+	cloudscaleclient.LoadBalancerService
+	loadBalancer *cloudscaleclient.LoadBalancer
+}
+
+// Get implements cloudscaleclient.LoadBalancerService.
+func (f *fakeLoadBalancerService) Get(_ context.Context, _ string) (*cloudscaleclient.LoadBalancer, error) {
+	return f.loadBalancer, nil
+}
+
+func TestLoadBalancerPipeline_Observe(t *testing.T) {
+	newLoadBalancer := func(name, status string) *cloudscaleclient.LoadBalancer {
+		return &cloudscaleclient.LoadBalancer{
+			ZonalResource: cloudscalesdk.ZonalResource{Zone: cloudscalesdk.Zone{Slug: "lpg1"}},
+			UUID:          "uuid", Name: name, Status: status,
+			Flavor: cloudscaleclient.LoadBalancerFlavorStub{Slug: "lb-standard"},
+			VIPAddresses: []cloudscaleclient.LoadBalancerVIPAddress{
+				{Version: 4, Address: "192.0.2.10", Subnet: cloudscalesdk.SubnetStub{UUID: "subnet"}},
+			},
+		}
+	}
+	tests := map[string]struct {
+		givenAnnotations  map[string]string
+		givenLoadBalancer *cloudscaleclient.LoadBalancer
+
+		expectedResult      managed.ExternalObservation
+		expectedReadyStatus corev1.ConditionStatus
+		expectedObservation cloudscalev1.LoadBalancerObservation
+	}{
+		"GivenNewLoadBalancer_ThenExpectNotExisting": {
+			expectedResult:      managed.ExternalObservation{},
+			expectedReadyStatus: corev1.ConditionUnknown,
+		},
+		"GivenChangingLoadBalancer_ThenExpectUnavailable": {
+			givenAnnotations:    map[string]string{LoadBalancerUUIDAnnotationKey: "uuid"},
+			givenLoadBalancer:   newLoadBalancer("my-lb", "changing"),
+			expectedResult:      managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			expectedReadyStatus: corev1.ConditionFalse,
+			expectedObservation: cloudscalev1.LoadBalancerObservation{
+				LoadBalancerUUID: "uuid", LoadBalancerName: "my-lb", Flavor: "lb-standard", Zone: "lpg1", Status: "changing",
+				VIPAddresses: []cloudscalev1.LoadBalancerVIPAddressObservation{{Version: 4, Address: "192.0.2.10", SubnetUUID: "subnet"}},
+				Tags:         cloudscalev1.Tags{},
+			},
+		},
+		"GivenRunningLoadBalancer_WhenRenamed_ThenExpectAvailableAndNotUpToDate": {
+			givenAnnotations:    map[string]string{LoadBalancerUUIDAnnotationKey: "uuid"},
+			givenLoadBalancer:   newLoadBalancer("old-name", LoadBalancerRunning),
+			expectedResult:      managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			expectedReadyStatus: corev1.ConditionTrue,
+			expectedObservation: cloudscalev1.LoadBalancerObservation{
+				LoadBalancerUUID: "uuid", LoadBalancerName: "old-name", Flavor: "lb-standard", Zone: "lpg1", Status: LoadBalancerRunning,
+				VIPAddresses: []cloudscalev1.LoadBalancerVIPAddressObservation{{Version: 4, Address: "192.0.2.10", SubnetUUID: "subnet"}},
+				Tags:         cloudscalev1.Tags{},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			p := NewPipeline(nil, &fakeLoadBalancerService{loadBalancer: tc.givenLoadBalancer})
+			loadBalancer := &cloudscalev1.LoadBalancer{ObjectMeta: metav1.ObjectMeta{Name: "my-lb", Annotations: tc.givenAnnotations}}
+
+			result, err := p.Observe(logr.NewContext(context.Background(), logr.Discard()), loadBalancer)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, result)
+			assert.Equal(t, tc.expectedReadyStatus, loadBalancer.GetCondition(xpv1.TypeReady).Status)
+			assert.Equal(t, tc.expectedObservation, loadBalancer.Status.AtProvider)
+		})
+	}
+}
+
+func TestPendingPollInterval(t *testing.T) {
+	loadBalancer := &cloudscalev1.LoadBalancer{}
+	loadBalancer.Status.AtProvider.Status = "changing"
+	assert.Equal(t, PendingPollInterval, pendingPollInterval(loadBalancer, time.Hour))
+
+	loadBalancer.Status.AtProvider.Status = LoadBalancerRunning
+	assert.Equal(t, time.Hour, pendingPollInterval(loadBalancer, time.Hour))
+}
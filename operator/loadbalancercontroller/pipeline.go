@@ -0,0 +1,60 @@
+package loadbalancercontroller
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+)
+
+const (
+	// LoadBalancerUUIDAnnotationKey is the annotation key where the load balancer UUID is stored.
+	LoadBalancerUUIDAnnotationKey = "cloudscale.crossplane.io/load-balancer-uuid"
+	// LoadBalancerRunning is the status of a load balancer that is ready to accept traffic.
+	LoadBalancerRunning = "running"
+)
+
+// LoadBalancerPipeline provisions LoadBalancers on cloudscale.ch
+type LoadBalancerPipeline struct {
+	recorder      event.Recorder
+	loadBalancers cloudscaleclient.LoadBalancerService
+}
+
+type pipelineContext struct {
+	context.Context
+	loadBalancer   *cloudscalev1.LoadBalancer
+	csLoadBalancer *cloudscaleclient.LoadBalancer
+}
+
+// ParentContext implements tracing.Context.
+func (c *pipelineContext) ParentContext() context.Context {
+	return c.Context
+}
+
+// SetParentContext implements tracing.Context.
+func (c *pipelineContext) SetParentContext(ctx context.Context) {
+	c.Context = ctx
+}
+
+// NewPipeline returns a new instance of LoadBalancerPipeline.
+func NewPipeline(recorder event.Recorder, loadBalancers cloudscaleclient.LoadBalancerService) *LoadBalancerPipeline {
+	return &LoadBalancerPipeline{
+		recorder:      recorder,
+		loadBalancers: loadBalancers,
+	}
+}
+
+// Disconnect implements managed.ExternalClient.
+func (p *LoadBalancerPipeline) Disconnect(_ context.Context) error {
+	return nil
+}
+
+func isLoadBalancerMissing(ctx *pipelineContext) bool {
+	return ctx.csLoadBalancer == nil
+}
+
+func fromManaged(mg resource.Managed) *cloudscalev1.LoadBalancer {
+	return mg.(*cloudscalev1.LoadBalancer)
+}
@@ -0,0 +1,59 @@
+package loadbalancercontroller
+
+import (
+	"strings"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	"github.com/vshn/provider-cloudscale/operator/controlleropts"
+	"github.com/vshn/provider-cloudscale/operator/ratelimit"
+	"github.com/vshn/provider-cloudscale/operator/tracing"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+)
+
+// DefaultPollInterval is the default interval in which load balancers are observed again.
+// Load balancers are observed more often while they are being provisioned, see PendingPollInterval.
+const DefaultPollInterval = 10 * time.Minute
+
+// PendingPollInterval is the interval in which load balancers are observed again until they're running.
+const PendingPollInterval = 30 * time.Second
+
+// SetupController adds a controller that reconciles cloudscalev1.LoadBalancer managed resources.
+func SetupController(mgr ctrl.Manager, opts controlleropts.Options) error {
+	name := strings.ToLower(cloudscalev1.LoadBalancerGroupKind)
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+	throttler := ratelimit.NewThrottler()
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(cloudscalev1.LoadBalancerGroupVersionKind),
+		managed.WithExternalConnecter(throttler.WrapConnecter(&loadBalancerConnector{
+			recorder:  recorder,
+			connector: cloudscaleclient.NewConnector(mgr.GetClient(), cloudscalev1.LoadBalancerKind),
+		})),
+		managed.WithLogger(logging.NewLogrLogger(mgr.GetLogger().WithValues("controller", name))),
+		managed.WithRecorder(recorder),
+		managed.WithPollIntervalHook(opts.PollIntervalHook(pendingPollInterval)),
+		managed.WithPollInterval(opts.PollInterval),
+		managed.WithConnectionPublishers(opts.ConnectionPublishers(mgr)...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&cloudscalev1.LoadBalancer{}, builder.WithPredicates(opts.Predicates...)).
+		WithOptions(opts.ForControllerRuntime()).
+		Complete(tracing.NewReconciler(cloudscalev1.LoadBalancerKind, throttler.WrapReconciler(r)))
+}
+
+// pendingPollInterval returns a shorter interval for load balancers that aren't running yet, so that they become ready soon after they've been provisioned.
+func pendingPollInterval(mg resource.Managed, pollInterval time.Duration) time.Duration {
+	if fromManaged(mg).Status.AtProvider.Status != LoadBalancerRunning {
+		return min(PendingPollInterval, pollInterval)
+	}
+	return pollInterval
+}
@@ -0,0 +1,53 @@
+package loadbalancercontroller
+
+import (
+	"context"
+
+	pipeline "github.com/ccremer/go-command-pipeline"
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	"github.com/vshn/provider-cloudscale/operator/pipelineutil"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Update implements managed.ExternalClient.
+func (p *LoadBalancerPipeline) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.Info("Updating resource")
+	loadBalancer := fromManaged(mg)
+
+	pctx := &pipelineContext{Context: ctx, loadBalancer: loadBalancer}
+	pipe := pipeline.NewPipeline[*pipelineContext]()
+	stepMetrics := pipelineutil.NewStepMetrics[*pipelineContext](cloudscalev1.LoadBalancerKind)
+	pipe.WithBeforeHooks(pipelineutil.DebugLogger(pctx)).
+		WithSteps(stepMetrics.Steps(
+			pipe.NewStep("update load balancer", p.updateLoadBalancer),
+		)...)
+	err := pipe.RunWithContext(pctx)
+
+	return managed.ExternalUpdate{}, errors.Wrap(err, "cannot update load balancer")
+}
+
+// updateLoadBalancer updates the name and tags of the load balancer identified by UUID.
+func (p *LoadBalancerPipeline) updateLoadBalancer(ctx *pipelineContext) error {
+	log := controllerruntime.LoggerFrom(ctx)
+	loadBalancer := ctx.loadBalancer
+	uuid := loadBalancer.Status.AtProvider.LoadBalancerUUID
+	tags := cloudscaleclient.DesiredTags(loadBalancer.Spec.ForProvider.Tags, loadBalancer.UID)
+
+	err := p.loadBalancers.Update(ctx, uuid, &cloudscaleclient.LoadBalancerRequest{
+		TaggedResourceRequest: cloudscalesdk.TaggedResourceRequest{
+			Tags: cloudscaleclient.ToTagMap(tags),
+		},
+		Name: loadBalancer.GetLoadBalancerName(),
+	})
+	if err != nil {
+		return err
+	}
+	log.V(1).Info("Updated load balancer in cloudscale", "uuid", uuid, "name", loadBalancer.GetLoadBalancerName(), "tags", tags)
+	return nil
+}
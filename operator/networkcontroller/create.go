@@ -0,0 +1,112 @@
+package networkcontroller
+
+import (
+	"context"
+	"fmt"
+
+	pipeline "github.com/ccremer/go-command-pipeline"
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	"github.com/vshn/provider-cloudscale/operator/pipelineutil"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Create implements managed.ExternalClient.
+func (p *NetworkPipeline) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.Info("Creating resource")
+
+	network := fromManaged(mg)
+	if network.Status.AtProvider.NetworkUUID != "" {
+		// Network already exists
+		return managed.ExternalCreation{}, nil
+	}
+
+	pctx := &pipelineContext{Context: ctx, network: network}
+	pipe := pipeline.NewPipeline[*pipelineContext]()
+	stepMetrics := pipelineutil.NewStepMetrics[*pipelineContext](cloudscalev1.NetworkKind)
+	pipe.WithBeforeHooks(pipelineutil.DebugLogger(pctx)).
+		WithSteps(stepMetrics.Steps(
+			pipe.NewStep("find network by owner tag", p.findNetworkByOwnerTag),
+			pipe.When(isNetworkMissing, "create network", p.createNetwork),
+			pipe.NewStep("set network UUID annotation", p.setNetworkUUIDAnnotation),
+			pipe.NewStep("emit event", p.emitCreationEvent),
+		)...)
+	err := pipe.RunWithContext(pctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, "cannot create network")
+	}
+
+	return managed.ExternalCreation{}, nil
+}
+
+// findNetworkByOwnerTag searches for a network that has been created by a previous reconciliation of this resource.
+// See servercontroller for why this is needed.
+// If exactly one network is found, it is adopted.
+func (p *NetworkPipeline) findNetworkByOwnerTag(ctx *pipelineContext) error {
+	csClient := p.csClient
+	log := controllerruntime.LoggerFrom(ctx)
+	network := ctx.network
+
+	if network.UID == "" {
+		return nil
+	}
+	csNetworks, err := csClient.Networks.List(ctx, cloudscalesdk.WithTagFilter(cloudscalesdk.TagMap{cloudscaleclient.OwnerTagKey: string(network.UID)}))
+	if err != nil {
+		return errors.Wrap(err, "cannot list networks by owner tag")
+	}
+	switch len(csNetworks) {
+	case 0:
+		return nil
+	case 1:
+		ctx.csNetwork = &csNetworks[0]
+		log.V(1).Info("Adopting existing network in cloudscale", "uuid", ctx.csNetwork.UUID, "name", ctx.csNetwork.Name)
+		return nil
+	default:
+		return fmt.Errorf("found %d networks with tag %s=%s, expected at most one", len(csNetworks), cloudscaleclient.OwnerTagKey, network.UID)
+	}
+}
+
+// createNetwork creates a new network in the project associated with the API token.
+func (p *NetworkPipeline) createNetwork(ctx *pipelineContext) error {
+	csClient := p.csClient
+	log := controllerruntime.LoggerFrom(ctx)
+	network := ctx.network
+	params := network.Spec.ForProvider
+
+	csNetwork, err := csClient.Networks.Create(ctx, &cloudscalesdk.NetworkCreateRequest{
+		ZonalResourceRequest: cloudscalesdk.ZonalResourceRequest{Zone: params.Zone},
+		TaggedResourceRequest: cloudscalesdk.TaggedResourceRequest{
+			Tags: cloudscaleclient.ToTagMap(cloudscaleclient.DesiredTags(params.Tags, network.UID)),
+		},
+		Name:                 network.GetNetworkName(),
+		MTU:                  params.MTU,
+		AutoCreateIPV4Subnet: params.AutoCreateIPv4Subnet,
+	})
+	if err != nil {
+		return err
+	}
+	log.V(1).Info("Created network in cloudscale", "uuid", csNetwork.UUID, "name", csNetwork.Name)
+	ctx.csNetwork = csNetwork
+	return nil
+}
+
+// setNetworkUUIDAnnotation stores the UUID of the created or adopted network in an annotation.
+func (p *NetworkPipeline) setNetworkUUIDAnnotation(ctx *pipelineContext) error {
+	cloudscaleclient.SetIDAnnotation(&ctx.network.ObjectMeta, NetworkUUIDAnnotationKey, ctx.csNetwork.UUID)
+	return nil
+}
+
+func (p *NetworkPipeline) emitCreationEvent(ctx *pipelineContext) error {
+	p.recorder.Event(ctx.network, event.Event{
+		Type:    event.TypeNormal,
+		Reason:  "Created",
+		Message: "Network successfully created",
+	})
+	return nil
+}
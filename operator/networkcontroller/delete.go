@@ -0,0 +1,57 @@
+package networkcontroller
+
+import (
+	"context"
+
+	pipeline "github.com/ccremer/go-command-pipeline"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	"github.com/vshn/provider-cloudscale/operator/pipelineutil"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Delete implements managed.ExternalClient.
+func (p *NetworkPipeline) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.Info("Deleting resource")
+
+	network := fromManaged(mg)
+	pctx := &pipelineContext{Context: ctx, network: network}
+	pipe := pipeline.NewPipeline[*pipelineContext]()
+	stepMetrics := pipelineutil.NewStepMetrics[*pipelineContext](cloudscalev1.NetworkKind)
+	pipe.WithBeforeHooks(pipelineutil.DebugLogger(pctx)).
+		WithSteps(stepMetrics.Steps(
+			pipe.NewStep("delete network", p.deleteNetwork),
+			pipe.NewStep("emit event", p.emitDeletionEvent),
+		)...)
+	err := pipe.RunWithContext(pctx)
+	return managed.ExternalDelete{}, errors.Wrap(err, "cannot deprovision network")
+}
+
+// deleteNetwork deletes the network including its subnets.
+// cloudscale.ch refuses to delete the network as long as servers are attached to it.
+func (p *NetworkPipeline) deleteNetwork(ctx *pipelineContext) error {
+	csClient := p.csClient
+	log := controllerruntime.LoggerFrom(ctx)
+	uuid := ctx.network.Status.AtProvider.NetworkUUID
+
+	err := csClient.Networks.Delete(ctx, uuid)
+	if err != nil {
+		return resource.Ignore(cloudscaleclient.IsNotFound, err)
+	}
+	log.V(1).Info("Deleted network in cloudscale", "uuid", uuid)
+	return nil
+}
+
+func (p *NetworkPipeline) emitDeletionEvent(ctx *pipelineContext) error {
+	p.recorder.Event(ctx.network, event.Event{
+		Type:    event.TypeNormal,
+		Reason:  "Deleted",
+		Message: "Network deleted",
+	})
+	return nil
+}
@@ -0,0 +1,86 @@
+package networkcontroller
+
+import (
+	"context"
+
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Observe implements managed.ExternalClient.
+func (p *NetworkPipeline) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.V(1).Info("Observing resource")
+
+	network := fromManaged(mg)
+	if network.Status.AtProvider.NetworkUUID == "" {
+		if uuid, exists := cloudscaleclient.IDFromAnnotation(network, NetworkUUIDAnnotationKey); exists {
+			network.Status.AtProvider.NetworkUUID = uuid
+		} else {
+			// New resource, create network first
+			return managed.ExternalObservation{}, nil
+		}
+	}
+
+	pctx := &pipelineContext{Context: ctx, network: network}
+	err := p.getNetwork(pctx)
+	if err != nil {
+		return managed.ExternalObservation{}, resource.Ignore(cloudscaleclient.IsNotFound, err)
+	}
+
+	csNetwork := pctx.csNetwork
+	network.Status.AtProvider = toObservation(csNetwork, network.Status.AtProvider.Project)
+	network.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: isUpToDate(network, csNetwork),
+	}, nil
+}
+
+// getNetwork fetches an existing network from the project associated with the API token.
+func (p *NetworkPipeline) getNetwork(ctx *pipelineContext) error {
+	csClient := p.csClient
+	log := controllerruntime.LoggerFrom(ctx)
+
+	csNetwork, err := csClient.Networks.Get(ctx, ctx.network.Status.AtProvider.NetworkUUID)
+	if err != nil {
+		return err
+	}
+	ctx.csNetwork = csNetwork
+	log.V(1).Info("Fetched network in cloudscale", "uuid", csNetwork.UUID, "name", csNetwork.Name)
+	return nil
+}
+
+// isUpToDate returns true if the updatable fields of the network match the spec.
+// Fields that can't be changed after creation are ignored, and an empty MTU accepts the MTU chosen by cloudscale.ch.
+func isUpToDate(network *cloudscalev1.Network, csNetwork *cloudscalesdk.Network) bool {
+	params := network.Spec.ForProvider
+	return network.GetNetworkName() == csNetwork.Name &&
+		(params.MTU == 0 || params.MTU == csNetwork.MTU) &&
+		!cloudscaleclient.TagsNeedUpdate(cloudscaleclient.DesiredTags(params.Tags, network.UID), csNetwork.Tags)
+}
+
+// toObservation returns the observed fields of the given network.
+func toObservation(csNetwork *cloudscalesdk.Network, project string) cloudscalev1.NetworkObservation {
+	obs := cloudscalev1.NetworkObservation{
+		NetworkUUID: csNetwork.UUID,
+		NetworkName: csNetwork.Name,
+		Zone:        csNetwork.Zone.Slug,
+		MTU:         csNetwork.MTU,
+		Tags:        cloudscaleclient.FromTagMap(csNetwork.Tags),
+		Project:     project,
+	}
+	for _, subnet := range csNetwork.Subnets {
+		obs.Subnets = append(obs.Subnets, cloudscalev1.NetworkSubnetObservation{
+			SubnetUUID: subnet.UUID,
+			CIDR:       subnet.CIDR,
+		})
+	}
+	return obs
+}
@@ -0,0 +1,74 @@
+package networkcontroller
+
+import (
+	"testing"
+
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/stretchr/testify/assert"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestIsUpToDate(t *testing.T) {
+	tests := map[string]struct {
+		givenMTU    int
+		observeMTU  int
+		observeName string
+		expected    bool
+	}{
+		"GivenNoMTU_WhenDefaultMTU_ThenExpectUpToDate": {
+			observeMTU: 9000, observeName: "my-network", expected: true,
+		},
+		"GivenMTU_WhenSameMTU_ThenExpectUpToDate": {
+			givenMTU: 1500, observeMTU: 1500, observeName: "my-network", expected: true,
+		},
+		"GivenMTU_WhenDifferentMTU_ThenExpectNotUpToDate": {
+			givenMTU: 1500, observeMTU: 9000, observeName: "my-network", expected: false,
+		},
+		"GivenName_WhenRenamed_ThenExpectNotUpToDate": {
+			observeMTU: 9000, observeName: "other", expected: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			network := &cloudscalev1.Network{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-network", UID: "uid"},
+				Spec:       cloudscalev1.NetworkSpec{ForProvider: cloudscalev1.NetworkParameters{MTU: tc.givenMTU}},
+			}
+			csNetwork := &cloudscalesdk.Network{
+				Name:           tc.observeName,
+				MTU:            tc.observeMTU,
+				TaggedResource: cloudscalesdk.TaggedResource{Tags: cloudscalesdk.TagMap{cloudscaleclient.OwnerTagKey: "uid"}},
+			}
+			assert.Equal(t, tc.expected, isUpToDate(network, csNetwork))
+		})
+	}
+}
+
+func TestToObservation(t *testing.T) {
+	csNetwork := &cloudscalesdk.Network{
+		UUID:          "uuid",
+		Name:          "my-network",
+		MTU:           9000,
+		ZonalResource: cloudscalesdk.ZonalResource{Zone: cloudscalesdk.Zone{Slug: "rma1"}},
+		Subnets: []cloudscalesdk.SubnetStub{
+			{UUID: "subnet-1", CIDR: "10.11.12.0/24"},
+			{UUID: "subnet-2", CIDR: "10.11.13.0/24"},
+		},
+	}
+
+	obs := toObservation(csNetwork, "project")
+	assert.Equal(t, cloudscalev1.NetworkObservation{
+		NetworkUUID: "uuid",
+		NetworkName: "my-network",
+		Zone:        "rma1",
+		MTU:         9000,
+		Subnets: []cloudscalev1.NetworkSubnetObservation{
+			{SubnetUUID: "subnet-1", CIDR: "10.11.12.0/24"},
+			{SubnetUUID: "subnet-2", CIDR: "10.11.13.0/24"},
+		},
+		Tags:    cloudscalev1.Tags{},
+		Project: "project",
+	}, obs)
+}
@@ -0,0 +1,58 @@
+package networkcontroller
+
+import (
+	"context"
+
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+)
+
+const (
+	// NetworkUUIDAnnotationKey is the annotation key where the network UUID is stored.
+	NetworkUUIDAnnotationKey = "cloudscale.crossplane.io/network-uuid"
+)
+
+// NetworkPipeline provisions Networks on cloudscale.ch
+type NetworkPipeline struct {
+	recorder event.Recorder
+	csClient *cloudscalesdk.Client
+}
+
+type pipelineContext struct {
+	context.Context
+	network   *cloudscalev1.Network
+	csNetwork *cloudscalesdk.Network
+}
+
+// ParentContext implements tracing.Context.
+func (c *pipelineContext) ParentContext() context.Context {
+	return c.Context
+}
+
+// SetParentContext implements tracing.Context.
+func (c *pipelineContext) SetParentContext(ctx context.Context) {
+	c.Context = ctx
+}
+
+// NewPipeline returns a new instance of NetworkPipeline.
+func NewPipeline(recorder event.Recorder, csClient *cloudscalesdk.Client) *NetworkPipeline {
+	return &NetworkPipeline{
+		recorder: recorder,
+		csClient: csClient,
+	}
+}
+
+// Disconnect implements managed.ExternalClient.
+func (p *NetworkPipeline) Disconnect(_ context.Context) error {
+	return nil
+}
+
+func isNetworkMissing(ctx *pipelineContext) bool {
+	return ctx.csNetwork == nil
+}
+
+func fromManaged(mg resource.Managed) *cloudscalev1.Network {
+	return mg.(*cloudscalev1.Network)
+}
@@ -0,0 +1,56 @@
+package networkcontroller
+
+import (
+	"context"
+
+	pipeline "github.com/ccremer/go-command-pipeline"
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	"github.com/vshn/provider-cloudscale/operator/pipelineutil"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Update implements managed.ExternalClient.
+func (p *NetworkPipeline) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.Info("Updating resource")
+	network := fromManaged(mg)
+
+	pctx := &pipelineContext{Context: ctx, network: network}
+	pipe := pipeline.NewPipeline[*pipelineContext]()
+	stepMetrics := pipelineutil.NewStepMetrics[*pipelineContext](cloudscalev1.NetworkKind)
+	pipe.WithBeforeHooks(pipelineutil.DebugLogger(pctx)).
+		WithSteps(stepMetrics.Steps(
+			pipe.NewStep("update network", p.updateNetwork),
+		)...)
+	err := pipe.RunWithContext(pctx)
+
+	return managed.ExternalUpdate{}, errors.Wrap(err, "cannot update network")
+}
+
+// updateNetwork updates the name, MTU and tags of the network identified by UUID.
+func (p *NetworkPipeline) updateNetwork(ctx *pipelineContext) error {
+	csClient := p.csClient
+	log := controllerruntime.LoggerFrom(ctx)
+	network := ctx.network
+	params := network.Spec.ForProvider
+	uuid := network.Status.AtProvider.NetworkUUID
+	tags := cloudscaleclient.DesiredTags(params.Tags, network.UID)
+
+	err := csClient.Networks.Update(ctx, uuid, &cloudscalesdk.NetworkUpdateRequest{
+		TaggedResourceRequest: cloudscalesdk.TaggedResourceRequest{
+			Tags: cloudscaleclient.ToTagMap(tags),
+		},
+		Name: network.GetNetworkName(),
+		MTU:  params.MTU,
+	})
+	if err != nil {
+		return err
+	}
+	log.V(1).Info("Updated network in cloudscale", "uuid", uuid, "name", network.GetNetworkName(), "mtu", params.MTU, "tags", tags)
+	return nil
+}
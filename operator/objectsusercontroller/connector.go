@@ -2,7 +2,6 @@ package objectsusercontroller
 
 import (
 	"context"
-	"fmt"
 
 	pipeline "github.com/ccremer/go-command-pipeline"
 	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
@@ -12,9 +11,9 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
 	providerv1 "github.com/vshn/provider-cloudscale/apis/provider/v1"
+	"github.com/vshn/provider-cloudscale/operator/clientcache"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
 	"github.com/vshn/provider-cloudscale/operator/pipelineutil"
-	"golang.org/x/oauth2"
-	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -22,20 +21,30 @@ import (
 
 const (
 	// CloudscaleAPITokenKey identifies the key in which the API token of the cloudscale.ch API is expected in a Secret.
-	CloudscaleAPITokenKey = "CLOUDSCALE_API_TOKEN"
+	CloudscaleAPITokenKey = cloudscaleclient.APITokenKey
 )
 
 type objectsUserConnector struct {
 	kube     client.Client
 	recorder event.Recorder
+	clients  *clientcache.Cache[*cloudscalesdk.Client]
 }
 
 type connectContext struct {
 	context.Context
 	user           *cloudscalev1.ObjectsUser
 	providerConfig *providerv1.ProviderConfig
-	apiTokenSecret *corev1.Secret
-	apiToken       string
+	csClient       *cloudscalesdk.Client
+}
+
+// ParentContext implements tracing.Context.
+func (c *connectContext) ParentContext() context.Context {
+	return c.Context
+}
+
+// SetParentContext implements tracing.Context.
+func (c *connectContext) SetParentContext(ctx context.Context) {
+	c.Context = ctx
 }
 
 // Connect implements managed.ExternalConnecter.
@@ -46,51 +55,51 @@ func (c *objectsUserConnector) Connect(ctx context.Context, mg resource.Managed)
 	user := fromManaged(mg)
 	pctx := &connectContext{Context: ctx, user: user}
 	p := pipeline.NewPipeline[*connectContext]()
+	stepMetrics := pipelineutil.NewStepMetrics[*connectContext](cloudscalev1.ObjectsUserKind)
 	err := p.WithBeforeHooks(pipelineutil.DebugLogger(pctx)).
-		WithSteps(
+		WithSteps(stepMetrics.Steps(
 			p.NewStep("track provider config", c.trackProviderConfig),
 			p.NewStep("fetch provider config", c.fetchProviderConfig),
-			p.NewStep("fetch API token", c.fetchApiTokenSecret),
-			p.NewStep("read API token", c.readApiToken),
-		).RunWithContext(pctx)
+			p.NewStep("get cloudscale client", c.getCloudscaleClient),
+		)...).RunWithContext(pctx)
 	if err != nil {
 		return nil, err
 	}
-	csClient := c.createCloudscaleClient(pctx)
-	return NewPipeline(c.kube, c.recorder, csClient), nil
+	return NewPipeline(c.kube, c.recorder, pctx.csClient), nil
 }
 
-// createCloudscaleClient creates a new kube using the API token provided.
-func (c *objectsUserConnector) createCloudscaleClient(ctx *connectContext) *cloudscalesdk.Client {
-	token := ctx.apiToken
-	tc := oauth2.NewClient(ctx, oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token}))
-	csClient := cloudscalesdk.NewClient(tc)
-	return csClient
+// getCloudscaleClient returns the cached client of the ProviderConfig.
+// A new client is created if there is none yet, or if the credentials of the ProviderConfig changed since.
+func (c *objectsUserConnector) getCloudscaleClient(ctx *connectContext) error {
+	version, err := cloudscaleclient.CredentialsVersion(ctx, c.kube, ctx.providerConfig)
+	if err != nil {
+		return err
+	}
+	csClient, err := c.clients.Get(string(ctx.providerConfig.UID), version, func() (*cloudscalesdk.Client, error) {
+		return c.createCloudscaleClient(ctx)
+	})
+	ctx.csClient = csClient
+	return err
+}
+
+// createCloudscaleClient creates a new client using the API token of the ProviderConfig.
+func (c *objectsUserConnector) createCloudscaleClient(ctx *connectContext) (*cloudscalesdk.Client, error) {
+	token, err := cloudscaleclient.ExtractAPIToken(ctx, c.kube, ctx.providerConfig)
+	if err != nil {
+		return nil, err
+	}
+	return cloudscaleclient.New(token, cloudscalev1.ObjectsUserKind, ctx.providerConfig)
 }
 
 func (c *objectsUserConnector) fetchProviderConfig(ctx *connectContext) error {
 	config := &providerv1.ProviderConfig{}
 	err := c.kube.Get(ctx, types.NamespacedName{Name: ctx.user.Spec.ProviderConfigReference.Name}, config)
-	ctx.providerConfig = config
-	return errors.Wrap(err, "cannot get ProviderConfig")
-}
-
-func (c *objectsUserConnector) fetchApiTokenSecret(ctx *connectContext) error {
-	providerConfig := ctx.providerConfig
-	secretRef := providerConfig.Spec.Credentials.APITokenSecretRef
-	secret := &corev1.Secret{}
-	err := c.kube.Get(ctx, types.NamespacedName{Name: secretRef.Name, Namespace: secretRef.Namespace}, secret)
-	ctx.apiTokenSecret = secret
-	return errors.Wrap(err, "cannot get secret with API token")
-}
-
-func (c *objectsUserConnector) readApiToken(ctx *connectContext) error {
-	secret := ctx.apiTokenSecret
-	if value, exists := secret.Data[CloudscaleAPITokenKey]; exists && string(value) != "" {
-		ctx.apiToken = string(value)
-		return nil
+	if err != nil {
+		return errors.Wrap(err, "cannot get ProviderConfig")
 	}
-	return fmt.Errorf("%s doesn't exist in secret %s/%s", CloudscaleAPITokenKey, secret.Namespace, secret.Name)
+	ctx.providerConfig = config
+	ctx.user.Status.AtProvider.Project = config.Spec.Project
+	return nil
 }
 
 // trackProviderConfig ensures that the ProviderConfig referenced by the ObjectsUser is not deleted until all ObjectsUser stop using the ProviderConfig.
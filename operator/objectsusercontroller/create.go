@@ -12,6 +12,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
 	"github.com/vshn/provider-cloudscale/operator/pipelineutil"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -33,14 +34,19 @@ func (p *ObjectsUserPipeline) Create(ctx context.Context, mg resource.Managed) (
 
 	pctx := &pipelineContext{Context: ctx, user: user}
 	pipe := pipeline.NewPipeline[*pipelineContext]()
+	stepMetrics := pipelineutil.NewStepMetrics[*pipelineContext](cloudscalev1.ObjectsUserKind)
 	pipe.WithBeforeHooks(pipelineutil.DebugLogger(pctx)).
-		WithSteps(
-			pipe.NewStep("create objects user", p.createObjectsUser),
+		WithSteps(stepMetrics.Steps(
+			pipe.NewStep("find objects user by owner tag", p.findObjectsUserByOwnerTag),
+			pipe.When(isObjectsUserMissing,
+				"create objects user", p.createObjectsUser,
+			),
+			pipe.NewStep("set user ID annotation", p.setUserIDAnnotation),
 			pipe.When(hasSecretRef,
 				"ensure credentials secret", p.ensureCredentialsSecret,
 			),
 			pipe.NewStep("emit event", p.emitCreationEvent),
-		)
+		)...)
 	err := pipe.RunWithContext(pctx)
 	if err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, "cannot create objects user")
@@ -49,6 +55,34 @@ func (p *ObjectsUserPipeline) Create(ctx context.Context, mg resource.Managed) (
 	return managed.ExternalCreation{ConnectionDetails: toConnectionDetails(pctx.csUser)}, nil
 }
 
+// findObjectsUserByOwnerTag searches for an objects user that has been created by a previous reconciliation of this resource.
+// If the process is interrupted after the user has been created in cloudscale.ch but before the user ID annotation has been persisted,
+// the next reconciliation would otherwise create another user with the same display name.
+// If exactly one user is found, it is adopted.
+func (p *ObjectsUserPipeline) findObjectsUserByOwnerTag(ctx *pipelineContext) error {
+	csClient := p.csClient
+	log := controllerruntime.LoggerFrom(ctx)
+	user := ctx.user
+
+	if user.UID == "" {
+		return nil
+	}
+	csUsers, err := csClient.ObjectsUsers.List(ctx, cloudscalesdk.WithTagFilter(cloudscalesdk.TagMap{OwnerTagKey: string(user.UID)}))
+	if err != nil {
+		return errors.Wrap(err, "cannot list objects users by owner tag")
+	}
+	switch len(csUsers) {
+	case 0:
+		return nil
+	case 1:
+		ctx.csUser = &csUsers[0]
+		log.V(1).Info("Adopting existing objects user in cloudscale", "userID", ctx.csUser.ID, "displayName", ctx.csUser.DisplayName)
+		return nil
+	default:
+		return fmt.Errorf("found %d objects users with tag %s=%s, expected at most one", len(csUsers), OwnerTagKey, user.UID)
+	}
+}
+
 // createObjectsUser creates a new objects user in the project associated with the API token.
 func (p *ObjectsUserPipeline) createObjectsUser(ctx *pipelineContext) error {
 	csClient := p.csClient
@@ -58,24 +92,23 @@ func (p *ObjectsUserPipeline) createObjectsUser(ctx *pipelineContext) error {
 	csUser, err := csClient.ObjectsUsers.Create(ctx, &cloudscalesdk.ObjectsUserRequest{
 		DisplayName: user.GetDisplayName(),
 		TaggedResourceRequest: cloudscalesdk.TaggedResourceRequest{
-			Tags: toTagMap(user.Spec.ForProvider.Tags),
+			Tags: toTagMap(getDesiredTags(user)),
 		},
 	})
 	if err != nil {
 		return err
 	}
-	// Limitation by crossplane: The interface managed.ExternalClient doesn't allow updating the resource during creation except annotations.
-	// But we need to somehow store the user ID returned by the creation operation, because cloudscale API allows multiple users with the same display name.
-	// So we store it in an annotation since that is the only allowed place to update our resource.
-	// However, once we observe the spec again, we will copy the user ID from the annotation to the status field,
-	//  and that will become the authoritative source of truth for future reconciliations.
-	metav1.SetMetaDataAnnotation(&user.ObjectMeta, UserIDAnnotationKey, csUser.ID)
-
 	log.V(1).Info("Created objects user in cloudscale", "userID", csUser.ID, "displayName", csUser.DisplayName, "tags", csUser.Tags)
 	ctx.csUser = csUser
 	return nil
 }
 
+// setUserIDAnnotation stores the ID of the created or adopted objects user in an annotation.
+func (p *ObjectsUserPipeline) setUserIDAnnotation(ctx *pipelineContext) error {
+	cloudscaleclient.SetIDAnnotation(&ctx.user.ObjectMeta, UserIDAnnotationKey, ctx.csUser.ID)
+	return nil
+}
+
 func (p *ObjectsUserPipeline) emitCreationEvent(ctx *pipelineContext) error {
 	p.recorder.Event(ctx.user, event.Event{
 		Type:    event.TypeNormal,
@@ -2,11 +2,15 @@ package objectsusercontroller
 
 import (
 	"context"
+	"net/http"
 	"testing"
 
 	pipeline "github.com/ccremer/go-command-pipeline"
 	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
 	"github.com/vshn/provider-cloudscale/operator/operatortest"
@@ -61,3 +65,67 @@ func (ts *ObjectsUserPipelineSuite) Test_ensureCredentialSecretFn() {
 	ts.Assert().Equal("secret", string(result.Data["AWS_SECRET_ACCESS_KEY"]), "secret key value")
 	ts.Assert().Equal(types.UID("uid"), result.OwnerReferences[0].UID, "owner reference set")
 }
+
+type fakeObjectsUsersService struct {
+	cloudscalesdk.ObjectsUsersService
+	users      []cloudscalesdk.ObjectsUser
+	err        error
+	givenQuery string
+}
+
+// List implements cloudscalesdk.ObjectsUsersService.
+func (f *fakeObjectsUsersService) List(_ context.Context, modifiers ...cloudscalesdk.ListRequestModifier) ([]cloudscalesdk.ObjectsUser, error) {
+	req, _ := http.NewRequest(http.MethodGet, "https://api.cloudscale.ch/v1/objects-users", nil)
+	for _, modifier := range modifiers {
+		modifier(req)
+	}
+	f.givenQuery = req.URL.RawQuery
+	return f.users, f.err
+}
+
+func TestObjectsUserPipeline_findObjectsUserByOwnerTag(t *testing.T) {
+	tests := map[string]struct {
+		givenUID      types.UID
+		givenUsers    []cloudscalesdk.ObjectsUser
+		expectedQuery string
+		expectedUser  *cloudscalesdk.ObjectsUser
+		expectedError string
+	}{
+		"GivenNoUID_ThenExpectNoLookup": {
+			givenUsers: []cloudscalesdk.ObjectsUser{{ID: "id"}},
+		},
+		"GivenUID_WhenNoUserFound_ThenExpectNil": {
+			givenUID:      "uid",
+			expectedQuery: "tag%3Acloudscale.crossplane.io%2Fowner-uid=uid",
+		},
+		"GivenUID_WhenOneUserFound_ThenExpectAdoption": {
+			givenUID:      "uid",
+			givenUsers:    []cloudscalesdk.ObjectsUser{{ID: "id"}},
+			expectedQuery: "tag%3Acloudscale.crossplane.io%2Fowner-uid=uid",
+			expectedUser:  &cloudscalesdk.ObjectsUser{ID: "id"},
+		},
+		"GivenUID_WhenMultipleUsersFound_ThenExpectError": {
+			givenUID:      "uid",
+			givenUsers:    []cloudscalesdk.ObjectsUser{{ID: "id1"}, {ID: "id2"}},
+			expectedQuery: "tag%3Acloudscale.crossplane.io%2Fowner-uid=uid",
+			expectedError: "found 2 objects users with tag cloudscale.crossplane.io/owner-uid=uid, expected at most one",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			svc := &fakeObjectsUsersService{users: tc.givenUsers}
+			p := ObjectsUserPipeline{csClient: &cloudscalesdk.Client{ObjectsUsers: svc}}
+			user := &cloudscalev1.ObjectsUser{ObjectMeta: metav1.ObjectMeta{UID: tc.givenUID}}
+
+			ctx := &pipelineContext{Context: logr.NewContext(context.Background(), logr.Discard()), user: user}
+			err := p.findObjectsUserByOwnerTag(ctx)
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedQuery, svc.givenQuery)
+			assert.Equal(t, tc.expectedUser, ctx.csUser)
+		})
+	}
+}
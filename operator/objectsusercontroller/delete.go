@@ -8,6 +8,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
 	"github.com/vshn/provider-cloudscale/operator/pipelineutil"
 	controllerruntime "sigs.k8s.io/controller-runtime"
 )
@@ -20,11 +21,12 @@ func (p *ObjectsUserPipeline) Delete(ctx context.Context, mg resource.Managed) (
 	user := fromManaged(mg)
 	pctx := &pipelineContext{Context: ctx, user: user}
 	pipe := pipeline.NewPipeline[*pipelineContext]()
+	stepMetrics := pipelineutil.NewStepMetrics[*pipelineContext](cloudscalev1.ObjectsUserKind)
 	pipe.WithBeforeHooks(pipelineutil.DebugLogger(pctx)).
-		WithSteps(
+		WithSteps(stepMetrics.Steps(
 			pipe.NewStep("delete objects user", p.deleteObjectsUser),
 			pipe.NewStep("emit event", p.emitDeletionEvent),
-		)
+		)...)
 	err := pipe.RunWithContext(pctx)
 	return managed.ExternalDelete{}, errors.Wrap(err, "cannot deprovision objects user")
 }
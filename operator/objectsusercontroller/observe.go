@@ -5,11 +5,11 @@ import (
 	"fmt"
 
 	pipeline "github.com/ccremer/go-command-pipeline"
-	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
 	"github.com/vshn/provider-cloudscale/operator/pipelineutil"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -23,8 +23,7 @@ func (p *ObjectsUserPipeline) Observe(ctx context.Context, mg resource.Managed)
 
 	user := fromManaged(mg)
 	if user.Status.AtProvider.UserID == "" {
-		if userId, exists := user.Annotations[UserIDAnnotationKey]; exists {
-			// get the user ID generated by Create() via annotation, since in Create() we're not allowed to update the status.
+		if userId, exists := cloudscaleclient.IDFromAnnotation(user, UserIDAnnotationKey); exists {
 			user.Status.AtProvider.UserID = userId
 			delete(user.Annotations, UserIDAnnotationKey) // might not work
 		} else {
@@ -36,24 +35,26 @@ func (p *ObjectsUserPipeline) Observe(ctx context.Context, mg resource.Managed)
 	pctx := &pipelineContext{Context: ctx, user: user}
 	err := p.getObjectsUser(pctx)
 	if err != nil {
-		return managed.ExternalObservation{}, resource.Ignore(isNotFound, err)
+		return managed.ExternalObservation{}, resource.Ignore(cloudscaleclient.IsNotFound, err)
 	}
 
 	csUser := pctx.csUser
 	user.Status.AtProvider.Tags = fromTagMap(csUser.Tags)
 	user.Status.AtProvider.DisplayName = csUser.DisplayName
 
-	if tagsNeedUpdate(user.Spec.ForProvider.Tags, csUser.Tags) || user.GetDisplayName() != csUser.DisplayName {
+	if tagsNeedUpdate(getDesiredTags(user), csUser.Tags) || user.GetDisplayName() != csUser.DisplayName {
 		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: toConnectionDetails(csUser)}, nil
 	}
 
 	pipe := pipeline.NewPipeline[*pipelineContext]()
-	result := pipe.WithBeforeHooks(pipelineutil.DebugLogger(pctx)).WithSteps(
-		pipe.WithNestedSteps("observe credentials secret", hasSecretRef,
-			pipe.NewStep("fetch credentials secret", p.fetchCredentialsSecret),
-			pipe.NewStep("compare credentials", p.checkCredentials),
-		).WithErrorHandler(p.observeCredentialsHandler),
-	).RunWithContext(pctx)
+	stepMetrics := pipelineutil.NewStepMetrics[*pipelineContext](cloudscalev1.ObjectsUserKind)
+	result := pipe.WithBeforeHooks(pipelineutil.DebugLogger(pctx)).
+		WithSteps(stepMetrics.Steps(
+			pipe.WithNestedSteps("observe credentials secret", hasSecretRef, stepMetrics.Steps(
+				pipe.NewStep("fetch credentials secret", p.fetchCredentialsSecret),
+				pipe.NewStep("compare credentials", p.checkCredentials),
+			)...).WithErrorHandler(p.observeCredentialsHandler),
+		)...).RunWithContext(pctx)
 	if result != nil {
 		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: toConnectionDetails(csUser)}, nil
 	}
@@ -106,11 +107,3 @@ func (p *ObjectsUserPipeline) observeCredentialsHandler(ctx *pipelineContext, er
 	log.V(1).Error(err, "Credentials Secret needs reconciling")
 	return nil
 }
-
-func isNotFound(err error) bool {
-	var errResp *cloudscalesdk.ErrorResponse
-	if errors.As(err, &errResp) {
-		return errResp.StatusCode == 404
-	}
-	return false
-}
@@ -15,6 +15,9 @@ import (
 const (
 	// UserIDAnnotationKey is the annotation key where the ObjectsUser ID is stored.
 	UserIDAnnotationKey = "cloudscale.crossplane.io/user-id"
+	// OwnerTagKey is the tag key in cloudscale.ch where the UID of the ObjectsUser resource is stored.
+	// It marks the objects user as being owned by the ObjectsUser resource.
+	OwnerTagKey = "cloudscale.crossplane.io/owner-uid"
 )
 
 // ObjectsUserPipeline provisions ObjectsUsers on cloudscale.ch
@@ -35,6 +38,16 @@ type pipelineContext struct {
 	credentialsSecret *corev1.Secret
 }
 
+// ParentContext implements tracing.Context.
+func (c *pipelineContext) ParentContext() context.Context {
+	return c.Context
+}
+
+// SetParentContext implements tracing.Context.
+func (c *pipelineContext) SetParentContext(ctx context.Context) {
+	c.Context = ctx
+}
+
 // NewPipeline returns a new instance of ObjectsUserPipeline.
 func NewPipeline(client client.Client, recorder event.Recorder, csClient *cloudscalesdk.Client) *ObjectsUserPipeline {
 	return &ObjectsUserPipeline{
@@ -48,6 +61,10 @@ func hasSecretRef(ctx *pipelineContext) bool {
 	return ctx.user.Spec.WriteConnectionSecretToReference != nil
 }
 
+func isObjectsUserMissing(ctx *pipelineContext) bool {
+	return ctx.csUser == nil
+}
+
 func toConnectionDetails(csUser *cloudscalesdk.ObjectsUser) managed.ConnectionDetails {
 	if csUser == nil {
 		return map[string][]byte{}
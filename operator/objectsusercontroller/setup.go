@@ -4,34 +4,47 @@ import (
 	"strings"
 	"time"
 
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/clientcache"
+	"github.com/vshn/provider-cloudscale/operator/controlleropts"
+	"github.com/vshn/provider-cloudscale/operator/ratelimit"
+	"github.com/vshn/provider-cloudscale/operator/tracing"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 )
 
+// DefaultPollInterval is the default interval in which objects users are observed again.
+// Objects users are rather static.
+const DefaultPollInterval = 1 * time.Hour
+
 // SetupController adds a controller that reconciles cloudscalev1.ObjectsUser managed resources.
-func SetupController(mgr ctrl.Manager) error {
+func SetupController(mgr ctrl.Manager, opts controlleropts.Options) error {
 	name := strings.ToLower(cloudscalev1.ObjectsUserGroupKind)
 
-	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
 	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+	throttler := ratelimit.NewThrottler()
 
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(cloudscalev1.ObjectsUserGroupVersionKind),
-		managed.WithExternalConnecter(&objectsUserConnector{
+		managed.WithExternalConnecter(throttler.WrapConnecter(&objectsUserConnector{
 			kube:     mgr.GetClient(),
 			recorder: recorder,
-		}),
+			clients:  clientcache.New[*cloudscalesdk.Client](clientcache.DefaultMaxIdle),
+		})),
 		managed.WithLogger(logging.NewLogrLogger(mgr.GetLogger().WithValues("controller", name))),
 		managed.WithRecorder(recorder),
-		managed.WithPollInterval(1*time.Hour), // object users are rather static
-		managed.WithConnectionPublishers(cps...))
+		managed.WithPollIntervalHook(opts.PollIntervalHook(nil)),
+		managed.WithPollInterval(opts.PollInterval),
+		managed.WithConnectionPublishers(opts.ConnectionPublishers(mgr)...))
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		For(&cloudscalev1.ObjectsUser{}).
-		Complete(r)
+		For(&cloudscalev1.ObjectsUser{}, builder.WithPredicates(opts.Predicates...)).
+		WithOptions(opts.ForControllerRuntime()).
+		Complete(tracing.NewReconciler(cloudscalev1.ObjectsUserKind, throttler.WrapReconciler(r)))
 }
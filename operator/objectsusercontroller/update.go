@@ -8,6 +8,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
 	"github.com/vshn/provider-cloudscale/operator/pipelineutil"
 	controllerruntime "sigs.k8s.io/controller-runtime"
 )
@@ -20,13 +21,14 @@ func (p *ObjectsUserPipeline) Update(ctx context.Context, mg resource.Managed) (
 
 	pctx := &pipelineContext{Context: ctx, user: user}
 	pipe := pipeline.NewPipeline[*pipelineContext]()
+	stepMetrics := pipelineutil.NewStepMetrics[*pipelineContext](cloudscalev1.ObjectsUserKind)
 	pipe.WithBeforeHooks(pipelineutil.DebugLogger(pctx)).
-		WithSteps(
+		WithSteps(stepMetrics.Steps(
 			pipe.NewStep("update objects user", p.updateObjectsUser),
 			pipe.When(hasSecretRef,
 				"ensure credentials secret", p.ensureCredentialsSecret,
 			),
-		)
+		)...)
 	err := pipe.RunWithContext(pctx)
 
 	return managed.ExternalUpdate{}, errors.Wrap(err, "cannot update objects user")
@@ -38,7 +40,7 @@ func (p *ObjectsUserPipeline) updateObjectsUser(ctx *pipelineContext) error {
 	log := controllerruntime.LoggerFrom(ctx)
 	user := ctx.user
 	id := user.Status.AtProvider.UserID
-	tags := user.Spec.ForProvider.Tags
+	tags := getDesiredTags(user)
 
 	if err := csClient.ObjectsUsers.Update(ctx, id, &cloudscalesdk.ObjectsUserRequest{
 		DisplayName: user.GetDisplayName(),
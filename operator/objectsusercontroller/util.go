@@ -16,6 +16,19 @@ func toTagMap(tags cloudscalev1.Tags) *cloudscalesdk.TagMap {
 	return &tagMap
 }
 
+// getDesiredTags returns the tags from the spec together with the owner tag.
+// The owner tag is omitted if the ObjectsUser has no UID.
+func getDesiredTags(user *cloudscalev1.ObjectsUser) cloudscalev1.Tags {
+	tags := make(cloudscalev1.Tags, len(user.Spec.ForProvider.Tags)+1)
+	for k, v := range user.Spec.ForProvider.Tags {
+		tags[k] = v
+	}
+	if user.UID != "" {
+		tags[OwnerTagKey] = string(user.UID)
+	}
+	return tags
+}
+
 func fromTagMap(tagMap cloudscalesdk.TagMap) cloudscalev1.Tags {
 	tags := make(cloudscalev1.Tags)
 	for k, v := range tagMap {
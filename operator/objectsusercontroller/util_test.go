@@ -6,6 +6,8 @@ import (
 	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
 	"github.com/stretchr/testify/assert"
 	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 func TestTagsNeedUpdate(t *testing.T) {
@@ -41,3 +43,43 @@ func TestTagsNeedUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestGetDesiredTags(t *testing.T) {
+	tests := map[string]struct {
+		givenUID       types.UID
+		givenTags      cloudscalev1.Tags
+		expectedResult cloudscalev1.Tags
+	}{
+		"GivenNoUID_WhenNoTags_ThenExpectEmpty": {
+			expectedResult: cloudscalev1.Tags{},
+		},
+		"GivenNoUID_WhenTags_ThenExpectSpecTags": {
+			givenTags:      cloudscalev1.Tags{"key": "value"},
+			expectedResult: cloudscalev1.Tags{"key": "value"},
+		},
+		"GivenUID_WhenNoTags_ThenExpectOwnerTag": {
+			givenUID:       "uid",
+			expectedResult: cloudscalev1.Tags{OwnerTagKey: "uid"},
+		},
+		"GivenUID_WhenTags_ThenExpectMerged": {
+			givenUID:       "uid",
+			givenTags:      cloudscalev1.Tags{"key": "value"},
+			expectedResult: cloudscalev1.Tags{"key": "value", OwnerTagKey: "uid"},
+		},
+		"GivenUID_WhenOwnerTagInSpec_ThenExpectOverridden": {
+			givenUID:       "uid",
+			givenTags:      cloudscalev1.Tags{OwnerTagKey: "other"},
+			expectedResult: cloudscalev1.Tags{OwnerTagKey: "uid"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			user := &cloudscalev1.ObjectsUser{
+				ObjectMeta: metav1.ObjectMeta{UID: tc.givenUID},
+				Spec:       cloudscalev1.ObjectsUserSpec{ForProvider: cloudscalev1.ObjectsUserParameters{Tags: tc.givenTags}},
+			}
+			result := getDesiredTags(user)
+			assert.Equal(t, tc.expectedResult, result)
+		})
+	}
+}
@@ -1,30 +1,114 @@
 package operator
 
 import (
+	"slices"
+
 	"github.com/vshn/provider-cloudscale/operator/bucketcontroller"
+	"github.com/vshn/provider-cloudscale/operator/catalogcontroller"
 	"github.com/vshn/provider-cloudscale/operator/configcontroller"
+	"github.com/vshn/provider-cloudscale/operator/controlleropts"
+	"github.com/vshn/provider-cloudscale/operator/customimagecontroller"
+	"github.com/vshn/provider-cloudscale/operator/floatingipcontroller"
+	"github.com/vshn/provider-cloudscale/operator/healthmonitorcontroller"
+	"github.com/vshn/provider-cloudscale/operator/listenercontroller"
+	"github.com/vshn/provider-cloudscale/operator/loadbalancercontroller"
+	"github.com/vshn/provider-cloudscale/operator/networkcontroller"
 	"github.com/vshn/provider-cloudscale/operator/objectsusercontroller"
+	"github.com/vshn/provider-cloudscale/operator/poolcontroller"
+	"github.com/vshn/provider-cloudscale/operator/poolmembercontroller"
+	"github.com/vshn/provider-cloudscale/operator/servercontroller"
+	"github.com/vshn/provider-cloudscale/operator/servergroupcontroller"
+	"github.com/vshn/provider-cloudscale/operator/sharding"
+	"github.com/vshn/provider-cloudscale/operator/subnetcontroller"
+	"github.com/vshn/provider-cloudscale/operator/volumecontroller"
+	"github.com/vshn/provider-cloudscale/operator/volumesnapshotcontroller"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 )
 
+// Options configure the controllers.
+type Options struct {
+	ObjectsUser    controlleropts.Options
+	Bucket         controlleropts.Options
+	Server         controlleropts.Options
+	Volume         controlleropts.Options
+	VolumeSnapshot controlleropts.Options
+	Network        controlleropts.Options
+	Subnet         controlleropts.Options
+	FloatingIP     controlleropts.Options
+	LoadBalancer   controlleropts.Options
+	Pool           controlleropts.Options
+	PoolMember     controlleropts.Options
+	Listener       controlleropts.Options
+	HealthMonitor  controlleropts.Options
+	ServerGroup    controlleropts.Options
+	CustomImage    controlleropts.Options
+	Catalog        controlleropts.Options
+	ProviderConfig controlleropts.Options
+	// Shard restricts the controllers to a subset of the resources.
+	Shard sharding.Options
+	// ESS enables External Secret Stores, if not nil.
+	ESS *controlleropts.ESSOptions
+}
+
+// controllers returns the options of all controllers.
+func (o *Options) controllers() []*controlleropts.Options {
+	return []*controlleropts.Options{
+		&o.ObjectsUser, &o.Bucket, &o.Server, &o.Volume, &o.VolumeSnapshot, &o.Network, &o.Subnet, &o.FloatingIP, &o.LoadBalancer,
+		&o.Pool, &o.PoolMember, &o.Listener, &o.HealthMonitor, &o.ServerGroup, &o.CustomImage, &o.Catalog, &o.ProviderConfig,
+	}
+}
+
 // SetupControllers creates all controllers and adds them to the supplied manager.
-func SetupControllers(mgr ctrl.Manager) error {
-	for _, setup := range []func(ctrl.Manager) error{
-		objectsusercontroller.SetupController,
-		bucketcontroller.SetupController,
-		configcontroller.SetupController,
-	} {
-		if err := setup(mgr); err != nil {
+func SetupControllers(mgr ctrl.Manager, opts Options) error {
+	for _, controllerOpts := range opts.controllers() {
+		controllerOpts.ESS = opts.ESS
+	}
+	type setup struct {
+		fn   func(ctrl.Manager, controlleropts.Options) error
+		opts controlleropts.Options
+	}
+	setups := []setup{
+		{fn: objectsusercontroller.SetupController, opts: withPredicate(opts.ObjectsUser, opts.Shard.ManagedPredicate())},
+		{fn: bucketcontroller.SetupController, opts: withPredicate(opts.Bucket, opts.Shard.ManagedPredicate())},
+		{fn: servercontroller.SetupController, opts: withPredicate(opts.Server, opts.Shard.ManagedPredicate())},
+		{fn: volumecontroller.SetupController, opts: withPredicate(opts.Volume, opts.Shard.ManagedPredicate())},
+		{fn: volumesnapshotcontroller.SetupController, opts: withPredicate(opts.VolumeSnapshot, opts.Shard.ManagedPredicate())},
+		{fn: networkcontroller.SetupController, opts: withPredicate(opts.Network, opts.Shard.ManagedPredicate())},
+		{fn: subnetcontroller.SetupController, opts: withPredicate(opts.Subnet, opts.Shard.ManagedPredicate())},
+		{fn: floatingipcontroller.SetupController, opts: withPredicate(opts.FloatingIP, opts.Shard.ManagedPredicate())},
+		{fn: loadbalancercontroller.SetupController, opts: withPredicate(opts.LoadBalancer, opts.Shard.ManagedPredicate())},
+		{fn: poolcontroller.SetupController, opts: withPredicate(opts.Pool, opts.Shard.ManagedPredicate())},
+		{fn: poolmembercontroller.SetupController, opts: withPredicate(opts.PoolMember, opts.Shard.ManagedPredicate())},
+		{fn: listenercontroller.SetupController, opts: withPredicate(opts.Listener, opts.Shard.ManagedPredicate())},
+		{fn: healthmonitorcontroller.SetupController, opts: withPredicate(opts.HealthMonitor, opts.Shard.ManagedPredicate())},
+		{fn: servergroupcontroller.SetupController, opts: withPredicate(opts.ServerGroup, opts.Shard.ManagedPredicate())},
+		{fn: customimagecontroller.SetupController, opts: withPredicate(opts.CustomImage, opts.Shard.ManagedPredicate())},
+		{fn: catalogcontroller.SetupController, opts: withPredicate(opts.Catalog, opts.Shard.ManagedPredicate())},
+		{fn: configcontroller.SetupController, opts: withPredicate(opts.ProviderConfig, opts.Shard.ProviderConfigPredicate())},
+		{fn: configcontroller.SetupHealthController, opts: withPredicate(opts.ProviderConfig, opts.Shard.ProviderConfigPredicate())},
+	}
+	if opts.ESS != nil {
+		setups = append(setups, setup{fn: configcontroller.SetupStoreConfigController, opts: opts.ProviderConfig})
+	}
+	for _, setup := range setups {
+		if err := setup.fn(mgr, setup.opts); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+func withPredicate(opts controlleropts.Options, p predicate.Predicate) controlleropts.Options {
+	opts.Predicates = append(slices.Clone(opts.Predicates), p)
+	return opts
+}
+
 // SetupWebhooks creates all webhooks and adds them to the supplied manager.
 func SetupWebhooks(mgr ctrl.Manager) error {
 	for _, setup := range []func(ctrl.Manager) error{
 		bucketcontroller.SetupWebhook,
+		volumecontroller.SetupWebhook,
 	} {
 		if err := setup(mgr); err != nil {
 			return err
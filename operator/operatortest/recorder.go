@@ -0,0 +1,24 @@
+package operatortest
+
+import (
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Recorder is an event.Recorder that records the events in memory, so that tests can assert them.
+type Recorder struct {
+	Events []event.Event
+}
+
+var _ event.Recorder = &Recorder{}
+
+// Event implements event.Recorder.
+func (r *Recorder) Event(_ runtime.Object, e event.Event) {
+	r.Events = append(r.Events, e)
+}
+
+// WithAnnotations implements event.Recorder.
+// The annotations are ignored.
+func (r *Recorder) WithAnnotations(_ ...string) event.Recorder {
+	return r
+}
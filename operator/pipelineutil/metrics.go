@@ -0,0 +1,71 @@
+package pipelineutil
+
+import (
+	"time"
+
+	pipeline "github.com/ccremer/go-command-pipeline"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/vshn/provider-cloudscale/operator/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	// OutcomeSuccess is the outcome label value of steps that completed without error.
+	OutcomeSuccess = "success"
+	// OutcomeFailure is the outcome label value of steps that returned an error.
+	OutcomeFailure = "failure"
+)
+
+var stepDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: "cloudscale",
+	Subsystem: "pipeline",
+	Name:      "step_duration_seconds",
+	Help:      "Duration of reconcile pipeline steps, partitioned by resource kind, step name and outcome.",
+	Buckets:   prometheus.DefBuckets,
+}, []string{"kind", "step", "outcome"})
+
+func init() {
+	metrics.Registry.MustRegister(stepDuration)
+}
+
+// StepMetrics records the duration and outcome of each step in a pipeline.
+// The durations are observed in a Prometheus histogram, and each step is recorded as an OpenTelemetry span.
+// Spans are only exported if a global trace provider is configured.
+//
+// The steps have to be wrapped with StepMetrics.Steps, including the steps nested in another step.
+// A step is recorded with the error returned by its action, even if the step's error handler discards the error.
+type StepMetrics[T tracing.Context] struct {
+	kind string
+}
+
+// NewStepMetrics returns a new StepMetrics for the given kind of managed resource.
+func NewStepMetrics[T tracing.Context](kind string) *StepMetrics[T] {
+	return &StepMetrics[T]{kind: kind}
+}
+
+// Steps returns the given steps with their actions wrapped, so that each invocation of an action is recorded.
+// The spans are created as children of the span in the pipeline context, and the context of the span is set on the pipeline context while the action runs.
+func (m *StepMetrics[T]) Steps(steps ...pipeline.Step[T]) []pipeline.Step[T] {
+	wrapped := make([]pipeline.Step[T], len(steps))
+	for i, step := range steps {
+		wrapped[i] = m.wrap(step)
+	}
+	return wrapped
+}
+
+func (m *StepMetrics[T]) wrap(step pipeline.Step[T]) pipeline.Step[T] {
+	name, action := step.Name, step.Action
+	step.Action = func(ctx T) error {
+		start := time.Now()
+		err := tracing.RunInSpan(ctx, name, action, trace.WithAttributes(attribute.String("kind", m.kind)))
+		outcome := OutcomeSuccess
+		if err != nil {
+			outcome = OutcomeFailure
+		}
+		stepDuration.WithLabelValues(m.kind, name, outcome).Observe(time.Since(start).Seconds())
+		return err
+	}
+	return step
+}
@@ -0,0 +1,90 @@
+package pipelineutil
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	pipeline "github.com/ccremer/go-command-pipeline"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testContext struct {
+	context.Context
+}
+
+func (c *testContext) ParentContext() context.Context {
+	return c.Context
+}
+
+func (c *testContext) SetParentContext(ctx context.Context) {
+	c.Context = ctx
+}
+
+func TestStepMetrics(t *testing.T) {
+	tests := map[string]struct {
+		givenError      error
+		expectedOutcome map[string]string
+	}{
+		"GivenAllStepsSucceed_ThenExpectSuccess": {
+			expectedOutcome: map[string]string{
+				"first": OutcomeSuccess, "nested": OutcomeSuccess, "inner": OutcomeSuccess, "handled": OutcomeFailure, "second": OutcomeSuccess,
+			},
+		},
+		"GivenLastStepFails_ThenExpectFailure": {
+			givenError: errors.New("error"),
+			expectedOutcome: map[string]string{
+				"first": OutcomeSuccess, "nested": OutcomeSuccess, "inner": OutcomeSuccess, "handled": OutcomeFailure, "second": OutcomeFailure,
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			kind := t.Name()
+			stepDuration.DeletePartialMatch(prometheus.Labels{"kind": kind})
+			ctx := &testContext{Context: context.Background()}
+			m := NewStepMetrics[*testContext](kind)
+			p := pipeline.NewPipeline[*testContext]()
+			err := p.WithSteps(m.Steps(
+				p.NewStep("first", func(_ *testContext) error { return nil }),
+				p.When(func(_ *testContext) bool { return false }, "skipped", func(_ *testContext) error { return nil }),
+				p.WithNestedSteps("nested", nil, m.Steps(
+					p.NewStep("inner", func(_ *testContext) error {
+						time.Sleep(10 * time.Millisecond)
+						return nil
+					}),
+				)...),
+				p.NewStep("handled", func(_ *testContext) error { return errors.New("handled") }).
+					WithErrorHandler(func(_ *testContext, _ error) error { return nil }),
+				p.NewStep("second", func(_ *testContext) error { return tc.givenError }),
+			)...).RunWithContext(ctx)
+			if tc.givenError != nil {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			for step, outcome := range tc.expectedOutcome {
+				assert.Equal(t, uint64(1), sampleCount(t, kind, step, outcome), "step %q with outcome %q", step, outcome)
+			}
+			assert.GreaterOrEqual(t, histogram(t, kind, "nested", OutcomeSuccess).GetSampleSum(), 0.01, "nested step includes inner step")
+			assert.Equal(t, uint64(0), sampleCount(t, kind, "skipped", OutcomeSuccess), "skipped step")
+			assert.Equal(t, uint64(0), sampleCount(t, kind, "nested", OutcomeFailure), "nested step")
+			assert.Equal(t, uint64(0), sampleCount(t, kind, "handled", OutcomeSuccess), "handled step")
+		})
+	}
+}
+
+func sampleCount(t *testing.T, kind, step, outcome string) uint64 {
+	return histogram(t, kind, step, outcome).GetSampleCount()
+}
+
+func histogram(t *testing.T, kind, step, outcome string) *dto.Histogram {
+	metric := &dto.Metric{}
+	require.NoError(t, stepDuration.WithLabelValues(kind, step, outcome).(prometheus.Histogram).Write(metric))
+	return metric.GetHistogram()
+}
@@ -0,0 +1,33 @@
+package poolcontroller
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+type poolConnector struct {
+	recorder  event.Recorder
+	connector *cloudscaleclient.Connector
+}
+
+// Connect implements managed.ExternalConnecter.
+func (c *poolConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.V(1).Info("Connecting resource")
+
+	pool := fromManaged(mg)
+	csClient, providerConfig, err := c.connector.Connect(ctx, pool)
+	if err != nil {
+		return nil, err
+	}
+	pool.Status.AtProvider.Project = providerConfig.Spec.Project
+	return NewPipeline(c.recorder,
+		cloudscaleclient.NewLoadBalancerPoolService(csClient),
+		cloudscaleclient.NewLoadBalancerPoolMemberService(csClient),
+	), nil
+}
@@ -0,0 +1,113 @@
+package poolcontroller
+
+import (
+	"context"
+	"fmt"
+
+	pipeline "github.com/ccremer/go-command-pipeline"
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	"github.com/vshn/provider-cloudscale/operator/pipelineutil"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Create implements managed.ExternalClient.
+func (p *PoolPipeline) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.Info("Creating resource")
+
+	pool := fromManaged(mg)
+	if pool.Status.AtProvider.PoolUUID != "" {
+		// Pool already exists
+		return managed.ExternalCreation{}, nil
+	}
+
+	pctx := &pipelineContext{Context: ctx, pool: pool}
+	pipe := pipeline.NewPipeline[*pipelineContext]()
+	stepMetrics := pipelineutil.NewStepMetrics[*pipelineContext](cloudscalev1.PoolKind)
+	pipe.WithBeforeHooks(pipelineutil.DebugLogger(pctx)).
+		WithSteps(stepMetrics.Steps(
+			pipe.NewStep("find pool by owner tag", p.findPoolByOwnerTag),
+			pipe.When(isPoolMissing, "create pool", p.createPool),
+			pipe.NewStep("set pool UUID annotation", p.setPoolUUIDAnnotation),
+			pipe.NewStep("emit event", p.emitCreationEvent),
+		)...)
+	err := pipe.RunWithContext(pctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, "cannot create pool")
+	}
+
+	return managed.ExternalCreation{}, nil
+}
+
+// findPoolByOwnerTag searches for a pool that has been created by a previous reconciliation of this resource.
+// See servercontroller for why this is needed.
+// If exactly one pool is found, it is adopted.
+func (p *PoolPipeline) findPoolByOwnerTag(ctx *pipelineContext) error {
+	log := controllerruntime.LoggerFrom(ctx)
+	pool := ctx.pool
+
+	if pool.UID == "" {
+		return nil
+	}
+	csPools, err := p.pools.List(ctx, cloudscalesdk.WithTagFilter(cloudscalesdk.TagMap{cloudscaleclient.OwnerTagKey: string(pool.UID)}))
+	if err != nil {
+		return errors.Wrap(err, "cannot list pools by owner tag")
+	}
+	switch len(csPools) {
+	case 0:
+		return nil
+	case 1:
+		ctx.csPool = &csPools[0]
+		log.V(1).Info("Adopting existing pool in cloudscale", "uuid", ctx.csPool.UUID, "name", ctx.csPool.Name)
+		return nil
+	default:
+		return fmt.Errorf("found %d pools with tag %s=%s, expected at most one", len(csPools), cloudscaleclient.OwnerTagKey, pool.UID)
+	}
+}
+
+// createPool creates a new pool for the referenced load balancer.
+func (p *PoolPipeline) createPool(ctx *pipelineContext) error {
+	log := controllerruntime.LoggerFrom(ctx)
+	pool := ctx.pool
+	params := pool.Spec.ForProvider
+
+	if params.LoadBalancerUUID == "" {
+		return errors.New("load balancer of the pool is not resolved yet")
+	}
+	csPool, err := p.pools.Create(ctx, &cloudscaleclient.LoadBalancerPoolRequest{
+		TaggedResourceRequest: cloudscalesdk.TaggedResourceRequest{
+			Tags: cloudscaleclient.ToTagMap(cloudscaleclient.DesiredTags(params.Tags, pool.UID)),
+		},
+		Name:         pool.GetPoolName(),
+		LoadBalancer: params.LoadBalancerUUID,
+		Algorithm:    params.Algorithm,
+		Protocol:     params.Protocol,
+	})
+	if err != nil {
+		return err
+	}
+	log.V(1).Info("Created pool in cloudscale", "uuid", csPool.UUID, "name", csPool.Name)
+	ctx.csPool = csPool
+	return nil
+}
+
+// setPoolUUIDAnnotation stores the UUID of the created or adopted pool in an annotation.
+func (p *PoolPipeline) setPoolUUIDAnnotation(ctx *pipelineContext) error {
+	cloudscaleclient.SetIDAnnotation(&ctx.pool.ObjectMeta, PoolUUIDAnnotationKey, ctx.csPool.UUID)
+	return nil
+}
+
+func (p *PoolPipeline) emitCreationEvent(ctx *pipelineContext) error {
+	p.recorder.Event(ctx.pool, event.Event{
+		Type:    event.TypeNormal,
+		Reason:  "Created",
+		Message: "Pool successfully created",
+	})
+	return nil
+}
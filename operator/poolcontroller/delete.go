@@ -0,0 +1,55 @@
+package poolcontroller
+
+import (
+	"context"
+
+	pipeline "github.com/ccremer/go-command-pipeline"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	"github.com/vshn/provider-cloudscale/operator/pipelineutil"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Delete implements managed.ExternalClient.
+func (p *PoolPipeline) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.Info("Deleting resource")
+
+	pool := fromManaged(mg)
+	pctx := &pipelineContext{Context: ctx, pool: pool}
+	pipe := pipeline.NewPipeline[*pipelineContext]()
+	stepMetrics := pipelineutil.NewStepMetrics[*pipelineContext](cloudscalev1.PoolKind)
+	pipe.WithBeforeHooks(pipelineutil.DebugLogger(pctx)).
+		WithSteps(stepMetrics.Steps(
+			pipe.NewStep("delete pool", p.deletePool),
+			pipe.NewStep("emit event", p.emitDeletionEvent),
+		)...)
+	err := pipe.RunWithContext(pctx)
+	return managed.ExternalDelete{}, errors.Wrap(err, "cannot deprovision pool")
+}
+
+// deletePool deletes the pool.
+func (p *PoolPipeline) deletePool(ctx *pipelineContext) error {
+	log := controllerruntime.LoggerFrom(ctx)
+	uuid := ctx.pool.Status.AtProvider.PoolUUID
+
+	err := p.pools.Delete(ctx, uuid)
+	if err != nil {
+		return resource.Ignore(cloudscaleclient.IsNotFound, err)
+	}
+	log.V(1).Info("Deleted pool in cloudscale", "uuid", uuid)
+	return nil
+}
+
+func (p *PoolPipeline) emitDeletionEvent(ctx *pipelineContext) error {
+	p.recorder.Event(ctx.pool, event.Event{
+		Type:    event.TypeNormal,
+		Reason:  "Deleted",
+		Message: "Pool deleted",
+	})
+	return nil
+}
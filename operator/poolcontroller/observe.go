@@ -0,0 +1,101 @@
+package poolcontroller
+
+import (
+	"context"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Observe implements managed.ExternalClient.
+func (p *PoolPipeline) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.V(1).Info("Observing resource")
+
+	pool := fromManaged(mg)
+	if pool.Status.AtProvider.PoolUUID == "" {
+		if uuid, exists := cloudscaleclient.IDFromAnnotation(pool, PoolUUIDAnnotationKey); exists {
+			pool.Status.AtProvider.PoolUUID = uuid
+		} else {
+			// New resource, create pool first
+			return managed.ExternalObservation{}, nil
+		}
+	}
+
+	pctx := &pipelineContext{Context: ctx, pool: pool}
+	err := p.getPool(pctx)
+	if err != nil {
+		return managed.ExternalObservation{}, resource.Ignore(cloudscaleclient.IsNotFound, err)
+	}
+	err = p.listMembers(pctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, "cannot list pool members")
+	}
+
+	pool.Status.AtProvider = toObservation(pctx.csPool, pctx.csMembers, pool.Status.AtProvider.Project)
+	pool.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: isUpToDate(pool, pctx.csPool),
+	}, nil
+}
+
+// getPool fetches an existing pool from the project associated with the API token.
+func (p *PoolPipeline) getPool(ctx *pipelineContext) error {
+	log := controllerruntime.LoggerFrom(ctx)
+
+	csPool, err := p.pools.Get(ctx, ctx.pool.Status.AtProvider.PoolUUID)
+	if err != nil {
+		return err
+	}
+	ctx.csPool = csPool
+	log.V(1).Info("Fetched pool in cloudscale", "uuid", csPool.UUID, "name", csPool.Name)
+	return nil
+}
+
+// listMembers fetches the members of the pool, regardless of whether they're managed by this provider.
+func (p *PoolPipeline) listMembers(ctx *pipelineContext) error {
+	csMembers, err := p.members.List(ctx, ctx.csPool.UUID)
+	if err != nil {
+		return err
+	}
+	ctx.csMembers = csMembers
+	return nil
+}
+
+// isUpToDate returns true if the updatable fields of the pool match the spec.
+// Fields that can't be changed after creation are ignored.
+func isUpToDate(pool *cloudscalev1.Pool, csPool *cloudscaleclient.LoadBalancerPool) bool {
+	return pool.GetPoolName() == csPool.Name &&
+		!cloudscaleclient.TagsNeedUpdate(cloudscaleclient.DesiredTags(pool.Spec.ForProvider.Tags, pool.UID), csPool.Tags)
+}
+
+// toObservation returns the observed fields of the given pool and its members.
+func toObservation(csPool *cloudscaleclient.LoadBalancerPool, csMembers []cloudscaleclient.LoadBalancerPoolMember, project string) cloudscalev1.PoolObservation {
+	obs := cloudscalev1.PoolObservation{
+		PoolUUID:         csPool.UUID,
+		PoolName:         csPool.Name,
+		LoadBalancerUUID: csPool.LoadBalancer.UUID,
+		Algorithm:        csPool.Algorithm,
+		Protocol:         csPool.Protocol,
+		Tags:             cloudscaleclient.FromTagMap(csPool.Tags),
+		Project:          project,
+	}
+	for _, member := range csMembers {
+		obs.Members = append(obs.Members, cloudscalev1.PoolMemberStatusObservation{
+			MemberUUID:    member.UUID,
+			MemberName:    member.Name,
+			Address:       member.Address,
+			ProtocolPort:  member.ProtocolPort,
+			Enabled:       member.Enabled,
+			MonitorStatus: member.MonitorStatus,
+		})
+	}
+	return obs
+}
@@ -0,0 +1,61 @@
+package poolcontroller
+
+import (
+	"context"
+	"testing"
+
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type fakePoolService struct {
+	cloudscaleclient.LoadBalancerPoolService
+	pool *cloudscaleclient.LoadBalancerPool
+}
+
+// Get implements cloudscaleclient.LoadBalancerPoolService.
+func (f *fakePoolService) Get(_ context.Context, _ string) (*cloudscaleclient.LoadBalancerPool, error) {
+	return f.pool, nil
+}
+
+type fakePoolMemberService struct {
+	cloudscaleclient.LoadBalancerPoolMemberService
+	members []cloudscaleclient.LoadBalancerPoolMember
+}
+
+// List implements cloudscaleclient.LoadBalancerPoolMemberService.
+func (f *fakePoolMemberService) List(_ context.Context, _ string, _ ...cloudscalesdk.ListRequestModifier) ([]cloudscaleclient.LoadBalancerPoolMember, error) {
+	return f.members, nil
+}
+
+func TestPoolPipeline_Observe(t *testing.T) {
+	p := NewPipeline(nil,
+		&fakePoolService{pool: &cloudscaleclient.LoadBalancerPool{
+			UUID: "uuid", Name: "my-pool", Algorithm: "round_robin", Protocol: "tcp",
+			LoadBalancer: cloudscaleclient.LoadBalancerStub{UUID: "lb"},
+		}},
+		&fakePoolMemberService{members: []cloudscaleclient.LoadBalancerPoolMember{
+			{UUID: "member-1", Name: "web-1", Address: "10.0.0.11", ProtocolPort: 80, Enabled: true, MonitorStatus: "up"},
+			{UUID: "member-2", Name: "web-2", Address: "10.0.0.12", ProtocolPort: 80, Enabled: false, MonitorStatus: "down"},
+		}},
+	)
+	pool := &cloudscalev1.Pool{ObjectMeta: metav1.ObjectMeta{Name: "my-pool", Annotations: map[string]string{PoolUUIDAnnotationKey: "uuid"}}}
+
+	result, err := p.Observe(logr.NewContext(context.Background(), logr.Discard()), pool)
+	require.NoError(t, err)
+	assert.True(t, result.ResourceExists)
+	assert.True(t, result.ResourceUpToDate)
+	assert.Equal(t, cloudscalev1.PoolObservation{
+		PoolUUID: "uuid", PoolName: "my-pool", LoadBalancerUUID: "lb", Algorithm: "round_robin", Protocol: "tcp",
+		Members: []cloudscalev1.PoolMemberStatusObservation{
+			{MemberUUID: "member-1", MemberName: "web-1", Address: "10.0.0.11", ProtocolPort: 80, Enabled: true, MonitorStatus: "up"},
+			{MemberUUID: "member-2", MemberName: "web-2", Address: "10.0.0.12", ProtocolPort: 80, Enabled: false, MonitorStatus: "down"},
+		},
+		Tags: cloudscalev1.Tags{},
+	}, pool.Status.AtProvider)
+}
@@ -0,0 +1,62 @@
+package poolcontroller
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+)
+
+const (
+	// PoolUUIDAnnotationKey is the annotation key where the pool UUID is stored.
+	PoolUUIDAnnotationKey = "cloudscale.crossplane.io/pool-uuid"
+)
+
+// PoolPipeline provisions Pools on cloudscale.ch
+type PoolPipeline struct {
+	recorder event.Recorder
+	pools    cloudscaleclient.LoadBalancerPoolService
+	members  cloudscaleclient.LoadBalancerPoolMemberService
+}
+
+type pipelineContext struct {
+	context.Context
+	pool   *cloudscalev1.Pool
+	csPool *cloudscaleclient.LoadBalancerPool
+	// csMembers are the members of the pool, which are reported in the status.
+	csMembers []cloudscaleclient.LoadBalancerPoolMember
+}
+
+// ParentContext implements tracing.Context.
+func (c *pipelineContext) ParentContext() context.Context {
+	return c.Context
+}
+
+// SetParentContext implements tracing.Context.
+func (c *pipelineContext) SetParentContext(ctx context.Context) {
+	c.Context = ctx
+}
+
+// NewPipeline returns a new instance of PoolPipeline.
+func NewPipeline(recorder event.Recorder, pools cloudscaleclient.LoadBalancerPoolService, members cloudscaleclient.LoadBalancerPoolMemberService) *PoolPipeline {
+	return &PoolPipeline{
+		recorder: recorder,
+		pools:    pools,
+		members:  members,
+	}
+}
+
+// Disconnect implements managed.ExternalClient.
+func (p *PoolPipeline) Disconnect(_ context.Context) error {
+	return nil
+}
+
+func isPoolMissing(ctx *pipelineContext) bool {
+	return ctx.csPool == nil
+}
+
+func fromManaged(mg resource.Managed) *cloudscalev1.Pool {
+	return mg.(*cloudscalev1.Pool)
+}
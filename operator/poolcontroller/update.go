@@ -0,0 +1,53 @@
+package poolcontroller
+
+import (
+	"context"
+
+	pipeline "github.com/ccremer/go-command-pipeline"
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	"github.com/vshn/provider-cloudscale/operator/pipelineutil"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Update implements managed.ExternalClient.
+func (p *PoolPipeline) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.Info("Updating resource")
+	pool := fromManaged(mg)
+
+	pctx := &pipelineContext{Context: ctx, pool: pool}
+	pipe := pipeline.NewPipeline[*pipelineContext]()
+	stepMetrics := pipelineutil.NewStepMetrics[*pipelineContext](cloudscalev1.PoolKind)
+	pipe.WithBeforeHooks(pipelineutil.DebugLogger(pctx)).
+		WithSteps(stepMetrics.Steps(
+			pipe.NewStep("update pool", p.updatePool),
+		)...)
+	err := pipe.RunWithContext(pctx)
+
+	return managed.ExternalUpdate{}, errors.Wrap(err, "cannot update pool")
+}
+
+// updatePool updates the name and tags of the pool identified by UUID.
+func (p *PoolPipeline) updatePool(ctx *pipelineContext) error {
+	log := controllerruntime.LoggerFrom(ctx)
+	pool := ctx.pool
+	uuid := pool.Status.AtProvider.PoolUUID
+	tags := cloudscaleclient.DesiredTags(pool.Spec.ForProvider.Tags, pool.UID)
+
+	err := p.pools.Update(ctx, uuid, &cloudscaleclient.LoadBalancerPoolRequest{
+		TaggedResourceRequest: cloudscalesdk.TaggedResourceRequest{
+			Tags: cloudscaleclient.ToTagMap(tags),
+		},
+		Name: pool.GetPoolName(),
+	})
+	if err != nil {
+		return err
+	}
+	log.V(1).Info("Updated pool in cloudscale", "uuid", uuid, "name", pool.GetPoolName(), "tags", tags)
+	return nil
+}
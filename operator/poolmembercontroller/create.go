@@ -0,0 +1,123 @@
+package poolmembercontroller
+
+import (
+	"context"
+	"fmt"
+
+	pipeline "github.com/ccremer/go-command-pipeline"
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	"github.com/vshn/provider-cloudscale/operator/pipelineutil"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Create implements managed.ExternalClient.
+func (p *PoolMemberPipeline) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.Info("Creating resource")
+
+	member := fromManaged(mg)
+	if member.Status.AtProvider.MemberUUID != "" {
+		// Pool member already exists
+		return managed.ExternalCreation{}, nil
+	}
+
+	pctx := &pipelineContext{Context: ctx, member: member}
+	pipe := pipeline.NewPipeline[*pipelineContext]()
+	stepMetrics := pipelineutil.NewStepMetrics[*pipelineContext](cloudscalev1.PoolMemberKind)
+	pipe.WithBeforeHooks(pipelineutil.DebugLogger(pctx)).
+		WithSteps(stepMetrics.Steps(
+			pipe.NewStep("check pool", p.checkPool),
+			pipe.NewStep("find pool member by owner tag", p.findPoolMemberByOwnerTag),
+			pipe.When(isPoolMemberMissing, "create pool member", p.createPoolMember),
+			pipe.NewStep("set pool member UUID annotation", p.setPoolMemberUUIDAnnotation),
+			pipe.NewStep("emit event", p.emitCreationEvent),
+		)...)
+	err := pipe.RunWithContext(pctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, "cannot create pool member")
+	}
+
+	return managed.ExternalCreation{}, nil
+}
+
+// checkPool returns an error if the pool hasn't been resolved yet.
+// Members are nested below their pool in the API, so the pool is also needed to look up existing members.
+func (p *PoolMemberPipeline) checkPool(ctx *pipelineContext) error {
+	if ctx.member.Spec.ForProvider.PoolUUID == "" {
+		return errors.New("pool of the member is not resolved yet")
+	}
+	return nil
+}
+
+// findPoolMemberByOwnerTag searches for a pool member that has been created by a previous reconciliation of this resource.
+// See servercontroller for why this is needed.
+// If exactly one pool member is found, it is adopted.
+func (p *PoolMemberPipeline) findPoolMemberByOwnerTag(ctx *pipelineContext) error {
+	log := controllerruntime.LoggerFrom(ctx)
+	member := ctx.member
+
+	if member.UID == "" {
+		return nil
+	}
+	csMembers, err := p.members.List(ctx, member.Spec.ForProvider.PoolUUID, cloudscalesdk.WithTagFilter(cloudscalesdk.TagMap{cloudscaleclient.OwnerTagKey: string(member.UID)}))
+	if err != nil {
+		return errors.Wrap(err, "cannot list pool members by owner tag")
+	}
+	switch len(csMembers) {
+	case 0:
+		return nil
+	case 1:
+		ctx.csMember = &csMembers[0]
+		log.V(1).Info("Adopting existing pool member in cloudscale", "uuid", ctx.csMember.UUID, "name", ctx.csMember.Name)
+		return nil
+	default:
+		return fmt.Errorf("found %d pool members with tag %s=%s, expected at most one", len(csMembers), cloudscaleclient.OwnerTagKey, member.UID)
+	}
+}
+
+// createPoolMember adds a new member to the referenced pool.
+func (p *PoolMemberPipeline) createPoolMember(ctx *pipelineContext) error {
+	log := controllerruntime.LoggerFrom(ctx)
+	member := ctx.member
+	params := member.Spec.ForProvider
+
+	enabled := member.IsEnabled()
+	csMember, err := p.members.Create(ctx, params.PoolUUID, &cloudscaleclient.LoadBalancerPoolMemberRequest{
+		TaggedResourceRequest: cloudscalesdk.TaggedResourceRequest{
+			Tags: cloudscaleclient.ToTagMap(cloudscaleclient.DesiredTags(params.Tags, member.UID)),
+		},
+		Name:         member.GetMemberName(),
+		Enabled:      &enabled,
+		ProtocolPort: params.ProtocolPort,
+		MonitorPort:  params.MonitorPort,
+		Address:      params.Address,
+		Subnet:       params.SubnetUUID,
+	})
+	if err != nil {
+		return err
+	}
+	log.V(1).Info("Created pool member in cloudscale", "uuid", csMember.UUID, "name", csMember.Name, "pool", params.PoolUUID)
+	ctx.csMember = csMember
+	return nil
+}
+
+// setPoolMemberUUIDAnnotation stores the UUID of the created or adopted pool member in an annotation.
+func (p *PoolMemberPipeline) setPoolMemberUUIDAnnotation(ctx *pipelineContext) error {
+	cloudscaleclient.SetIDAnnotation(&ctx.member.ObjectMeta, PoolMemberUUIDAnnotationKey, ctx.csMember.UUID)
+	return nil
+}
+
+func (p *PoolMemberPipeline) emitCreationEvent(ctx *pipelineContext) error {
+	p.recorder.Event(ctx.member, event.Event{
+		Type:    event.TypeNormal,
+		Reason:  "Created",
+		Message: "Pool member successfully created",
+	})
+	return nil
+}
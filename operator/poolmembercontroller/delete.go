@@ -0,0 +1,55 @@
+package poolmembercontroller
+
+import (
+	"context"
+
+	pipeline "github.com/ccremer/go-command-pipeline"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	"github.com/vshn/provider-cloudscale/operator/pipelineutil"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Delete implements managed.ExternalClient.
+func (p *PoolMemberPipeline) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.Info("Deleting resource")
+
+	member := fromManaged(mg)
+	pctx := &pipelineContext{Context: ctx, member: member}
+	pipe := pipeline.NewPipeline[*pipelineContext]()
+	stepMetrics := pipelineutil.NewStepMetrics[*pipelineContext](cloudscalev1.PoolMemberKind)
+	pipe.WithBeforeHooks(pipelineutil.DebugLogger(pctx)).
+		WithSteps(stepMetrics.Steps(
+			pipe.NewStep("delete pool member", p.deletePoolMember),
+			pipe.NewStep("emit event", p.emitDeletionEvent),
+		)...)
+	err := pipe.RunWithContext(pctx)
+	return managed.ExternalDelete{}, errors.Wrap(err, "cannot deprovision pool member")
+}
+
+// deletePoolMember deletes the pool member.
+func (p *PoolMemberPipeline) deletePoolMember(ctx *pipelineContext) error {
+	log := controllerruntime.LoggerFrom(ctx)
+	uuid := ctx.member.Status.AtProvider.MemberUUID
+
+	err := p.members.Delete(ctx, ctx.member.Spec.ForProvider.PoolUUID, uuid)
+	if err != nil {
+		return resource.Ignore(cloudscaleclient.IsNotFound, err)
+	}
+	log.V(1).Info("Deleted pool member in cloudscale", "uuid", uuid)
+	return nil
+}
+
+func (p *PoolMemberPipeline) emitDeletionEvent(ctx *pipelineContext) error {
+	p.recorder.Event(ctx.member, event.Event{
+		Type:    event.TypeNormal,
+		Reason:  "Deleted",
+		Message: "Pool member deleted",
+	})
+	return nil
+}
@@ -0,0 +1,94 @@
+package poolmembercontroller
+
+import (
+	"context"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// MonitorStatusDown is the monitor status of a pool member that failed the health checks of the pool.
+const MonitorStatusDown = "down"
+
+// Observe implements managed.ExternalClient.
+func (p *PoolMemberPipeline) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.V(1).Info("Observing resource")
+
+	member := fromManaged(mg)
+	if member.Status.AtProvider.MemberUUID == "" {
+		if uuid, exists := cloudscaleclient.IDFromAnnotation(member, PoolMemberUUIDAnnotationKey); exists {
+			member.Status.AtProvider.MemberUUID = uuid
+		} else {
+			// New resource, create pool member first
+			return managed.ExternalObservation{}, nil
+		}
+	}
+
+	pctx := &pipelineContext{Context: ctx, member: member}
+	err := p.getPoolMember(pctx)
+	if err != nil {
+		return managed.ExternalObservation{}, resource.Ignore(cloudscaleclient.IsNotFound, err)
+	}
+
+	csMember := pctx.csMember
+	member.Status.AtProvider = toObservation(csMember, member.Status.AtProvider.Project)
+	if csMember.MonitorStatus == MonitorStatusDown {
+		member.SetConditions(xpv1.Unavailable().WithMessage("health monitor reports pool member as down"))
+	} else {
+		member.SetConditions(xpv1.Available())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: isUpToDate(member, csMember),
+	}, nil
+}
+
+// getPoolMember fetches an existing pool member from the project associated with the API token.
+func (p *PoolMemberPipeline) getPoolMember(ctx *pipelineContext) error {
+	log := controllerruntime.LoggerFrom(ctx)
+	member := ctx.member
+
+	csMember, err := p.members.Get(ctx, member.Spec.ForProvider.PoolUUID, member.Status.AtProvider.MemberUUID)
+	if err != nil {
+		return err
+	}
+	ctx.csMember = csMember
+	log.V(1).Info("Fetched pool member in cloudscale", "uuid", csMember.UUID, "name", csMember.Name, "monitorStatus", csMember.MonitorStatus)
+	return nil
+}
+
+// isUpToDate returns true if the updatable fields of the pool member match the spec.
+// The monitor port is only compared if it's set in the spec, otherwise cloudscale.ch uses the protocol port.
+func isUpToDate(member *cloudscalev1.PoolMember, csMember *cloudscaleclient.LoadBalancerPoolMember) bool {
+	params := member.Spec.ForProvider
+	if params.MonitorPort != 0 && params.MonitorPort != csMember.MonitorPort {
+		return false
+	}
+	return member.GetMemberName() == csMember.Name &&
+		member.IsEnabled() == csMember.Enabled &&
+		params.ProtocolPort == csMember.ProtocolPort &&
+		!cloudscaleclient.TagsNeedUpdate(cloudscaleclient.DesiredTags(params.Tags, member.UID), csMember.Tags)
+}
+
+// toObservation returns the observed fields of the given pool member.
+func toObservation(csMember *cloudscaleclient.LoadBalancerPoolMember, project string) cloudscalev1.PoolMemberObservation {
+	return cloudscalev1.PoolMemberObservation{
+		MemberUUID:    csMember.UUID,
+		MemberName:    csMember.Name,
+		PoolUUID:      csMember.Pool.UUID,
+		ProtocolPort:  csMember.ProtocolPort,
+		MonitorPort:   csMember.MonitorPort,
+		Address:       csMember.Address,
+		SubnetUUID:    csMember.Subnet.UUID,
+		Enabled:       csMember.Enabled,
+		MonitorStatus: csMember.MonitorStatus,
+		Tags:          cloudscaleclient.FromTagMap(csMember.Tags),
+		Project:       project,
+	}
+}
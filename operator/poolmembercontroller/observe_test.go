@@ -0,0 +1,103 @@
+package poolmembercontroller
+
+import (
+	"context"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type fakePoolMemberService struct {
+	cloudscaleclient.LoadBalancerPoolMemberService
+	member *cloudscaleclient.LoadBalancerPoolMember
+}
+
+// Get implements cloudscaleclient.LoadBalancerPoolMemberService.
+func (f *fakePoolMemberService) Get(_ context.Context, _, _ string) (*cloudscaleclient.LoadBalancerPoolMember, error) {
+	return f.member, nil
+}
+
+func TestPoolMemberPipeline_Observe(t *testing.T) {
+	tests := map[string]struct {
+		givenMonitorStatus string
+
+		expectedReadyStatus corev1.ConditionStatus
+	}{
+		"GivenMemberUp_ThenExpectAvailable": {
+			givenMonitorStatus:  "up",
+			expectedReadyStatus: corev1.ConditionTrue,
+		},
+		"GivenMemberWithoutMonitor_ThenExpectAvailable": {
+			givenMonitorStatus:  "no_monitor",
+			expectedReadyStatus: corev1.ConditionTrue,
+		},
+		"GivenMemberDown_ThenExpectUnavailable": {
+			givenMonitorStatus:  MonitorStatusDown,
+			expectedReadyStatus: corev1.ConditionFalse,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			p := NewPipeline(nil, &fakePoolMemberService{member: &cloudscaleclient.LoadBalancerPoolMember{
+				UUID: "uuid", Name: "my-member", Enabled: true, ProtocolPort: 80, Address: "10.0.0.11", MonitorStatus: tc.givenMonitorStatus,
+				Pool: cloudscaleclient.LoadBalancerPoolStub{UUID: "pool"},
+			}})
+			member := &cloudscalev1.PoolMember{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-member", Annotations: map[string]string{PoolMemberUUIDAnnotationKey: "uuid"}},
+				Spec: cloudscalev1.PoolMemberSpec{ForProvider: cloudscalev1.PoolMemberParameters{
+					PoolUUID: "pool", ProtocolPort: 80, Address: "10.0.0.11",
+				}},
+			}
+
+			result, err := p.Observe(logr.NewContext(context.Background(), logr.Discard()), member)
+			require.NoError(t, err)
+			assert.True(t, result.ResourceExists)
+			assert.True(t, result.ResourceUpToDate)
+			assert.Equal(t, tc.expectedReadyStatus, member.GetCondition(xpv1.TypeReady).Status)
+			assert.Equal(t, tc.givenMonitorStatus, member.Status.AtProvider.MonitorStatus)
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	disabled := false
+	tests := map[string]struct {
+		givenMonitorPort int
+		givenEnabled     *bool
+		expected         bool
+	}{
+		"GivenNoMonitorPort_ThenExpectUpToDate": {
+			expected: true,
+		},
+		"GivenSameMonitorPort_ThenExpectUpToDate": {
+			givenMonitorPort: 8080, expected: true,
+		},
+		"GivenDifferentMonitorPort_ThenExpectNotUpToDate": {
+			givenMonitorPort: 9090, expected: false,
+		},
+		"GivenDisabledMember_ThenExpectNotUpToDate": {
+			givenEnabled: &disabled, expected: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			member := &cloudscalev1.PoolMember{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-member"},
+				Spec: cloudscalev1.PoolMemberSpec{ForProvider: cloudscalev1.PoolMemberParameters{
+					ProtocolPort: 80,
+					MonitorPort:  tc.givenMonitorPort,
+					Enabled:      tc.givenEnabled,
+				}},
+			}
+			csMember := &cloudscaleclient.LoadBalancerPoolMember{Name: "my-member", Enabled: true, ProtocolPort: 80, MonitorPort: 8080}
+			assert.Equal(t, tc.expected, isUpToDate(member, csMember))
+		})
+	}
+}
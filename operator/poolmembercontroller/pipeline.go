@@ -0,0 +1,58 @@
+package poolmembercontroller
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+)
+
+const (
+	// PoolMemberUUIDAnnotationKey is the annotation key where the pool member UUID is stored.
+	PoolMemberUUIDAnnotationKey = "cloudscale.crossplane.io/pool-member-uuid"
+)
+
+// PoolMemberPipeline provisions PoolMembers on cloudscale.ch
+type PoolMemberPipeline struct {
+	recorder event.Recorder
+	members  cloudscaleclient.LoadBalancerPoolMemberService
+}
+
+type pipelineContext struct {
+	context.Context
+	member   *cloudscalev1.PoolMember
+	csMember *cloudscaleclient.LoadBalancerPoolMember
+}
+
+// ParentContext implements tracing.Context.
+func (c *pipelineContext) ParentContext() context.Context {
+	return c.Context
+}
+
+// SetParentContext implements tracing.Context.
+func (c *pipelineContext) SetParentContext(ctx context.Context) {
+	c.Context = ctx
+}
+
+// NewPipeline returns a new instance of PoolMemberPipeline.
+func NewPipeline(recorder event.Recorder, members cloudscaleclient.LoadBalancerPoolMemberService) *PoolMemberPipeline {
+	return &PoolMemberPipeline{
+		recorder: recorder,
+		members:  members,
+	}
+}
+
+// Disconnect implements managed.ExternalClient.
+func (p *PoolMemberPipeline) Disconnect(_ context.Context) error {
+	return nil
+}
+
+func isPoolMemberMissing(ctx *pipelineContext) bool {
+	return ctx.csMember == nil
+}
+
+func fromManaged(mg resource.Managed) *cloudscalev1.PoolMember {
+	return mg.(*cloudscalev1.PoolMember)
+}
@@ -0,0 +1,58 @@
+package poolmembercontroller
+
+import (
+	"context"
+
+	pipeline "github.com/ccremer/go-command-pipeline"
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	"github.com/vshn/provider-cloudscale/operator/pipelineutil"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Update implements managed.ExternalClient.
+func (p *PoolMemberPipeline) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.Info("Updating resource")
+	member := fromManaged(mg)
+
+	pctx := &pipelineContext{Context: ctx, member: member}
+	pipe := pipeline.NewPipeline[*pipelineContext]()
+	stepMetrics := pipelineutil.NewStepMetrics[*pipelineContext](cloudscalev1.PoolMemberKind)
+	pipe.WithBeforeHooks(pipelineutil.DebugLogger(pctx)).
+		WithSteps(stepMetrics.Steps(
+			pipe.NewStep("update pool member", p.updatePoolMember),
+		)...)
+	err := pipe.RunWithContext(pctx)
+
+	return managed.ExternalUpdate{}, errors.Wrap(err, "cannot update pool member")
+}
+
+// updatePoolMember updates the mutable fields of the pool member identified by UUID.
+func (p *PoolMemberPipeline) updatePoolMember(ctx *pipelineContext) error {
+	log := controllerruntime.LoggerFrom(ctx)
+	member := ctx.member
+	params := member.Spec.ForProvider
+	uuid := member.Status.AtProvider.MemberUUID
+	tags := cloudscaleclient.DesiredTags(params.Tags, member.UID)
+
+	enabled := member.IsEnabled()
+	err := p.members.Update(ctx, params.PoolUUID, uuid, &cloudscaleclient.LoadBalancerPoolMemberRequest{
+		TaggedResourceRequest: cloudscalesdk.TaggedResourceRequest{
+			Tags: cloudscaleclient.ToTagMap(tags),
+		},
+		Name:         member.GetMemberName(),
+		Enabled:      &enabled,
+		ProtocolPort: params.ProtocolPort,
+		MonitorPort:  params.MonitorPort,
+	})
+	if err != nil {
+		return err
+	}
+	log.V(1).Info("Updated pool member in cloudscale", "uuid", uuid, "name", member.GetMemberName(), "enabled", enabled, "tags", tags)
+	return nil
+}
@@ -0,0 +1,68 @@
+package probes
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultAPIURL is the URL of the cloudscale.ch API that is checked for reachability.
+	DefaultAPIURL = "https://api.cloudscale.ch/v1/regions"
+	// checkTimeout is the time after which the API is considered unreachable.
+	checkTimeout = 5 * time.Second
+	// checkInterval is the interval in which the API is checked at most.
+	// Probes run every few seconds, but the API shouldn't be called that often.
+	checkInterval = 1 * time.Minute
+)
+
+// APIReachabilityChecker checks whether the cloudscale.ch API can be reached.
+// Any HTTP response counts as reachable, since no credentials are sent.
+// The result is cached for a minute.
+type APIReachabilityChecker struct {
+	url    string
+	client *http.Client
+
+	mu        sync.Mutex
+	lastCheck time.Time
+	lastErr   error
+	now       func() time.Time
+}
+
+// NewAPIReachabilityChecker returns a new checker for the given URL.
+func NewAPIReachabilityChecker(url string) *APIReachabilityChecker {
+	return &APIReachabilityChecker{url: url, client: http.DefaultClient, now: time.Now}
+}
+
+// Check implements healthz.Checker.
+func (c *APIReachabilityChecker) Check(req *http.Request) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.lastCheck.IsZero() && c.now().Sub(c.lastCheck) < checkInterval {
+		return c.lastErr
+	}
+	c.lastErr = c.check(req.Context())
+	c.lastCheck = c.now()
+	return c.lastErr
+}
+
+func (c *APIReachabilityChecker) check(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, c.url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("cloudscale.ch API is not reachable: %w", err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode >= 500 {
+		return fmt.Errorf("cloudscale.ch API is not available: %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package probes
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIReachabilityChecker_Check(t *testing.T) {
+	tests := map[string]struct {
+		givenStatusCode int
+		expectedError   string
+	}{
+		"GivenUnauthorized_ThenExpectReachable": {
+			givenStatusCode: http.StatusUnauthorized,
+		},
+		"GivenOK_ThenExpectReachable": {
+			givenStatusCode: http.StatusOK,
+		},
+		"GivenServiceUnavailable_ThenExpectError": {
+			givenStatusCode: http.StatusServiceUnavailable,
+			expectedError:   "cloudscale.ch API is not available: 503 Service Unavailable",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.givenStatusCode)
+			}))
+			defer server.Close()
+
+			err := NewAPIReachabilityChecker(server.URL).Check(httptest.NewRequest(http.MethodGet, "/readyz", nil))
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestAPIReachabilityChecker_Check_Cached(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	}))
+	defer server.Close()
+
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	checker := NewAPIReachabilityChecker(server.URL)
+	checker.now = func() time.Time { return now }
+	req := httptest.NewRequest(http.MethodGet, "/readyz", nil)
+
+	assert.NoError(t, checker.Check(req))
+	assert.NoError(t, checker.Check(req))
+	assert.Equal(t, 1, requests, "result should be cached")
+
+	now = now.Add(checkInterval)
+	assert.NoError(t, checker.Check(req))
+	assert.Equal(t, 2, requests, "result should expire")
+}
@@ -0,0 +1,124 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// ThrottledRequeueAfter is the delay after which a managed resource is reconciled again if it has been throttled.
+const ThrottledRequeueAfter = 1 * time.Minute
+
+// Throttler prevents that throttled API requests are reported as reconcile errors.
+//
+// During mass provisioning, many resources may hit the rate limit at once.
+// Reporting each as a reconcile error would flip them to `ReconcileError` and flood events, although they're expected to succeed later without any change.
+// Instead, a throttled connection or observation is reported to the managed reconciler as conflict, which requeues the resource without touching its conditions or connection details.
+// The reconciler wrapped with WrapReconciler then requeues the resource after ThrottledRequeueAfter instead of with backoff.
+// Throttled creations, updates and deletions are still reported as errors, so that they are retried with backoff.
+type Throttler struct {
+	mu        sync.Mutex
+	throttled map[types.NamespacedName]struct{}
+}
+
+// NewThrottler returns a new Throttler.
+func NewThrottler() *Throttler {
+	return &Throttler{throttled: map[types.NamespacedName]struct{}{}}
+}
+
+// WrapConnecter returns an ExternalConnecter that handles throttled errors of the given ExternalConnecter.
+func (t *Throttler) WrapConnecter(c managed.ExternalConnecter) managed.ExternalConnecter {
+	return managed.ExternalConnectorFn(func(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+		client, err := c.Connect(ctx, mg)
+		if err != nil {
+			return nil, t.handleThrottled(ctx, mg, err)
+		}
+		return &throttlingClient{ExternalClient: client, throttler: t}, nil
+	})
+}
+
+// WrapReconciler returns a reconcile.Reconciler that requeues the resources after ThrottledRequeueAfter that have been throttled while being reconciled by the given reconcile.Reconciler.
+func (t *Throttler) WrapReconciler(r reconcile.Reconciler) reconcile.Reconciler {
+	return reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+		result, err := r.Reconcile(ctx, req)
+		if t.wasThrottled(req.NamespacedName) && err == nil {
+			return reconcile.Result{RequeueAfter: ThrottledRequeueAfter}, nil
+		}
+		return result, err
+	})
+}
+
+// handleThrottled returns a throttledError if the given error is caused by throttling, otherwise the error unchanged.
+// The resource is remembered so that the reconciler returned by WrapReconciler requeues it after ThrottledRequeueAfter.
+func (t *Throttler) handleThrottled(ctx context.Context, mg resource.Managed, err error) error {
+	if !IsThrottled(err) {
+		return err
+	}
+	log := controllerruntime.LoggerFrom(ctx)
+	log.V(1).Info("API requests are throttled, retrying later", "error", err.Error(), "requeueAfter", ThrottledRequeueAfter)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.throttled[types.NamespacedName{Namespace: mg.GetNamespace(), Name: mg.GetName()}] = struct{}{}
+	return &throttledError{err: err}
+}
+
+// wasThrottled returns true if the resource with the given name has been throttled since the last invocation.
+func (t *Throttler) wasThrottled(name types.NamespacedName) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	_, found := t.throttled[name]
+	delete(t.throttled, name)
+	return found
+}
+
+// throttlingClient reports throttled observations of the wrapped ExternalClient as throttledError.
+type throttlingClient struct {
+	managed.ExternalClient
+	throttler *Throttler
+}
+
+// Observe implements managed.ExternalClient.
+func (c *throttlingClient) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	obs, err := c.ExternalClient.Observe(ctx, mg)
+	if err != nil {
+		return obs, c.throttler.handleThrottled(ctx, mg, err)
+	}
+	return obs, nil
+}
+
+// throttledError is a throttled error that the managed reconciler treats like a conflict.
+// Conflicts are requeued without setting the `ReconcileError` condition, emitting an event or publishing connection details.
+type throttledError struct {
+	err error
+}
+
+var _ kerrors.APIStatus = &throttledError{}
+
+// Error implements error.
+func (e *throttledError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the throttled error.
+func (e *throttledError) Unwrap() error {
+	return e.err
+}
+
+// Status implements kerrors.APIStatus.
+func (e *throttledError) Status() metav1.Status {
+	return metav1.Status{
+		Status:  metav1.StatusFailure,
+		Code:    http.StatusConflict,
+		Reason:  metav1.StatusReasonConflict,
+		Message: e.Error(),
+	}
+}
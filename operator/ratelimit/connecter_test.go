@@ -0,0 +1,122 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/minio/minio-go/v7"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestThrottler(t *testing.T) {
+	tests := map[string]struct {
+		givenConnectErr error
+		givenObserveErr error
+		givenDeleted    bool
+		expectConflict  bool
+		expectedResult  reconcile.Result
+	}{
+		"GivenNoError_ThenExpectPollInterval": {
+			expectedResult: reconcile.Result{RequeueAfter: time.Hour},
+		},
+		"GivenThrottledObserve_ThenExpectConflictAndShortRequeue": {
+			givenObserveErr: &cloudscalesdk.ErrorResponse{StatusCode: http.StatusTooManyRequests},
+			expectConflict:  true,
+			expectedResult:  reconcile.Result{RequeueAfter: ThrottledRequeueAfter},
+		},
+		"GivenThrottledConnect_ThenExpectConflictAndShortRequeue": {
+			givenConnectErr: &ThrottledError{Err: errors.New("rate: Wait(n=1) would exceed context deadline")},
+			expectConflict:  true,
+			expectedResult:  reconcile.Result{RequeueAfter: ThrottledRequeueAfter},
+		},
+		"GivenThrottledObserve_WhenDeleted_ThenExpectConflictAndShortRequeue": {
+			givenObserveErr: &cloudscalesdk.ErrorResponse{StatusCode: http.StatusTooManyRequests},
+			givenDeleted:    true,
+			expectConflict:  true,
+			expectedResult:  reconcile.Result{RequeueAfter: ThrottledRequeueAfter},
+		},
+		"GivenCanceledContext_ThenExpectError": {
+			givenObserveErr: &ThrottledError{Err: context.Canceled},
+			expectedResult:  reconcile.Result{Requeue: true},
+		},
+		"GivenOtherError_ThenExpectError": {
+			givenObserveErr: errors.New("failed"),
+			expectedResult:  reconcile.Result{Requeue: true},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			mg := &cloudscalev1.Bucket{ObjectMeta: metav1.ObjectMeta{Name: "bucket", UID: "uid"}}
+			if tc.givenDeleted {
+				mg.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+			}
+			inner := managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+				if tc.givenConnectErr != nil {
+					return nil, tc.givenConnectErr
+				}
+				return &managed.ExternalClientFns{
+					ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+						return managed.ExternalObservation{ResourceExists: true}, tc.givenObserveErr
+					},
+				}, nil
+			})
+			throttler := NewThrottler()
+			connecter := throttler.WrapConnecter(inner)
+
+			// The reconciler mimics how the managed reconciler handles errors of Connect and Observe.
+			var observeErr error
+			r := throttler.WrapReconciler(reconcile.Func(func(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+				client, err := connecter.Connect(ctx, mg)
+				if err == nil {
+					_, err = client.Observe(ctx, mg)
+				}
+				observeErr = err
+				if err != nil {
+					// Conflicts are requeued without updating the status, other errors set the ReconcileError condition.
+					return reconcile.Result{Requeue: true}, nil
+				}
+				return reconcile.Result{RequeueAfter: time.Hour}, nil
+			}))
+
+			result, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "bucket"}})
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, result)
+			switch {
+			case tc.givenConnectErr != nil:
+				assert.ErrorIs(t, observeErr, tc.givenConnectErr)
+			case tc.givenObserveErr != nil:
+				assert.ErrorIs(t, observeErr, tc.givenObserveErr)
+			default:
+				assert.NoError(t, observeErr)
+			}
+			assert.Equal(t, tc.expectConflict, kerrors.IsConflict(observeErr))
+
+			result, err = r.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "other"}})
+			require.NoError(t, err)
+			assert.NotEqual(t, ThrottledRequeueAfter, result.RequeueAfter, "only the throttled resource should be requeued early")
+		})
+	}
+}
+
+func TestIsThrottled(t *testing.T) {
+	assert.True(t, IsThrottled(&ThrottledError{Err: errors.New("rate: Wait(n=1) would exceed context deadline")}))
+	assert.False(t, IsThrottled(&ThrottledError{Err: context.DeadlineExceeded}))
+	assert.False(t, IsThrottled(context.Canceled))
+	assert.True(t, IsThrottled(&cloudscalesdk.ErrorResponse{StatusCode: http.StatusTooManyRequests}))
+	assert.True(t, IsThrottled(minio.ErrorResponse{Code: "SlowDown", StatusCode: http.StatusServiceUnavailable}))
+	assert.False(t, IsThrottled(&cloudscalesdk.ErrorResponse{StatusCode: http.StatusInternalServerError}))
+	assert.False(t, IsThrottled(errors.New("failed")))
+	assert.False(t, IsThrottled(nil))
+}
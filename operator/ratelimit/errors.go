@@ -0,0 +1,47 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/minio/minio-go/v7"
+)
+
+// ThrottledError is returned by Transport if a request couldn't be made within the deadline of its context due to the client-side rate limit.
+type ThrottledError struct {
+	Err error
+}
+
+// Error implements error.
+func (e *ThrottledError) Error() string {
+	return "request throttled by client-side rate limit: " + e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *ThrottledError) Unwrap() error {
+	return e.Err
+}
+
+// IsThrottled returns true if the given error is caused by either the client-side rate limit or the server responding with 429 Too Many Requests.
+// Throttled requests are expected to succeed later without any change, so they shouldn't be reported as reconcile errors.
+// Errors caused by a canceled context or an exceeded deadline aren't considered throttled.
+func IsThrottled(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var throttledErr *ThrottledError
+	if errors.As(err, &throttledErr) {
+		return true
+	}
+	var csErr *cloudscalesdk.ErrorResponse
+	if errors.As(err, &csErr) {
+		return csErr.StatusCode == http.StatusTooManyRequests
+	}
+	var s3Err minio.ErrorResponse
+	if errors.As(err, &s3Err) {
+		return s3Err.StatusCode == http.StatusTooManyRequests || s3Err.Code == "SlowDown"
+	}
+	return false
+}
@@ -0,0 +1,70 @@
+package ratelimit
+
+import (
+	"net/http"
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+// Options configure the client-side rate limiting of API requests.
+type Options struct {
+	// QPS is the number of requests per second that are allowed per credentials.
+	// If zero or negative, requests are not limited.
+	QPS float64
+	// Burst is the number of requests that may be made at once, before QPS applies.
+	Burst int
+	// MaxRetries is the number of times a throttled or failed request is retried at most.
+	MaxRetries int
+}
+
+// DefaultOptions are the Options used unless Configure is called.
+var DefaultOptions = Options{
+	QPS:        10,
+	Burst:      20,
+	MaxRetries: 3,
+}
+
+var (
+	mu       sync.Mutex
+	options  = DefaultOptions
+	limiters = map[string]*rate.Limiter{}
+)
+
+// Configure sets the options for all limiters and transports created afterwards.
+// It is meant to be called once at startup, before any controllers are started.
+func Configure(opts Options) {
+	mu.Lock()
+	defer mu.Unlock()
+	options = opts
+	limiters = map[string]*rate.Limiter{}
+}
+
+// CurrentOptions returns the configured Options.
+func CurrentOptions() Options {
+	mu.Lock()
+	defer mu.Unlock()
+	return options
+}
+
+// LimiterFor returns the limiter shared by all clients with the given key, e.g. the UID of a ProviderConfig.
+// Clients that use the same credentials should use the same limiter, since the server applies its limits per credentials.
+// It returns nil if rate limiting is disabled.
+func LimiterFor(key string) *rate.Limiter {
+	mu.Lock()
+	defer mu.Unlock()
+	if options.QPS <= 0 {
+		return nil
+	}
+	limiter, found := limiters[key]
+	if !found {
+		limiter = rate.NewLimiter(rate.Limit(options.QPS), max(options.Burst, 1))
+		limiters[key] = limiter
+	}
+	return limiter
+}
+
+// NewTransport returns a new Transport that uses the limiter of the given key and the configured number of retries.
+func NewTransport(base http.RoundTripper, key string) *Transport {
+	return &Transport{Limiter: LimiterFor(key), MaxRetries: CurrentOptions().MaxRetries, Base: base}
+}
@@ -0,0 +1,142 @@
+package ratelimit
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	// MinBackoff is the delay before the first retry, if the response doesn't contain a Retry-After header.
+	MinBackoff = 1 * time.Second
+	// MaxBackoff is the longest delay before a retry.
+	// If the server asks to wait longer via Retry-After, the request isn't retried and the throttled response is returned instead.
+	MaxBackoff = 30 * time.Second
+)
+
+// Transport is a http.RoundTripper that limits the rate of requests and retries requests that have been throttled or failed on the server side.
+//
+// Throttled requests (429) are always retried, since the server didn't process them.
+// Server errors (5xx) are only retried for idempotent methods.
+// The Retry-After header of the response is honored.
+type Transport struct {
+	// Limiter limits the rate of requests, including retries.
+	// If nil, requests are not limited.
+	Limiter *rate.Limiter
+	// MaxRetries is the number of times a request is retried at most.
+	MaxRetries int
+	// Base is the underlying RoundTripper.
+	// If nil, http.DefaultTransport is used.
+	Base http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	ctx := req.Context()
+
+	for attempt := 0; ; attempt++ {
+		if t.Limiter != nil {
+			if err := t.Limiter.Wait(ctx); err != nil {
+				if ctx.Err() != nil {
+					// The context has been canceled or its deadline exceeded while waiting.
+					return nil, ctx.Err()
+				}
+				return nil, &ThrottledError{Err: err}
+			}
+		}
+		attemptReq, err := rewind(req, attempt)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := base.RoundTrip(attemptReq)
+		if err != nil || attempt >= t.MaxRetries || !isRetryable(req, resp) {
+			return resp, err
+		}
+		delay, ok := retryDelay(resp, attempt)
+		if !ok {
+			return resp, nil
+		}
+		// The response is discarded, drain it so that the connection can be reused.
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// rewind returns the request to send in the given attempt.
+// Requests with a body are cloned with a fresh body for each retry.
+func rewind(req *http.Request, attempt int) (*http.Request, error) {
+	if attempt == 0 || req.Body == nil || req.Body == http.NoBody {
+		return req, nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	clone := req.Clone(req.Context())
+	clone.Body = body
+	return clone, nil
+}
+
+func isRetryable(req *http.Request, resp *http.Response) bool {
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		// The body has been consumed and can't be sent again.
+		return false
+	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	if resp.StatusCode >= 500 && resp.StatusCode != http.StatusNotImplemented {
+		return isIdempotent(req.Method)
+	}
+	return false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// retryDelay returns the delay before the next attempt.
+// It returns false if the server asked to wait longer than MaxBackoff.
+func retryDelay(resp *http.Response, attempt int) (time.Duration, bool) {
+	if delay, found := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); found {
+		return delay, delay <= MaxBackoff
+	}
+	delay := MinBackoff << attempt
+	if delay > MaxBackoff || delay <= 0 {
+		delay = MaxBackoff
+	}
+	return delay, true
+}
+
+// parseRetryAfter parses the value of a Retry-After header, which is either a number of seconds or an HTTP date.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return max(time.Duration(seconds)*time.Second, 0), true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(date.Sub(now), 0), true
+	}
+	return 0, false
+}
@@ -0,0 +1,164 @@
+package ratelimit
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+func TestTransport_RoundTrip(t *testing.T) {
+	tests := map[string]struct {
+		givenMethod        string
+		givenResponses     []int
+		givenRetryAfter    string
+		expectedStatusCode int
+		expectedAttempts   int
+	}{
+		"GivenSuccess_ThenExpectNoRetry": {
+			givenMethod:        http.MethodGet,
+			givenResponses:     []int{http.StatusOK},
+			expectedStatusCode: http.StatusOK,
+			expectedAttempts:   1,
+		},
+		"GivenTooManyRequests_WhenRetryAfter_ThenExpectRetry": {
+			givenMethod:        http.MethodPost,
+			givenResponses:     []int{http.StatusTooManyRequests, http.StatusCreated},
+			givenRetryAfter:    "0",
+			expectedStatusCode: http.StatusCreated,
+			expectedAttempts:   2,
+		},
+		"GivenServerError_WhenIdempotent_ThenExpectRetry": {
+			givenMethod:        http.MethodGet,
+			givenResponses:     []int{http.StatusBadGateway, http.StatusOK},
+			givenRetryAfter:    "0",
+			expectedStatusCode: http.StatusOK,
+			expectedAttempts:   2,
+		},
+		"GivenServerError_WhenNotIdempotent_ThenExpectNoRetry": {
+			givenMethod:        http.MethodPost,
+			givenResponses:     []int{http.StatusBadGateway, http.StatusCreated},
+			expectedStatusCode: http.StatusBadGateway,
+			expectedAttempts:   1,
+		},
+		"GivenTooManyRequests_WhenRetryAfterTooLong_ThenExpectNoRetry": {
+			givenMethod:        http.MethodGet,
+			givenResponses:     []int{http.StatusTooManyRequests, http.StatusOK},
+			givenRetryAfter:    "3600",
+			expectedStatusCode: http.StatusTooManyRequests,
+			expectedAttempts:   1,
+		},
+		"GivenTooManyRequests_WhenRetriesExhausted_ThenExpectLastResponse": {
+			givenMethod:        http.MethodGet,
+			givenResponses:     []int{http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusTooManyRequests},
+			givenRetryAfter:    "0",
+			expectedStatusCode: http.StatusTooManyRequests,
+			expectedAttempts:   3,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			attempts := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodPost {
+					body, _ := io.ReadAll(r.Body)
+					assert.Equal(t, "body", string(body), "body should be sent again on retries")
+				}
+				if tc.givenRetryAfter != "" {
+					w.Header().Set("Retry-After", tc.givenRetryAfter)
+				}
+				w.WriteHeader(tc.givenResponses[attempts])
+				attempts++
+			}))
+			defer server.Close()
+
+			req, err := http.NewRequest(tc.givenMethod, server.URL, strings.NewReader("body"))
+			require.NoError(t, err)
+			transport := &Transport{MaxRetries: 2}
+			resp, err := transport.RoundTrip(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			assert.Equal(t, tc.expectedStatusCode, resp.StatusCode)
+			assert.Equal(t, tc.expectedAttempts, attempts)
+		})
+	}
+}
+
+func TestTransport_RoundTrip_Limiter(t *testing.T) {
+	tests := map[string]struct {
+		givenCanceled   bool
+		expectedErr     error
+		expectThrottled bool
+	}{
+		"GivenDeadline_WhenRateExceeded_ThenExpectThrottled": {
+			expectThrottled: true,
+		},
+		"GivenCanceledContext_ThenExpectNotThrottled": {
+			givenCanceled: true,
+			expectedErr:   context.Canceled,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+			if tc.givenCanceled {
+				cancel()
+			}
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://localhost", nil)
+			require.NoError(t, err)
+			transport := &Transport{Limiter: rate.NewLimiter(rate.Every(time.Hour), 0)}
+
+			_, err = transport.RoundTrip(req)
+			require.Error(t, err)
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+			}
+			assert.Equal(t, tc.expectThrottled, IsThrottled(err))
+		})
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := map[string]struct {
+		givenValue    string
+		expectedDelay time.Duration
+		expectedFound bool
+	}{
+		"GivenEmpty_ThenExpectNotFound": {
+			givenValue: "",
+		},
+		"GivenSeconds_ThenExpectDelay": {
+			givenValue:    "5",
+			expectedDelay: 5 * time.Second,
+			expectedFound: true,
+		},
+		"GivenDate_ThenExpectDelay": {
+			givenValue:    now.Add(10 * time.Second).Format(http.TimeFormat),
+			expectedDelay: 10 * time.Second,
+			expectedFound: true,
+		},
+		"GivenDateInPast_ThenExpectNoDelay": {
+			givenValue:    now.Add(-10 * time.Second).Format(http.TimeFormat),
+			expectedFound: true,
+		},
+		"GivenInvalidValue_ThenExpectNotFound": {
+			givenValue: "soon",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			delay, found := parseRetryAfter(tc.givenValue, now)
+			assert.Equal(t, tc.expectedDelay, delay)
+			assert.Equal(t, tc.expectedFound, found)
+		})
+	}
+}
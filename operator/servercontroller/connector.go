@@ -0,0 +1,32 @@
+package servercontroller
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type serverConnector struct {
+	kube      client.Client
+	recorder  event.Recorder
+	connector *cloudscaleclient.Connector
+}
+
+// Connect implements managed.ExternalConnecter.
+func (c *serverConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.V(1).Info("Connecting resource")
+
+	server := fromManaged(mg)
+	csClient, providerConfig, err := c.connector.Connect(ctx, server)
+	if err != nil {
+		return nil, err
+	}
+	server.Status.AtProvider.Project = providerConfig.Spec.Project
+	return NewPipeline(c.kube, c.recorder, csClient), nil
+}
@@ -0,0 +1,167 @@
+package servercontroller
+
+import (
+	"context"
+	"fmt"
+
+	pipeline "github.com/ccremer/go-command-pipeline"
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	"github.com/vshn/provider-cloudscale/operator/pipelineutil"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Create implements managed.ExternalClient.
+func (p *ServerPipeline) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.Info("Creating resource")
+
+	server := fromManaged(mg)
+	if server.Status.AtProvider.ServerUUID != "" {
+		// Server already exists
+		return managed.ExternalCreation{}, nil
+	}
+
+	pctx := &pipelineContext{Context: ctx, server: server}
+	pipe := pipeline.NewPipeline[*pipelineContext]()
+	stepMetrics := pipelineutil.NewStepMetrics[*pipelineContext](cloudscalev1.ServerKind)
+	pipe.WithBeforeHooks(pipelineutil.DebugLogger(pctx)).
+		WithSteps(stepMetrics.Steps(
+			pipe.NewStep("find server by owner tag", p.findServerByOwnerTag),
+			pipe.WithNestedSteps("create server", isServerMissing, stepMetrics.Steps(
+				pipe.When(hasUserDataSecretRef, "fetch user data", p.fetchUserData),
+				pipe.NewStep("create server", p.createServer),
+			)...),
+			pipe.NewStep("set server UUID annotation", p.setServerUUIDAnnotation),
+			pipe.NewStep("emit event", p.emitCreationEvent),
+		)...)
+	err := pipe.RunWithContext(pctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, "cannot create server")
+	}
+
+	return managed.ExternalCreation{ConnectionDetails: toConnectionDetails(pctx.csServer)}, nil
+}
+
+// findServerByOwnerTag searches for a server that has been created by a previous reconciliation of this resource.
+// If the process is interrupted after the server has been created in cloudscale.ch but before the server UUID annotation has been persisted,
+// the next reconciliation would otherwise create another server with the same name.
+// If exactly one server is found, it is adopted.
+func (p *ServerPipeline) findServerByOwnerTag(ctx *pipelineContext) error {
+	csClient := p.csClient
+	log := controllerruntime.LoggerFrom(ctx)
+	server := ctx.server
+
+	if server.UID == "" {
+		return nil
+	}
+	csServers, err := csClient.Servers.List(ctx, cloudscalesdk.WithTagFilter(cloudscalesdk.TagMap{cloudscaleclient.OwnerTagKey: string(server.UID)}))
+	if err != nil {
+		return errors.Wrap(err, "cannot list servers by owner tag")
+	}
+	switch len(csServers) {
+	case 0:
+		return nil
+	case 1:
+		ctx.csServer = &csServers[0]
+		log.V(1).Info("Adopting existing server in cloudscale", "uuid", ctx.csServer.UUID, "name", ctx.csServer.Name)
+		return nil
+	default:
+		return fmt.Errorf("found %d servers with tag %s=%s, expected at most one", len(csServers), cloudscaleclient.OwnerTagKey, server.UID)
+	}
+}
+
+// fetchUserData reads the cloud-init user data from the referenced secret.
+func (p *ServerPipeline) fetchUserData(ctx *pipelineContext) error {
+	ref := ctx.server.Spec.ForProvider.UserDataSecretRef
+	secret := &corev1.Secret{}
+	if err := p.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, secret); err != nil {
+		return errors.Wrap(err, "cannot get user data secret")
+	}
+	userData, exists := secret.Data[ref.Key]
+	if !exists {
+		return fmt.Errorf("secret %s/%s doesn't contain key %q", ref.Namespace, ref.Name, ref.Key)
+	}
+	ctx.userData = string(userData)
+	return nil
+}
+
+// createServer creates a new server in the project associated with the API token.
+func (p *ServerPipeline) createServer(ctx *pipelineContext) error {
+	csClient := p.csClient
+	log := controllerruntime.LoggerFrom(ctx)
+	server := ctx.server
+	params := server.Spec.ForProvider
+
+	if params.Image == "" {
+		return fmt.Errorf("image is required, set either image, imageRef or imageSelector")
+	}
+	req := &cloudscalesdk.ServerRequest{
+		Name:             server.GetServerName(),
+		Flavor:           params.Flavor,
+		Image:            params.Image,
+		Zone:             params.Zone,
+		VolumeSizeGB:     params.VolumeSizeGB,
+		BulkVolumeSizeGB: params.BulkVolumeSizeGB,
+		SSHKeys:          params.SSHKeys,
+		ServerGroups:     params.ServerGroupUUIDs,
+		UseIPV6:          params.UseIPv6,
+		UserData:         ctx.userData,
+		TaggedResourceRequest: cloudscalesdk.TaggedResourceRequest{
+			Tags: cloudscaleclient.ToTagMap(cloudscaleclient.DesiredTags(params.Tags, server.UID)),
+		},
+	}
+	if req.SSHKeys == nil {
+		// The API rejects null.
+		req.SSHKeys = []string{}
+	}
+	if len(params.Interfaces) > 0 {
+		req.Interfaces = toInterfaceRequests(params.Interfaces)
+	}
+
+	csServer, err := csClient.Servers.Create(ctx, req)
+	if err != nil {
+		return err
+	}
+	log.V(1).Info("Created server in cloudscale", "uuid", csServer.UUID, "name", csServer.Name)
+	ctx.csServer = csServer
+	return nil
+}
+
+// setServerUUIDAnnotation stores the UUID of the created or adopted server in an annotation.
+func (p *ServerPipeline) setServerUUIDAnnotation(ctx *pipelineContext) error {
+	cloudscaleclient.SetIDAnnotation(&ctx.server.ObjectMeta, ServerUUIDAnnotationKey, ctx.csServer.UUID)
+	return nil
+}
+
+func (p *ServerPipeline) emitCreationEvent(ctx *pipelineContext) error {
+	p.recorder.Event(ctx.server, event.Event{
+		Type:    event.TypeNormal,
+		Reason:  "Created",
+		Message: "Server successfully created",
+	})
+	return nil
+}
+
+func toInterfaceRequests(interfaces []cloudscalev1.ServerInterface) *[]cloudscalesdk.InterfaceRequest {
+	reqs := make([]cloudscalesdk.InterfaceRequest, 0, len(interfaces))
+	for _, iface := range interfaces {
+		req := cloudscalesdk.InterfaceRequest{Network: iface.Network}
+		if len(iface.Addresses) > 0 {
+			addrs := make([]cloudscalesdk.AddressRequest, 0, len(iface.Addresses))
+			for _, addr := range iface.Addresses {
+				addrs = append(addrs, cloudscalesdk.AddressRequest{Subnet: addr.Subnet, Address: addr.Address})
+			}
+			req.Addresses = &addrs
+		}
+		reqs = append(reqs, req)
+	}
+	return &reqs
+}
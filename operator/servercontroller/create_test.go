@@ -0,0 +1,143 @@
+package servercontroller
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type fakeServerService struct {
+	cloudscalesdk.ServerService
+	servers      []cloudscalesdk.Server
+	givenQuery   string
+	givenRequest *cloudscalesdk.ServerRequest
+	actions      []string
+}
+
+// List implements cloudscalesdk.ServerService.
+func (f *fakeServerService) List(_ context.Context, modifiers ...cloudscalesdk.ListRequestModifier) ([]cloudscalesdk.Server, error) {
+	req, _ := http.NewRequest(http.MethodGet, "https://api.cloudscale.ch/v1/servers", nil)
+	for _, modifier := range modifiers {
+		modifier(req)
+	}
+	f.givenQuery = req.URL.RawQuery
+	return f.servers, nil
+}
+
+// Create implements cloudscalesdk.ServerService.
+func (f *fakeServerService) Create(_ context.Context, createRequest *cloudscalesdk.ServerRequest) (*cloudscalesdk.Server, error) {
+	f.givenRequest = createRequest
+	return &cloudscalesdk.Server{UUID: "new", Name: createRequest.Name}, nil
+}
+
+// Update implements cloudscalesdk.ServerService.
+func (f *fakeServerService) Update(_ context.Context, _ string, updateRequest *cloudscalesdk.ServerUpdateRequest) error {
+	if updateRequest.Flavor != "" {
+		f.actions = append(f.actions, "change flavor")
+	}
+	return nil
+}
+
+// Start implements cloudscalesdk.ServerService.
+func (f *fakeServerService) Start(_ context.Context, _ string) error {
+	f.actions = append(f.actions, "start")
+	return nil
+}
+
+// Stop implements cloudscalesdk.ServerService.
+func (f *fakeServerService) Stop(_ context.Context, _ string) error {
+	f.actions = append(f.actions, "stop")
+	return nil
+}
+
+// Reboot implements cloudscalesdk.ServerService.
+func (f *fakeServerService) Reboot(_ context.Context, _ string) error {
+	f.actions = append(f.actions, "reboot")
+	return nil
+}
+
+func TestServerPipeline_findServerByOwnerTag(t *testing.T) {
+	tests := map[string]struct {
+		givenServers       []cloudscalesdk.Server
+		expectedServerUUID string
+		expectedError      string
+	}{
+		"GivenNoServer_ThenExpectNothingAdopted": {},
+		"GivenExistingServer_ThenExpectServerAdopted": {
+			givenServers:       []cloudscalesdk.Server{{UUID: "existing"}},
+			expectedServerUUID: "existing",
+		},
+		"GivenMultipleServers_ThenExpectError": {
+			givenServers:  []cloudscalesdk.Server{{UUID: "uuid1"}, {UUID: "uuid2"}},
+			expectedError: "found 2 servers with tag cloudscale.crossplane.io/owner-uid=uid, expected at most one",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			svc := &fakeServerService{servers: tc.givenServers}
+			p := NewPipeline(nil, nil, &cloudscalesdk.Client{Servers: svc})
+			ctx := &pipelineContext{
+				Context: logr.NewContext(context.Background(), logr.Discard()),
+				server:  &cloudscalev1.Server{ObjectMeta: metav1.ObjectMeta{Name: "my-server", UID: "uid"}},
+			}
+
+			err := p.findServerByOwnerTag(ctx)
+			assert.Equal(t, "tag%3Acloudscale.crossplane.io%2Fowner-uid=uid", svc.givenQuery)
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			if tc.expectedServerUUID == "" {
+				assert.Nil(t, ctx.csServer)
+				return
+			}
+			require.NotNil(t, ctx.csServer)
+			assert.Equal(t, tc.expectedServerUUID, ctx.csServer.UUID)
+		})
+	}
+}
+
+func TestServerPipeline_createServer(t *testing.T) {
+	svc := &fakeServerService{}
+	p := NewPipeline(nil, nil, &cloudscalesdk.Client{Servers: svc})
+	ctx := &pipelineContext{
+		Context: logr.NewContext(context.Background(), logr.Discard()),
+		server: &cloudscalev1.Server{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-server", UID: "uid"},
+			Spec: cloudscalev1.ServerSpec{ForProvider: cloudscalev1.ServerParameters{
+				Flavor:           "flex-4-2",
+				Image:            "debian-12",
+				Tags:             cloudscalev1.Tags{"team": "ops"},
+				ServerGroupUUIDs: []string{"group"},
+				Interfaces: []cloudscalev1.ServerInterface{
+					{Network: cloudscalev1.PublicNetwork},
+					{Network: "network", Addresses: []cloudscalev1.ServerInterfaceAddress{{Subnet: "subnet", Address: "10.0.0.10"}}},
+				},
+			}},
+		},
+		userData: "#cloud-config",
+	}
+
+	err := p.createServer(ctx)
+	require.NoError(t, err)
+	req := svc.givenRequest
+	assert.Equal(t, "my-server", req.Name)
+	assert.Equal(t, "#cloud-config", req.UserData)
+	assert.Equal(t, []string{}, req.SSHKeys, "SSH keys must not be null")
+	assert.Equal(t, cloudscalesdk.TagMap{"team": "ops", "cloudscale.crossplane.io/owner-uid": "uid"}, *req.Tags)
+	require.NotNil(t, req.Interfaces)
+	assert.Equal(t, []cloudscalesdk.InterfaceRequest{
+		{Network: cloudscalev1.PublicNetwork},
+		{Network: "network", Addresses: &[]cloudscalesdk.AddressRequest{{Subnet: "subnet", Address: "10.0.0.10"}}},
+	}, *req.Interfaces)
+	assert.Equal(t, []string{"group"}, req.ServerGroups)
+	assert.Equal(t, "new", ctx.csServer.UUID)
+}
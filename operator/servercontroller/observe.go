@@ -0,0 +1,173 @@
+package servercontroller
+
+import (
+	"context"
+	"slices"
+
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Observe implements managed.ExternalClient.
+func (p *ServerPipeline) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.V(1).Info("Observing resource")
+
+	server := fromManaged(mg)
+	if server.Status.AtProvider.ServerUUID == "" {
+		if uuid, exists := cloudscaleclient.IDFromAnnotation(server, ServerUUIDAnnotationKey); exists {
+			server.Status.AtProvider.ServerUUID = uuid
+			// A server that has just been created doesn't need to be rebooted.
+			server.Status.AtProvider.LastRebootRequest = server.Annotations[cloudscalev1.RebootAnnotationKey]
+		} else {
+			// New resource, create server first
+			return managed.ExternalObservation{}, nil
+		}
+	}
+
+	pctx := &pipelineContext{Context: ctx, server: server}
+	err := p.getServer(pctx)
+	if err != nil {
+		return managed.ExternalObservation{}, resource.Ignore(cloudscaleclient.IsNotFound, err)
+	}
+
+	csServer := pctx.csServer
+	server.Status.AtProvider = toObservation(csServer, server.Status.AtProvider)
+	switch csServer.Status {
+	case cloudscalesdk.ServerRunning, cloudscalesdk.ServerStopped:
+		server.SetConditions(xpv1.Available())
+	default:
+		server.SetConditions(xpv1.Unavailable().WithMessage("server is " + csServer.Status))
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  isUpToDate(server, csServer),
+		ConnectionDetails: toConnectionDetails(csServer),
+	}, nil
+}
+
+// getServer fetches an existing server from the project associated with the API token.
+func (p *ServerPipeline) getServer(ctx *pipelineContext) error {
+	csClient := p.csClient
+	log := controllerruntime.LoggerFrom(ctx)
+
+	csServer, err := csClient.Servers.Get(ctx, ctx.server.Status.AtProvider.ServerUUID)
+	if err != nil {
+		return err
+	}
+	ctx.csServer = csServer
+	log.V(1).Info("Fetched server in cloudscale", "uuid", csServer.UUID, "name", csServer.Name, "status", csServer.Status)
+	return nil
+}
+
+// isUpToDate returns true if the updatable fields of the server match the spec, the server is in the desired power state and no reboot has been requested.
+// Fields that can't be changed after creation are ignored.
+// The observation has to be updated beforehand.
+func isUpToDate(server *cloudscalev1.Server, csServer *cloudscalesdk.Server) bool {
+	params := server.Spec.ForProvider
+	return server.GetServerName() == csServer.Name &&
+		params.Flavor == csServer.Flavor.Slug &&
+		!cloudscaleclient.TagsNeedUpdate(cloudscaleclient.DesiredTags(params.Tags, server.UID), csServer.Tags) &&
+		interfacesUpToDate(params.Interfaces, server.Status.AtProvider.Interfaces) &&
+		!isPowerStateChanged(server) &&
+		!isRebootRequested(server)
+}
+
+// isPowerStateChanged returns true if the server is running or stopped, but not in the desired power state.
+// Transitional states like `changing` are ignored, since an action is already in progress.
+// The observation has to be updated beforehand.
+func isPowerStateChanged(server *cloudscalev1.Server) bool {
+	switch state := server.Status.AtProvider.State; state {
+	case cloudscalesdk.ServerRunning, cloudscalesdk.ServerStopped:
+		return state != string(server.GetPowerState())
+	default:
+		return false
+	}
+}
+
+// isRebootRequested returns true if the reboot annotation has been changed since the last reboot request has been handled.
+func isRebootRequested(server *cloudscalev1.Server) bool {
+	value, exists := server.Annotations[cloudscalev1.RebootAnnotationKey]
+	return exists && value != server.Status.AtProvider.LastRebootRequest
+}
+
+// interfacesUpToDate returns true if the observed interfaces are attached to the desired networks in the same order, and have the desired fixed addresses.
+// If no interfaces are desired, the interfaces chosen by cloudscale.ch are accepted.
+func interfacesUpToDate(desired []cloudscalev1.ServerInterface, observed []cloudscalev1.ServerInterfaceObservation) bool {
+	if len(desired) == 0 {
+		return true
+	}
+	if len(desired) != len(observed) {
+		return false
+	}
+	for i, iface := range desired {
+		if iface.Network == cloudscalev1.PublicNetwork {
+			if observed[i].Type != cloudscalev1.PublicNetwork {
+				return false
+			}
+			continue
+		}
+		if observed[i].NetworkUUID != iface.Network {
+			return false
+		}
+		for _, addr := range iface.Addresses {
+			found := slices.ContainsFunc(observed[i].Addresses, func(observedAddr cloudscalev1.ServerAddressObservation) bool {
+				return (addr.Address == "" || addr.Address == observedAddr.Address) &&
+					(addr.Subnet == "" || addr.Subnet == observedAddr.SubnetUUID)
+			})
+			if !found {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// toObservation returns the observed fields of the given server.
+// The fields that aren't observed in cloudscale.ch are taken from the previous observation.
+func toObservation(csServer *cloudscalesdk.Server, prev cloudscalev1.ServerObservation) cloudscalev1.ServerObservation {
+	obs := cloudscalev1.ServerObservation{
+		ServerUUID:        csServer.UUID,
+		ServerName:        csServer.Name,
+		State:             csServer.Status,
+		Flavor:            csServer.Flavor.Slug,
+		Image:             csServer.Image.Slug,
+		Zone:              csServer.Zone.Slug,
+		PublicIPv4:        publicAddress(csServer, 4),
+		PublicIPv6:        publicAddress(csServer, 6),
+		Tags:              cloudscaleclient.FromTagMap(csServer.Tags),
+		Project:           prev.Project,
+		LastRebootRequest: prev.LastRebootRequest,
+	}
+	for _, iface := range csServer.Interfaces {
+		ifaceObs := cloudscalev1.ServerInterfaceObservation{Type: iface.Type, NetworkUUID: iface.Network.UUID}
+		for _, addr := range iface.Addresses {
+			ifaceObs.Addresses = append(ifaceObs.Addresses, cloudscalev1.ServerAddressObservation{
+				Version:      addr.Version,
+				Address:      addr.Address,
+				PrefixLength: addr.PrefixLength,
+				Gateway:      addr.Gateway,
+				SubnetUUID:   addr.Subnet.UUID,
+			})
+		}
+		obs.Interfaces = append(obs.Interfaces, ifaceObs)
+	}
+	for _, volume := range csServer.Volumes {
+		obs.Volumes = append(obs.Volumes, cloudscalev1.ServerVolumeObservation{
+			VolumeUUID: volume.UUID,
+			Type:       volume.Type,
+			DevicePath: volume.DevicePath,
+			SizeGB:     volume.SizeGB,
+		})
+	}
+	for _, group := range csServer.ServerGroups {
+		obs.ServerGroupUUIDs = append(obs.ServerGroupUUIDs, group.UUID)
+	}
+	return obs
+}
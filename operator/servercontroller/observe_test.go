@@ -0,0 +1,73 @@
+package servercontroller
+
+import (
+	"testing"
+
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/stretchr/testify/assert"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+)
+
+func TestInterfacesUpToDate(t *testing.T) {
+	observed := []cloudscalev1.ServerInterfaceObservation{
+		{Type: cloudscalev1.PublicNetwork, NetworkUUID: "public-uuid"},
+		{Type: "private", NetworkUUID: "network", Addresses: []cloudscalev1.ServerAddressObservation{{Address: "10.0.0.10", SubnetUUID: "subnet"}}},
+	}
+	tests := map[string]struct {
+		givenInterfaces []cloudscalev1.ServerInterface
+		expectedResult  bool
+	}{
+		"GivenNoInterfaces_ThenExpectUpToDate": {
+			expectedResult: true,
+		},
+		"GivenSameNetworks_ThenExpectUpToDate": {
+			givenInterfaces: []cloudscalev1.ServerInterface{{Network: cloudscalev1.PublicNetwork}, {Network: "network"}},
+			expectedResult:  true,
+		},
+		"GivenSameAddress_ThenExpectUpToDate": {
+			givenInterfaces: []cloudscalev1.ServerInterface{
+				{Network: cloudscalev1.PublicNetwork},
+				{Network: "network", Addresses: []cloudscalev1.ServerInterfaceAddress{{Subnet: "subnet", Address: "10.0.0.10"}}},
+			},
+			expectedResult: true,
+		},
+		"GivenDifferentAddress_ThenExpectNotUpToDate": {
+			givenInterfaces: []cloudscalev1.ServerInterface{
+				{Network: cloudscalev1.PublicNetwork},
+				{Network: "network", Addresses: []cloudscalev1.ServerInterfaceAddress{{Address: "10.0.0.11"}}},
+			},
+			expectedResult: false,
+		},
+		"GivenDifferentOrder_ThenExpectNotUpToDate": {
+			givenInterfaces: []cloudscalev1.ServerInterface{{Network: "network"}, {Network: cloudscalev1.PublicNetwork}},
+			expectedResult:  false,
+		},
+		"GivenPublicNetworkRemoved_ThenExpectNotUpToDate": {
+			givenInterfaces: []cloudscalev1.ServerInterface{{Network: "network"}},
+			expectedResult:  false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			result := interfacesUpToDate(tc.givenInterfaces, observed)
+			assert.Equal(t, tc.expectedResult, result)
+		})
+	}
+}
+
+func TestToConnectionDetails(t *testing.T) {
+	csServer := &cloudscalesdk.Server{Interfaces: []cloudscalesdk.Interface{
+		{Type: "private", Addresses: []cloudscalesdk.Address{{Version: 4, Address: "10.0.0.10"}}},
+		{Type: cloudscalev1.PublicNetwork, Addresses: []cloudscalesdk.Address{
+			{Version: 6, Address: "2001:db8::1"},
+			{Version: 4, Address: "192.0.2.1"},
+		}},
+	}}
+
+	result := toConnectionDetails(csServer)
+	assert.Equal(t, managed.ConnectionDetails{
+		cloudscalev1.PublicIPv4Name: []byte("192.0.2.1"),
+		cloudscalev1.PublicIPv6Name: []byte("2001:db8::1"),
+	}, result)
+}
@@ -0,0 +1,93 @@
+package servercontroller
+
+import (
+	"context"
+
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ServerUUIDAnnotationKey is the annotation key where the server UUID is stored.
+	ServerUUIDAnnotationKey = "cloudscale.crossplane.io/server-uuid"
+)
+
+// ServerPipeline provisions Servers on cloudscale.ch
+type ServerPipeline struct {
+	kube     client.Client
+	recorder event.Recorder
+	csClient *cloudscalesdk.Client
+}
+
+type pipelineContext struct {
+	context.Context
+	server   *cloudscalev1.Server
+	csServer *cloudscalesdk.Server
+	userData string
+}
+
+// ParentContext implements tracing.Context.
+func (c *pipelineContext) ParentContext() context.Context {
+	return c.Context
+}
+
+// SetParentContext implements tracing.Context.
+func (c *pipelineContext) SetParentContext(ctx context.Context) {
+	c.Context = ctx
+}
+
+// NewPipeline returns a new instance of ServerPipeline.
+func NewPipeline(client client.Client, recorder event.Recorder, csClient *cloudscalesdk.Client) *ServerPipeline {
+	return &ServerPipeline{
+		kube:     client,
+		recorder: recorder,
+		csClient: csClient,
+	}
+}
+
+// Disconnect implements managed.ExternalClient.
+func (p *ServerPipeline) Disconnect(_ context.Context) error {
+	return nil
+}
+
+func isServerMissing(ctx *pipelineContext) bool {
+	return ctx.csServer == nil
+}
+
+func hasUserDataSecretRef(ctx *pipelineContext) bool {
+	return ctx.server.Spec.ForProvider.UserDataSecretRef != nil
+}
+
+func toConnectionDetails(csServer *cloudscalesdk.Server) managed.ConnectionDetails {
+	details := managed.ConnectionDetails{}
+	if ipv4 := publicAddress(csServer, 4); ipv4 != "" {
+		details[cloudscalev1.PublicIPv4Name] = []byte(ipv4)
+	}
+	if ipv6 := publicAddress(csServer, 6); ipv6 != "" {
+		details[cloudscalev1.PublicIPv6Name] = []byte(ipv6)
+	}
+	return details
+}
+
+// publicAddress returns the first address of the given IP version in the public network, or an empty string if there is none.
+func publicAddress(csServer *cloudscalesdk.Server, version int) string {
+	for _, iface := range csServer.Interfaces {
+		if iface.Type != cloudscalev1.PublicNetwork {
+			continue
+		}
+		for _, addr := range iface.Addresses {
+			if addr.Version == version {
+				return addr.Address
+			}
+		}
+	}
+	return ""
+}
+
+func fromManaged(mg resource.Managed) *cloudscalev1.Server {
+	return mg.(*cloudscalev1.Server)
+}
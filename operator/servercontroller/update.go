@@ -0,0 +1,164 @@
+package servercontroller
+
+import (
+	"context"
+
+	pipeline "github.com/ccremer/go-command-pipeline"
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	"github.com/vshn/provider-cloudscale/operator/pipelineutil"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Update implements managed.ExternalClient.
+func (p *ServerPipeline) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.Info("Updating resource")
+	server := fromManaged(mg)
+
+	pctx := &pipelineContext{Context: ctx, server: server}
+	pipe := pipeline.NewPipeline[*pipelineContext]()
+	stepMetrics := pipelineutil.NewStepMetrics[*pipelineContext](cloudscalev1.ServerKind)
+	pipe.WithBeforeHooks(pipelineutil.DebugLogger(pctx)).
+		WithSteps(stepMetrics.Steps(
+			pipe.NewStep("update server", p.updateServer),
+			pipe.When(isStopRequested, "stop server", p.stopServer),
+			pipe.When(isFlavorChanged, "change flavor", p.changeFlavor),
+			pipe.When(isStartRequested, "start server", p.startServer),
+			pipe.When(hasRebootRequest, "reboot server", p.rebootServer),
+		)...)
+	err := pipe.RunWithContext(pctx)
+
+	return managed.ExternalUpdate{}, errors.Wrap(err, "cannot update server")
+}
+
+// updateServer updates the name, tags and interfaces of the server identified by UUID.
+func (p *ServerPipeline) updateServer(ctx *pipelineContext) error {
+	csClient := p.csClient
+	log := controllerruntime.LoggerFrom(ctx)
+	server := ctx.server
+	params := server.Spec.ForProvider
+	uuid := server.Status.AtProvider.ServerUUID
+	tags := cloudscaleclient.DesiredTags(params.Tags, server.UID)
+
+	req := &cloudscalesdk.ServerUpdateRequest{
+		Name: server.GetServerName(),
+		TaggedResourceRequest: cloudscalesdk.TaggedResourceRequest{
+			Tags: cloudscaleclient.ToTagMap(tags),
+		},
+	}
+	if !interfacesUpToDate(params.Interfaces, server.Status.AtProvider.Interfaces) {
+		// Interfaces are only sent if they changed, since re-attaching them may interrupt the network connectivity.
+		req.Interfaces = toInterfaceRequests(params.Interfaces)
+	}
+	if err := csClient.Servers.Update(ctx, uuid, req); err != nil {
+		return err
+	}
+	log.V(1).Info("Updated server in cloudscale", "uuid", uuid, "name", server.GetServerName(), "tags", tags)
+	return nil
+}
+
+// changeFlavor changes the flavor of the server.
+// This is rejected by cloudscale.ch unless the server is stopped, see `powerState`.
+// Stopping a server is asynchronous, so the flavor of a server that isn't observed as stopped yet is changed in a later reconcile.
+func (p *ServerPipeline) changeFlavor(ctx *pipelineContext) error {
+	csClient := p.csClient
+	log := controllerruntime.LoggerFrom(ctx)
+	server := ctx.server
+	uuid := server.Status.AtProvider.ServerUUID
+
+	if server.Status.AtProvider.State != cloudscalesdk.ServerStopped {
+		log.V(1).Info("Skipped flavor change of server in cloudscale until it's stopped", "uuid", uuid, "flavor", server.Spec.ForProvider.Flavor, "state", server.Status.AtProvider.State)
+		return nil
+	}
+	err := csClient.Servers.Update(ctx, uuid, &cloudscalesdk.ServerUpdateRequest{Flavor: server.Spec.ForProvider.Flavor})
+	if err != nil {
+		return errors.Wrap(err, "cannot change flavor")
+	}
+	log.V(1).Info("Changed flavor of server in cloudscale", "uuid", uuid, "flavor", server.Spec.ForProvider.Flavor)
+	return nil
+}
+
+func isFlavorChanged(ctx *pipelineContext) bool {
+	return ctx.server.Spec.ForProvider.Flavor != ctx.server.Status.AtProvider.Flavor
+}
+
+// stopServer stops the running server.
+func (p *ServerPipeline) stopServer(ctx *pipelineContext) error {
+	csClient := p.csClient
+	log := controllerruntime.LoggerFrom(ctx)
+	uuid := ctx.server.Status.AtProvider.ServerUUID
+
+	if err := csClient.Servers.Stop(ctx, uuid); err != nil {
+		return errors.Wrap(err, "cannot stop server")
+	}
+	log.V(1).Info("Stopped server in cloudscale", "uuid", uuid)
+	p.recorder.Event(ctx.server, event.Event{
+		Type:    event.TypeNormal,
+		Reason:  "Stopped",
+		Message: "Server stopped",
+	})
+	return nil
+}
+
+// startServer starts the stopped server.
+// This also restores the desired power state if the server has been stopped outside of Kubernetes, e.g. in the cloudscale.ch UI.
+func (p *ServerPipeline) startServer(ctx *pipelineContext) error {
+	csClient := p.csClient
+	log := controllerruntime.LoggerFrom(ctx)
+	uuid := ctx.server.Status.AtProvider.ServerUUID
+
+	if err := csClient.Servers.Start(ctx, uuid); err != nil {
+		return errors.Wrap(err, "cannot start server")
+	}
+	log.V(1).Info("Started server in cloudscale", "uuid", uuid)
+	p.recorder.Event(ctx.server, event.Event{
+		Type:    event.TypeNormal,
+		Reason:  "Started",
+		Message: "Server started",
+	})
+	return nil
+}
+
+// rebootServer reboots the server and remembers the value of the reboot annotation, so that the server is rebooted once per change.
+// A server that isn't running or has just been started or stopped isn't rebooted.
+func (p *ServerPipeline) rebootServer(ctx *pipelineContext) error {
+	csClient := p.csClient
+	log := controllerruntime.LoggerFrom(ctx)
+	server := ctx.server
+	uuid := server.Status.AtProvider.ServerUUID
+	request := server.Annotations[cloudscalev1.RebootAnnotationKey]
+
+	if server.Status.AtProvider.State == cloudscalesdk.ServerRunning && !isPowerStateChanged(server) {
+		if err := csClient.Servers.Reboot(ctx, uuid); err != nil {
+			return errors.Wrap(err, "cannot reboot server")
+		}
+		log.V(1).Info("Rebooted server in cloudscale", "uuid", uuid, "request", request)
+		p.recorder.Event(server, event.Event{
+			Type:    event.TypeNormal,
+			Reason:  "Rebooted",
+			Message: "Server rebooted",
+		})
+	} else {
+		log.V(1).Info("Skipped reboot of server in cloudscale", "uuid", uuid, "request", request, "state", server.Status.AtProvider.State)
+	}
+	server.Status.AtProvider.LastRebootRequest = request
+	return nil
+}
+
+func hasRebootRequest(ctx *pipelineContext) bool {
+	return isRebootRequested(ctx.server)
+}
+
+func isStopRequested(ctx *pipelineContext) bool {
+	return isPowerStateChanged(ctx.server) && ctx.server.GetPowerState() == cloudscalev1.PowerStateStopped
+}
+
+func isStartRequested(ctx *pipelineContext) bool {
+	return isPowerStateChanged(ctx.server) && ctx.server.GetPowerState() == cloudscalev1.PowerStateRunning
+}
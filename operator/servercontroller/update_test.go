@@ -0,0 +1,140 @@
+package servercontroller
+
+import (
+	"context"
+	"testing"
+
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/operatortest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestServerPipeline_Update_PowerState(t *testing.T) {
+	tests := map[string]struct {
+		givenPowerState        cloudscalev1.PowerState
+		givenState             string
+		givenFlavor            string
+		givenRebootAnnotation  string
+		givenLastRebootRequest string
+
+		expectedActions           []string
+		expectedEvents            []event.Reason
+		expectedLastRebootRequest string
+	}{
+		"GivenRunningServer_WhenDesiredRunning_ThenExpectNoAction": {
+			givenPowerState: cloudscalev1.PowerStateRunning,
+			givenState:      cloudscalesdk.ServerRunning,
+		},
+		"GivenStoppedServer_WhenNoPowerState_ThenExpectStarted": {
+			givenState:      cloudscalesdk.ServerStopped,
+			expectedActions: []string{"start"},
+			expectedEvents:  []event.Reason{"Started"},
+		},
+		"GivenRunningServer_WhenDesiredStopped_ThenExpectStopped": {
+			givenPowerState: cloudscalev1.PowerStateStopped,
+			givenState:      cloudscalesdk.ServerRunning,
+			expectedActions: []string{"stop"},
+			expectedEvents:  []event.Reason{"Stopped"},
+		},
+		"GivenChangingServer_WhenDesiredStopped_ThenExpectNoAction": {
+			givenPowerState: cloudscalev1.PowerStateStopped,
+			givenState:      "changing",
+		},
+		"GivenRunningServer_WhenDesiredStoppedAndFlavorChanged_ThenExpectStoppedOnly": {
+			givenPowerState: cloudscalev1.PowerStateStopped,
+			givenState:      cloudscalesdk.ServerRunning,
+			givenFlavor:     "flex-8-4",
+			expectedActions: []string{"stop"},
+			expectedEvents:  []event.Reason{"Stopped"},
+		},
+		"GivenChangingServer_WhenFlavorChanged_ThenExpectNoAction": {
+			givenPowerState: cloudscalev1.PowerStateStopped,
+			givenState:      "changing",
+			givenFlavor:     "flex-8-4",
+		},
+		"GivenStoppedServer_WhenFlavorChanged_ThenExpectFlavorChanged": {
+			givenPowerState: cloudscalev1.PowerStateStopped,
+			givenState:      cloudscalesdk.ServerStopped,
+			givenFlavor:     "flex-8-4",
+			expectedActions: []string{"change flavor"},
+		},
+		"GivenStoppedServer_WhenFlavorChangedAndDesiredRunning_ThenExpectFlavorChangedAndStarted": {
+			givenPowerState: cloudscalev1.PowerStateRunning,
+			givenState:      cloudscalesdk.ServerStopped,
+			givenFlavor:     "flex-8-4",
+			expectedActions: []string{"change flavor", "start"},
+			expectedEvents:  []event.Reason{"Started"},
+		},
+		"GivenRunningServer_WhenRebootRequested_ThenExpectRebooted": {
+			givenState:                cloudscalesdk.ServerRunning,
+			givenRebootAnnotation:     "2024-01-02T03:04:05Z",
+			givenLastRebootRequest:    "2024-01-01T03:04:05Z",
+			expectedActions:           []string{"reboot"},
+			expectedEvents:            []event.Reason{"Rebooted"},
+			expectedLastRebootRequest: "2024-01-02T03:04:05Z",
+		},
+		"GivenRunningServer_WhenRebootAlreadyHandled_ThenExpectNoAction": {
+			givenState:                cloudscalesdk.ServerRunning,
+			givenRebootAnnotation:     "2024-01-02T03:04:05Z",
+			givenLastRebootRequest:    "2024-01-02T03:04:05Z",
+			expectedLastRebootRequest: "2024-01-02T03:04:05Z",
+		},
+		"GivenStoppedServer_WhenRebootRequested_ThenExpectStartedOnly": {
+			givenState:                cloudscalesdk.ServerStopped,
+			givenRebootAnnotation:     "2024-01-02T03:04:05Z",
+			expectedActions:           []string{"start"},
+			expectedEvents:            []event.Reason{"Started"},
+			expectedLastRebootRequest: "2024-01-02T03:04:05Z",
+		},
+		"GivenRunningServer_WhenDesiredStoppedAndRebootRequested_ThenExpectStoppedOnly": {
+			givenPowerState:           cloudscalev1.PowerStateStopped,
+			givenState:                cloudscalesdk.ServerRunning,
+			givenRebootAnnotation:     "2024-01-02T03:04:05Z",
+			expectedActions:           []string{"stop"},
+			expectedEvents:            []event.Reason{"Stopped"},
+			expectedLastRebootRequest: "2024-01-02T03:04:05Z",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			svc := &fakeServerService{}
+			recorder := &operatortest.Recorder{}
+			p := NewPipeline(nil, recorder, &cloudscalesdk.Client{Servers: svc})
+			flavor := "flex-4-2"
+			if tc.givenFlavor != "" {
+				flavor = tc.givenFlavor
+			}
+			server := &cloudscalev1.Server{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-server"},
+				Spec: cloudscalev1.ServerSpec{ForProvider: cloudscalev1.ServerParameters{
+					Flavor:     flavor,
+					PowerState: tc.givenPowerState,
+				}},
+				Status: cloudscalev1.ServerStatus{AtProvider: cloudscalev1.ServerObservation{
+					ServerUUID:        "uuid",
+					Flavor:            "flex-4-2",
+					State:             tc.givenState,
+					LastRebootRequest: tc.givenLastRebootRequest,
+				}},
+			}
+			if tc.givenRebootAnnotation != "" {
+				server.Annotations = map[string]string{cloudscalev1.RebootAnnotationKey: tc.givenRebootAnnotation}
+			}
+
+			_, err := p.Update(logr.NewContext(context.Background(), logr.Discard()), server)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedActions, svc.actions)
+			var reasons []event.Reason
+			for _, e := range recorder.Events {
+				reasons = append(reasons, e.Reason)
+			}
+			assert.Equal(t, tc.expectedEvents, reasons)
+			assert.Equal(t, tc.expectedLastRebootRequest, server.Status.AtProvider.LastRebootRequest)
+		})
+	}
+}
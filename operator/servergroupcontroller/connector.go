@@ -0,0 +1,30 @@
+package servergroupcontroller
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+type serverGroupConnector struct {
+	recorder  event.Recorder
+	connector *cloudscaleclient.Connector
+}
+
+// Connect implements managed.ExternalConnecter.
+func (c *serverGroupConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.V(1).Info("Connecting resource")
+
+	serverGroup := fromManaged(mg)
+	csClient, providerConfig, err := c.connector.Connect(ctx, serverGroup)
+	if err != nil {
+		return nil, err
+	}
+	serverGroup.Status.AtProvider.Project = providerConfig.Spec.Project
+	return NewPipeline(c.recorder, csClient), nil
+}
@@ -0,0 +1,111 @@
+package servergroupcontroller
+
+import (
+	"context"
+	"fmt"
+
+	pipeline "github.com/ccremer/go-command-pipeline"
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	"github.com/vshn/provider-cloudscale/operator/pipelineutil"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Create implements managed.ExternalClient.
+func (p *ServerGroupPipeline) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.Info("Creating resource")
+
+	serverGroup := fromManaged(mg)
+	if serverGroup.Status.AtProvider.ServerGroupUUID != "" {
+		// Server group already exists
+		return managed.ExternalCreation{}, nil
+	}
+
+	pctx := &pipelineContext{Context: ctx, serverGroup: serverGroup}
+	pipe := pipeline.NewPipeline[*pipelineContext]()
+	stepMetrics := pipelineutil.NewStepMetrics[*pipelineContext](cloudscalev1.ServerGroupKindName)
+	pipe.WithBeforeHooks(pipelineutil.DebugLogger(pctx)).
+		WithSteps(stepMetrics.Steps(
+			pipe.NewStep("find server group by owner tag", p.findServerGroupByOwnerTag),
+			pipe.When(isServerGroupMissing, "create server group", p.createServerGroup),
+			pipe.NewStep("set server group UUID annotation", p.setServerGroupUUIDAnnotation),
+			pipe.NewStep("emit event", p.emitCreationEvent),
+		)...)
+	err := pipe.RunWithContext(pctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, "cannot create server group")
+	}
+
+	return managed.ExternalCreation{}, nil
+}
+
+// findServerGroupByOwnerTag searches for a server group that has been created by a previous reconciliation of this resource.
+// See servercontroller for why this is needed.
+// If exactly one server group is found, it is adopted.
+func (p *ServerGroupPipeline) findServerGroupByOwnerTag(ctx *pipelineContext) error {
+	csClient := p.csClient
+	log := controllerruntime.LoggerFrom(ctx)
+	serverGroup := ctx.serverGroup
+
+	if serverGroup.UID == "" {
+		return nil
+	}
+	csServerGroups, err := csClient.ServerGroups.List(ctx, cloudscalesdk.WithTagFilter(cloudscalesdk.TagMap{cloudscaleclient.OwnerTagKey: string(serverGroup.UID)}))
+	if err != nil {
+		return errors.Wrap(err, "cannot list server groups by owner tag")
+	}
+	switch len(csServerGroups) {
+	case 0:
+		return nil
+	case 1:
+		ctx.csServerGroup = &csServerGroups[0]
+		log.V(1).Info("Adopting existing server group in cloudscale", "uuid", ctx.csServerGroup.UUID, "name", ctx.csServerGroup.Name)
+		return nil
+	default:
+		return fmt.Errorf("found %d server groups with tag %s=%s, expected at most one", len(csServerGroups), cloudscaleclient.OwnerTagKey, serverGroup.UID)
+	}
+}
+
+// createServerGroup creates a new server group in the project associated with the API token.
+func (p *ServerGroupPipeline) createServerGroup(ctx *pipelineContext) error {
+	csClient := p.csClient
+	log := controllerruntime.LoggerFrom(ctx)
+	serverGroup := ctx.serverGroup
+	params := serverGroup.Spec.ForProvider
+
+	csServerGroup, err := csClient.ServerGroups.Create(ctx, &cloudscalesdk.ServerGroupRequest{
+		ZonalResourceRequest: cloudscalesdk.ZonalResourceRequest{Zone: params.Zone},
+		TaggedResourceRequest: cloudscalesdk.TaggedResourceRequest{
+			Tags: cloudscaleclient.ToTagMap(cloudscaleclient.DesiredTags(params.Tags, serverGroup.UID)),
+		},
+		Name: serverGroup.GetServerGroupName(),
+		Type: serverGroup.GetType(),
+	})
+	if err != nil {
+		return err
+	}
+	log.V(1).Info("Created server group in cloudscale", "uuid", csServerGroup.UUID, "name", csServerGroup.Name)
+	ctx.csServerGroup = csServerGroup
+	return nil
+}
+
+// setServerGroupUUIDAnnotation stores the UUID of the created or adopted server group in an annotation.
+func (p *ServerGroupPipeline) setServerGroupUUIDAnnotation(ctx *pipelineContext) error {
+	cloudscaleclient.SetIDAnnotation(&ctx.serverGroup.ObjectMeta, ServerGroupUUIDAnnotationKey, ctx.csServerGroup.UUID)
+	return nil
+}
+
+func (p *ServerGroupPipeline) emitCreationEvent(ctx *pipelineContext) error {
+	p.recorder.Event(ctx.serverGroup, event.Event{
+		Type:    event.TypeNormal,
+		Reason:  "Created",
+		Message: "Server group successfully created",
+	})
+	return nil
+}
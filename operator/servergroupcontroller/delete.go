@@ -0,0 +1,57 @@
+package servergroupcontroller
+
+import (
+	"context"
+
+	pipeline "github.com/ccremer/go-command-pipeline"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	"github.com/vshn/provider-cloudscale/operator/pipelineutil"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Delete implements managed.ExternalClient.
+func (p *ServerGroupPipeline) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.Info("Deleting resource")
+
+	serverGroup := fromManaged(mg)
+	pctx := &pipelineContext{Context: ctx, serverGroup: serverGroup}
+	pipe := pipeline.NewPipeline[*pipelineContext]()
+	stepMetrics := pipelineutil.NewStepMetrics[*pipelineContext](cloudscalev1.ServerGroupKindName)
+	pipe.WithBeforeHooks(pipelineutil.DebugLogger(pctx)).
+		WithSteps(stepMetrics.Steps(
+			pipe.NewStep("delete server group", p.deleteServerGroup),
+			pipe.NewStep("emit event", p.emitDeletionEvent),
+		)...)
+	err := pipe.RunWithContext(pctx)
+	return managed.ExternalDelete{}, errors.Wrap(err, "cannot deprovision server group")
+}
+
+// deleteServerGroup deletes the server group.
+// The servers of the group aren't affected.
+func (p *ServerGroupPipeline) deleteServerGroup(ctx *pipelineContext) error {
+	csClient := p.csClient
+	log := controllerruntime.LoggerFrom(ctx)
+	uuid := ctx.serverGroup.Status.AtProvider.ServerGroupUUID
+
+	err := csClient.ServerGroups.Delete(ctx, uuid)
+	if err != nil {
+		return resource.Ignore(cloudscaleclient.IsNotFound, err)
+	}
+	log.V(1).Info("Deleted server group in cloudscale", "uuid", uuid)
+	return nil
+}
+
+func (p *ServerGroupPipeline) emitDeletionEvent(ctx *pipelineContext) error {
+	p.recorder.Event(ctx.serverGroup, event.Event{
+		Type:    event.TypeNormal,
+		Reason:  "Deleted",
+		Message: "Server group deleted",
+	})
+	return nil
+}
@@ -0,0 +1,81 @@
+package servergroupcontroller
+
+import (
+	"context"
+
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Observe implements managed.ExternalClient.
+func (p *ServerGroupPipeline) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.V(1).Info("Observing resource")
+
+	serverGroup := fromManaged(mg)
+	if serverGroup.Status.AtProvider.ServerGroupUUID == "" {
+		if uuid, exists := cloudscaleclient.IDFromAnnotation(serverGroup, ServerGroupUUIDAnnotationKey); exists {
+			serverGroup.Status.AtProvider.ServerGroupUUID = uuid
+		} else {
+			// New resource, create server group first
+			return managed.ExternalObservation{}, nil
+		}
+	}
+
+	pctx := &pipelineContext{Context: ctx, serverGroup: serverGroup}
+	err := p.getServerGroup(pctx)
+	if err != nil {
+		return managed.ExternalObservation{}, resource.Ignore(cloudscaleclient.IsNotFound, err)
+	}
+
+	csServerGroup := pctx.csServerGroup
+	serverGroup.Status.AtProvider = toObservation(csServerGroup, serverGroup.Status.AtProvider.Project)
+	serverGroup.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: isUpToDate(serverGroup, csServerGroup),
+	}, nil
+}
+
+// getServerGroup fetches an existing server group from the project associated with the API token.
+func (p *ServerGroupPipeline) getServerGroup(ctx *pipelineContext) error {
+	csClient := p.csClient
+	log := controllerruntime.LoggerFrom(ctx)
+
+	csServerGroup, err := csClient.ServerGroups.Get(ctx, ctx.serverGroup.Status.AtProvider.ServerGroupUUID)
+	if err != nil {
+		return err
+	}
+	ctx.csServerGroup = csServerGroup
+	log.V(1).Info("Fetched server group in cloudscale", "uuid", csServerGroup.UUID, "name", csServerGroup.Name)
+	return nil
+}
+
+// isUpToDate returns true if the updatable fields of the server group match the spec.
+// Fields that can't be changed after creation are ignored.
+func isUpToDate(serverGroup *cloudscalev1.ServerGroup, csServerGroup *cloudscalesdk.ServerGroup) bool {
+	return serverGroup.GetServerGroupName() == csServerGroup.Name &&
+		!cloudscaleclient.TagsNeedUpdate(cloudscaleclient.DesiredTags(serverGroup.Spec.ForProvider.Tags, serverGroup.UID), csServerGroup.Tags)
+}
+
+// toObservation returns the observed fields of the given server group.
+func toObservation(csServerGroup *cloudscalesdk.ServerGroup, project string) cloudscalev1.ServerGroupObservation {
+	obs := cloudscalev1.ServerGroupObservation{
+		ServerGroupUUID: csServerGroup.UUID,
+		ServerGroupName: csServerGroup.Name,
+		Type:            csServerGroup.Type,
+		Zone:            csServerGroup.Zone.Slug,
+		Tags:            cloudscaleclient.FromTagMap(csServerGroup.Tags),
+		Project:         project,
+	}
+	for _, server := range csServerGroup.Servers {
+		obs.ServerUUIDs = append(obs.ServerUUIDs, server.UUID)
+	}
+	return obs
+}
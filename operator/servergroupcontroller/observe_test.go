@@ -0,0 +1,30 @@
+package servergroupcontroller
+
+import (
+	"testing"
+
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/stretchr/testify/assert"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+)
+
+func TestToObservation(t *testing.T) {
+	csServerGroup := &cloudscalesdk.ServerGroup{
+		ZonalResource: cloudscalesdk.ZonalResource{Zone: cloudscalesdk.Zone{Slug: "rma1"}},
+		UUID:          "uuid",
+		Name:          "db",
+		Type:          cloudscalev1.ServerGroupAntiAffinity,
+		Servers:       []cloudscalesdk.ServerStub{{UUID: "server-1"}, {UUID: "server-2"}},
+	}
+
+	obs := toObservation(csServerGroup, "project")
+	assert.Equal(t, cloudscalev1.ServerGroupObservation{
+		ServerGroupUUID: "uuid",
+		ServerGroupName: "db",
+		Type:            cloudscalev1.ServerGroupAntiAffinity,
+		Zone:            "rma1",
+		ServerUUIDs:     []string{"server-1", "server-2"},
+		Tags:            cloudscalev1.Tags{},
+		Project:         "project",
+	}, obs)
+}
@@ -0,0 +1,58 @@
+package servergroupcontroller
+
+import (
+	"context"
+
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+)
+
+const (
+	// ServerGroupUUIDAnnotationKey is the annotation key where the server group UUID is stored.
+	ServerGroupUUIDAnnotationKey = "cloudscale.crossplane.io/server-group-uuid"
+)
+
+// ServerGroupPipeline provisions ServerGroups on cloudscale.ch
+type ServerGroupPipeline struct {
+	recorder event.Recorder
+	csClient *cloudscalesdk.Client
+}
+
+type pipelineContext struct {
+	context.Context
+	serverGroup   *cloudscalev1.ServerGroup
+	csServerGroup *cloudscalesdk.ServerGroup
+}
+
+// ParentContext implements tracing.Context.
+func (c *pipelineContext) ParentContext() context.Context {
+	return c.Context
+}
+
+// SetParentContext implements tracing.Context.
+func (c *pipelineContext) SetParentContext(ctx context.Context) {
+	c.Context = ctx
+}
+
+// NewPipeline returns a new instance of ServerGroupPipeline.
+func NewPipeline(recorder event.Recorder, csClient *cloudscalesdk.Client) *ServerGroupPipeline {
+	return &ServerGroupPipeline{
+		recorder: recorder,
+		csClient: csClient,
+	}
+}
+
+// Disconnect implements managed.ExternalClient.
+func (p *ServerGroupPipeline) Disconnect(_ context.Context) error {
+	return nil
+}
+
+func isServerGroupMissing(ctx *pipelineContext) bool {
+	return ctx.csServerGroup == nil
+}
+
+func fromManaged(mg resource.Managed) *cloudscalev1.ServerGroup {
+	return mg.(*cloudscalev1.ServerGroup)
+}
@@ -0,0 +1,54 @@
+package servergroupcontroller
+
+import (
+	"context"
+
+	pipeline "github.com/ccremer/go-command-pipeline"
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	"github.com/vshn/provider-cloudscale/operator/pipelineutil"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Update implements managed.ExternalClient.
+func (p *ServerGroupPipeline) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.Info("Updating resource")
+	serverGroup := fromManaged(mg)
+
+	pctx := &pipelineContext{Context: ctx, serverGroup: serverGroup}
+	pipe := pipeline.NewPipeline[*pipelineContext]()
+	stepMetrics := pipelineutil.NewStepMetrics[*pipelineContext](cloudscalev1.ServerGroupKindName)
+	pipe.WithBeforeHooks(pipelineutil.DebugLogger(pctx)).
+		WithSteps(stepMetrics.Steps(
+			pipe.NewStep("update server group", p.updateServerGroup),
+		)...)
+	err := pipe.RunWithContext(pctx)
+
+	return managed.ExternalUpdate{}, errors.Wrap(err, "cannot update server group")
+}
+
+// updateServerGroup updates the name and tags of the server group identified by UUID.
+func (p *ServerGroupPipeline) updateServerGroup(ctx *pipelineContext) error {
+	csClient := p.csClient
+	log := controllerruntime.LoggerFrom(ctx)
+	serverGroup := ctx.serverGroup
+	uuid := serverGroup.Status.AtProvider.ServerGroupUUID
+	tags := cloudscaleclient.DesiredTags(serverGroup.Spec.ForProvider.Tags, serverGroup.UID)
+
+	err := csClient.ServerGroups.Update(ctx, uuid, &cloudscalesdk.ServerGroupRequest{
+		TaggedResourceRequest: cloudscalesdk.TaggedResourceRequest{
+			Tags: cloudscaleclient.ToTagMap(tags),
+		},
+		Name: serverGroup.GetServerGroupName(),
+	})
+	if err != nil {
+		return err
+	}
+	log.V(1).Info("Updated server group in cloudscale", "uuid", uuid, "name", serverGroup.GetServerGroupName(), "tags", tags)
+	return nil
+}
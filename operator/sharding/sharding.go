@@ -0,0 +1,111 @@
+package sharding
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// Options restrict an operator instance to a subset of the managed resources, so that the work can be split across multiple instances.
+// The zero value doesn't restrict anything.
+type Options struct {
+	// Selector selects the managed resources by their labels.
+	// Resources that don't match aren't cached at all.
+	Selector labels.Selector
+	// ProviderConfigs are the names of the ProviderConfigs whose managed resources are reconciled.
+	// If empty, managed resources of all ProviderConfigs are reconciled.
+	ProviderConfigs []string
+}
+
+// NewOptions parses the given label selector and returns the Options.
+func NewOptions(selector string, providerConfigs []string) (Options, error) {
+	opts := Options{ProviderConfigs: slices.Sorted(slices.Values(providerConfigs))}
+	if selector == "" {
+		return opts, nil
+	}
+	sel, err := labels.Parse(selector)
+	if err != nil {
+		return opts, fmt.Errorf("cannot parse shard selector: %w", err)
+	}
+	opts.Selector = sel
+	return opts, nil
+}
+
+// IsSharded returns true if any restriction is configured.
+func (o Options) IsSharded() bool {
+	return o.hasSelector() || len(o.ProviderConfigs) > 0
+}
+
+// LeaderElectionID returns the given ID, suffixed with a hash of the restrictions if sharded.
+// Instances of the same shard compete for the same lease, while instances of different shards don't block each other.
+func (o Options) LeaderElectionID(id string) string {
+	if !o.IsSharded() {
+		return id
+	}
+	h := sha256.New()
+	if o.hasSelector() {
+		h.Write([]byte(o.Selector.String()))
+	}
+	h.Write([]byte("\n" + strings.Join(o.ProviderConfigs, ",")))
+	return id + "-" + hex.EncodeToString(h.Sum(nil))[:10]
+}
+
+// CacheByObject returns the cache options that restrict the managed resources to the label selector.
+func (o Options) CacheByObject() map[client.Object]cache.ByObject {
+	if !o.hasSelector() {
+		return nil
+	}
+	return map[client.Object]cache.ByObject{
+		&cloudscalev1.ObjectsUser{}:    {Label: o.Selector},
+		&cloudscalev1.Bucket{}:         {Label: o.Selector},
+		&cloudscalev1.Server{}:         {Label: o.Selector},
+		&cloudscalev1.Volume{}:         {Label: o.Selector},
+		&cloudscalev1.VolumeSnapshot{}: {Label: o.Selector},
+		&cloudscalev1.Network{}:        {Label: o.Selector},
+		&cloudscalev1.Subnet{}:         {Label: o.Selector},
+		&cloudscalev1.FloatingIP{}:     {Label: o.Selector},
+		&cloudscalev1.LoadBalancer{}:   {Label: o.Selector},
+		&cloudscalev1.Pool{}:           {Label: o.Selector},
+		&cloudscalev1.PoolMember{}:     {Label: o.Selector},
+		&cloudscalev1.Listener{}:       {Label: o.Selector},
+		&cloudscalev1.HealthMonitor{}:  {Label: o.Selector},
+		&cloudscalev1.ServerGroup{}:    {Label: o.Selector},
+		&cloudscalev1.CustomImage{}:    {Label: o.Selector},
+		&cloudscalev1.Catalog{}:        {Label: o.Selector},
+	}
+}
+
+// ManagedPredicate returns a predicate that accepts managed resources that refer to one of the ProviderConfigs.
+func (o Options) ManagedPredicate() predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		if len(o.ProviderConfigs) == 0 {
+			return true
+		}
+		mg, ok := obj.(resource.Managed)
+		if !ok {
+			return false
+		}
+		ref := mg.GetProviderConfigReference()
+		return ref != nil && slices.Contains(o.ProviderConfigs, ref.Name)
+	})
+}
+
+// ProviderConfigPredicate returns a predicate that accepts the ProviderConfigs by name.
+func (o Options) ProviderConfigPredicate() predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		return len(o.ProviderConfigs) == 0 || slices.Contains(o.ProviderConfigs, obj.GetName())
+	})
+}
+
+func (o Options) hasSelector() bool {
+	return o.Selector != nil && !o.Selector.Empty()
+}
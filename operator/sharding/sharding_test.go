@@ -0,0 +1,88 @@
+package sharding
+
+import (
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	providerv1 "github.com/vshn/provider-cloudscale/apis/provider/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+func TestNewOptions(t *testing.T) {
+	tests := map[string]struct {
+		givenSelector        string
+		givenProviderConfigs []string
+		expectSharded        bool
+		expectedError        string
+	}{
+		"GivenNothing_ThenExpectUnsharded": {
+			expectSharded: false,
+		},
+		"GivenSelector_ThenExpectSharded": {
+			givenSelector: "tenant=acme",
+			expectSharded: true,
+		},
+		"GivenProviderConfigs_ThenExpectSharded": {
+			givenProviderConfigs: []string{"acme"},
+			expectSharded:        true,
+		},
+		"GivenInvalidSelector_ThenExpectError": {
+			givenSelector: "tenant in (",
+			expectedError: "cannot parse shard selector",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			opts, err := NewOptions(tc.givenSelector, tc.givenProviderConfigs)
+			if tc.expectedError != "" {
+				assert.ErrorContains(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectSharded, opts.IsSharded())
+			assert.Equal(t, tc.expectSharded, opts.LeaderElectionID("id") != "id")
+		})
+	}
+}
+
+func TestOptions_LeaderElectionID(t *testing.T) {
+	acme, err := NewOptions("tenant=acme", []string{"b", "a"})
+	require.NoError(t, err)
+	acmeReordered, err := NewOptions("tenant=acme", []string{"a", "b"})
+	require.NoError(t, err)
+	other, err := NewOptions("tenant=other", []string{"a", "b"})
+	require.NoError(t, err)
+
+	assert.Equal(t, acme.LeaderElectionID("id"), acmeReordered.LeaderElectionID("id"))
+	assert.NotEqual(t, acme.LeaderElectionID("id"), other.LeaderElectionID("id"))
+}
+
+func TestOptions_ManagedPredicate(t *testing.T) {
+	opts, err := NewOptions("", []string{"acme"})
+	require.NoError(t, err)
+	newBucket := func(providerConfig string) *cloudscalev1.Bucket {
+		bucket := &cloudscalev1.Bucket{}
+		bucket.SetProviderConfigReference(&xpv1.Reference{Name: providerConfig})
+		return bucket
+	}
+
+	assert.True(t, opts.ManagedPredicate().Create(event.CreateEvent{Object: newBucket("acme")}))
+	assert.False(t, opts.ManagedPredicate().Create(event.CreateEvent{Object: newBucket("other")}))
+	assert.False(t, opts.ManagedPredicate().Create(event.CreateEvent{Object: &cloudscalev1.Bucket{}}))
+	assert.True(t, Options{}.ManagedPredicate().Create(event.CreateEvent{Object: newBucket("other")}))
+}
+
+func TestOptions_ProviderConfigPredicate(t *testing.T) {
+	opts, err := NewOptions("", []string{"acme"})
+	require.NoError(t, err)
+	newProviderConfig := func(name string) *providerv1.ProviderConfig {
+		return &providerv1.ProviderConfig{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	}
+
+	assert.True(t, opts.ProviderConfigPredicate().Create(event.CreateEvent{Object: newProviderConfig("acme")}))
+	assert.False(t, opts.ProviderConfigPredicate().Create(event.CreateEvent{Object: newProviderConfig("other")}))
+}
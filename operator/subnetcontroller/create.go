@@ -0,0 +1,115 @@
+package subnetcontroller
+
+import (
+	"context"
+	"fmt"
+
+	pipeline "github.com/ccremer/go-command-pipeline"
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	"github.com/vshn/provider-cloudscale/operator/pipelineutil"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Create implements managed.ExternalClient.
+func (p *SubnetPipeline) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.Info("Creating resource")
+
+	subnet := fromManaged(mg)
+	if subnet.Status.AtProvider.SubnetUUID != "" {
+		// Subnet already exists
+		return managed.ExternalCreation{}, nil
+	}
+
+	pctx := &pipelineContext{Context: ctx, subnet: subnet}
+	pipe := pipeline.NewPipeline[*pipelineContext]()
+	stepMetrics := pipelineutil.NewStepMetrics[*pipelineContext](cloudscalev1.SubnetKind)
+	pipe.WithBeforeHooks(pipelineutil.DebugLogger(pctx)).
+		WithSteps(stepMetrics.Steps(
+			pipe.NewStep("find subnet by owner tag", p.findSubnetByOwnerTag),
+			pipe.When(isSubnetMissing, "create subnet", p.createSubnet),
+			pipe.NewStep("set subnet UUID annotation", p.setSubnetUUIDAnnotation),
+			pipe.NewStep("emit event", p.emitCreationEvent),
+		)...)
+	err := pipe.RunWithContext(pctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, "cannot create subnet")
+	}
+
+	return managed.ExternalCreation{}, nil
+}
+
+// findSubnetByOwnerTag searches for a subnet that has been created by a previous reconciliation of this resource.
+// See servercontroller for why this is needed.
+// If exactly one subnet is found, it is adopted.
+func (p *SubnetPipeline) findSubnetByOwnerTag(ctx *pipelineContext) error {
+	csClient := p.csClient
+	log := controllerruntime.LoggerFrom(ctx)
+	subnet := ctx.subnet
+
+	if subnet.UID == "" {
+		return nil
+	}
+	csSubnets, err := csClient.Subnets.List(ctx, cloudscalesdk.WithTagFilter(cloudscalesdk.TagMap{cloudscaleclient.OwnerTagKey: string(subnet.UID)}))
+	if err != nil {
+		return errors.Wrap(err, "cannot list subnets by owner tag")
+	}
+	switch len(csSubnets) {
+	case 0:
+		return nil
+	case 1:
+		ctx.csSubnet = &csSubnets[0]
+		log.V(1).Info("Adopting existing subnet in cloudscale", "uuid", ctx.csSubnet.UUID, "cidr", ctx.csSubnet.CIDR)
+		return nil
+	default:
+		return fmt.Errorf("found %d subnets with tag %s=%s, expected at most one", len(csSubnets), cloudscaleclient.OwnerTagKey, subnet.UID)
+	}
+}
+
+// createSubnet creates a new subnet in the network given by UUID.
+func (p *SubnetPipeline) createSubnet(ctx *pipelineContext) error {
+	csClient := p.csClient
+	log := controllerruntime.LoggerFrom(ctx)
+	subnet := ctx.subnet
+	params := subnet.Spec.ForProvider
+
+	if params.NetworkUUID == "" {
+		return errors.New("network of the subnet is not resolved yet")
+	}
+	csSubnet, err := csClient.Subnets.Create(ctx, &cloudscalesdk.SubnetCreateRequest{
+		TaggedResourceRequest: cloudscalesdk.TaggedResourceRequest{
+			Tags: cloudscaleclient.ToTagMap(cloudscaleclient.DesiredTags(params.Tags, subnet.UID)),
+		},
+		CIDR:           params.CIDR,
+		Network:        params.NetworkUUID,
+		GatewayAddress: params.GatewayAddress,
+		DNSServers:     params.DNSServers,
+	})
+	if err != nil {
+		return err
+	}
+	log.V(1).Info("Created subnet in cloudscale", "uuid", csSubnet.UUID, "cidr", csSubnet.CIDR, "network", csSubnet.Network.UUID)
+	ctx.csSubnet = csSubnet
+	return nil
+}
+
+// setSubnetUUIDAnnotation stores the UUID of the created or adopted subnet in an annotation.
+func (p *SubnetPipeline) setSubnetUUIDAnnotation(ctx *pipelineContext) error {
+	cloudscaleclient.SetIDAnnotation(&ctx.subnet.ObjectMeta, SubnetUUIDAnnotationKey, ctx.csSubnet.UUID)
+	return nil
+}
+
+func (p *SubnetPipeline) emitCreationEvent(ctx *pipelineContext) error {
+	p.recorder.Event(ctx.subnet, event.Event{
+		Type:    event.TypeNormal,
+		Reason:  "Created",
+		Message: "Subnet successfully created",
+	})
+	return nil
+}
@@ -0,0 +1,57 @@
+package subnetcontroller
+
+import (
+	"context"
+
+	pipeline "github.com/ccremer/go-command-pipeline"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	"github.com/vshn/provider-cloudscale/operator/pipelineutil"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Delete implements managed.ExternalClient.
+func (p *SubnetPipeline) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.Info("Deleting resource")
+
+	subnet := fromManaged(mg)
+	pctx := &pipelineContext{Context: ctx, subnet: subnet}
+	pipe := pipeline.NewPipeline[*pipelineContext]()
+	stepMetrics := pipelineutil.NewStepMetrics[*pipelineContext](cloudscalev1.SubnetKind)
+	pipe.WithBeforeHooks(pipelineutil.DebugLogger(pctx)).
+		WithSteps(stepMetrics.Steps(
+			pipe.NewStep("delete subnet", p.deleteSubnet),
+			pipe.NewStep("emit event", p.emitDeletionEvent),
+		)...)
+	err := pipe.RunWithContext(pctx)
+	return managed.ExternalDelete{}, errors.Wrap(err, "cannot deprovision subnet")
+}
+
+// deleteSubnet deletes the subnet.
+// cloudscale.ch refuses to delete the subnet as long as servers have addresses in it.
+func (p *SubnetPipeline) deleteSubnet(ctx *pipelineContext) error {
+	csClient := p.csClient
+	log := controllerruntime.LoggerFrom(ctx)
+	uuid := ctx.subnet.Status.AtProvider.SubnetUUID
+
+	err := csClient.Subnets.Delete(ctx, uuid)
+	if err != nil {
+		return resource.Ignore(cloudscaleclient.IsNotFound, err)
+	}
+	log.V(1).Info("Deleted subnet in cloudscale", "uuid", uuid)
+	return nil
+}
+
+func (p *SubnetPipeline) emitDeletionEvent(ctx *pipelineContext) error {
+	p.recorder.Event(ctx.subnet, event.Event{
+		Type:    event.TypeNormal,
+		Reason:  "Deleted",
+		Message: "Subnet deleted",
+	})
+	return nil
+}
@@ -0,0 +1,82 @@
+package subnetcontroller
+
+import (
+	"context"
+	"slices"
+
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Observe implements managed.ExternalClient.
+func (p *SubnetPipeline) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.V(1).Info("Observing resource")
+
+	subnet := fromManaged(mg)
+	if subnet.Status.AtProvider.SubnetUUID == "" {
+		if uuid, exists := cloudscaleclient.IDFromAnnotation(subnet, SubnetUUIDAnnotationKey); exists {
+			subnet.Status.AtProvider.SubnetUUID = uuid
+		} else {
+			// New resource, create subnet first
+			return managed.ExternalObservation{}, nil
+		}
+	}
+
+	pctx := &pipelineContext{Context: ctx, subnet: subnet}
+	err := p.getSubnet(pctx)
+	if err != nil {
+		return managed.ExternalObservation{}, resource.Ignore(cloudscaleclient.IsNotFound, err)
+	}
+
+	csSubnet := pctx.csSubnet
+	subnet.Status.AtProvider = toObservation(csSubnet, subnet.Status.AtProvider.Project)
+	subnet.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: isUpToDate(subnet, csSubnet),
+	}, nil
+}
+
+// getSubnet fetches an existing subnet from the project associated with the API token.
+func (p *SubnetPipeline) getSubnet(ctx *pipelineContext) error {
+	csClient := p.csClient
+	log := controllerruntime.LoggerFrom(ctx)
+
+	csSubnet, err := csClient.Subnets.Get(ctx, ctx.subnet.Status.AtProvider.SubnetUUID)
+	if err != nil {
+		return err
+	}
+	ctx.csSubnet = csSubnet
+	log.V(1).Info("Fetched subnet in cloudscale", "uuid", csSubnet.UUID, "cidr", csSubnet.CIDR)
+	return nil
+}
+
+// isUpToDate returns true if the updatable fields of the subnet match the spec.
+// Fields that can't be changed after creation are ignored.
+// An empty gateway or empty DNS servers accept the values chosen by cloudscale.ch.
+func isUpToDate(subnet *cloudscalev1.Subnet, csSubnet *cloudscalesdk.Subnet) bool {
+	params := subnet.Spec.ForProvider
+	return (params.GatewayAddress == "" || params.GatewayAddress == csSubnet.GatewayAddress) &&
+		(len(params.DNSServers) == 0 || slices.Equal(params.DNSServers, csSubnet.DNSServers)) &&
+		!cloudscaleclient.TagsNeedUpdate(cloudscaleclient.DesiredTags(params.Tags, subnet.UID), csSubnet.Tags)
+}
+
+// toObservation returns the observed fields of the given subnet.
+func toObservation(csSubnet *cloudscalesdk.Subnet, project string) cloudscalev1.SubnetObservation {
+	return cloudscalev1.SubnetObservation{
+		SubnetUUID:     csSubnet.UUID,
+		CIDR:           csSubnet.CIDR,
+		NetworkUUID:    csSubnet.Network.UUID,
+		GatewayAddress: csSubnet.GatewayAddress,
+		DNSServers:     csSubnet.DNSServers,
+		Tags:           cloudscaleclient.FromTagMap(csSubnet.Tags),
+		Project:        project,
+	}
+}
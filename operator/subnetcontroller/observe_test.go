@@ -0,0 +1,51 @@
+package subnetcontroller
+
+import (
+	"testing"
+
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/stretchr/testify/assert"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestIsUpToDate(t *testing.T) {
+	tests := map[string]struct {
+		givenGateway    string
+		givenDNSServers []string
+		expected        bool
+	}{
+		"GivenNoGatewayAndDNSServers_ThenExpectUpToDate": {
+			expected: true,
+		},
+		"GivenSameGatewayAndDNSServers_ThenExpectUpToDate": {
+			givenGateway: "10.11.12.1", givenDNSServers: []string{"10.11.12.2", "10.11.12.3"}, expected: true,
+		},
+		"GivenDifferentGateway_ThenExpectNotUpToDate": {
+			givenGateway: "10.11.12.254", expected: false,
+		},
+		"GivenDifferentDNSServers_ThenExpectNotUpToDate": {
+			givenDNSServers: []string{"10.11.12.2"}, expected: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			subnet := &cloudscalev1.Subnet{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-subnet", UID: "uid"},
+				Spec: cloudscalev1.SubnetSpec{ForProvider: cloudscalev1.SubnetParameters{
+					CIDR:           "10.11.12.0/24",
+					GatewayAddress: tc.givenGateway,
+					DNSServers:     tc.givenDNSServers,
+				}},
+			}
+			csSubnet := &cloudscalesdk.Subnet{
+				CIDR:           "10.11.12.0/24",
+				GatewayAddress: "10.11.12.1",
+				DNSServers:     []string{"10.11.12.2", "10.11.12.3"},
+				TaggedResource: cloudscalesdk.TaggedResource{Tags: cloudscalesdk.TagMap{cloudscaleclient.OwnerTagKey: "uid"}},
+			}
+			assert.Equal(t, tc.expected, isUpToDate(subnet, csSubnet))
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package subnetcontroller
+
+import (
+	"context"
+
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+)
+
+const (
+	// SubnetUUIDAnnotationKey is the annotation key where the subnet UUID is stored.
+	SubnetUUIDAnnotationKey = "cloudscale.crossplane.io/subnet-uuid"
+)
+
+// SubnetPipeline provisions Subnets on cloudscale.ch
+type SubnetPipeline struct {
+	recorder event.Recorder
+	csClient *cloudscalesdk.Client
+}
+
+type pipelineContext struct {
+	context.Context
+	subnet   *cloudscalev1.Subnet
+	csSubnet *cloudscalesdk.Subnet
+}
+
+// ParentContext implements tracing.Context.
+func (c *pipelineContext) ParentContext() context.Context {
+	return c.Context
+}
+
+// SetParentContext implements tracing.Context.
+func (c *pipelineContext) SetParentContext(ctx context.Context) {
+	c.Context = ctx
+}
+
+// NewPipeline returns a new instance of SubnetPipeline.
+func NewPipeline(recorder event.Recorder, csClient *cloudscalesdk.Client) *SubnetPipeline {
+	return &SubnetPipeline{
+		recorder: recorder,
+		csClient: csClient,
+	}
+}
+
+// Disconnect implements managed.ExternalClient.
+func (p *SubnetPipeline) Disconnect(_ context.Context) error {
+	return nil
+}
+
+func isSubnetMissing(ctx *pipelineContext) bool {
+	return ctx.csSubnet == nil
+}
+
+func fromManaged(mg resource.Managed) *cloudscalev1.Subnet {
+	return mg.(*cloudscalev1.Subnet)
+}
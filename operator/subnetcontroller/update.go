@@ -0,0 +1,56 @@
+package subnetcontroller
+
+import (
+	"context"
+
+	pipeline "github.com/ccremer/go-command-pipeline"
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	"github.com/vshn/provider-cloudscale/operator/pipelineutil"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Update implements managed.ExternalClient.
+func (p *SubnetPipeline) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.Info("Updating resource")
+	subnet := fromManaged(mg)
+
+	pctx := &pipelineContext{Context: ctx, subnet: subnet}
+	pipe := pipeline.NewPipeline[*pipelineContext]()
+	stepMetrics := pipelineutil.NewStepMetrics[*pipelineContext](cloudscalev1.SubnetKind)
+	pipe.WithBeforeHooks(pipelineutil.DebugLogger(pctx)).
+		WithSteps(stepMetrics.Steps(
+			pipe.NewStep("update subnet", p.updateSubnet),
+		)...)
+	err := pipe.RunWithContext(pctx)
+
+	return managed.ExternalUpdate{}, errors.Wrap(err, "cannot update subnet")
+}
+
+// updateSubnet updates the gateway, DNS servers and tags of the subnet identified by UUID.
+func (p *SubnetPipeline) updateSubnet(ctx *pipelineContext) error {
+	csClient := p.csClient
+	log := controllerruntime.LoggerFrom(ctx)
+	subnet := ctx.subnet
+	params := subnet.Spec.ForProvider
+	uuid := subnet.Status.AtProvider.SubnetUUID
+	tags := cloudscaleclient.DesiredTags(params.Tags, subnet.UID)
+
+	err := csClient.Subnets.Update(ctx, uuid, &cloudscalesdk.SubnetUpdateRequest{
+		TaggedResourceRequest: cloudscalesdk.TaggedResourceRequest{
+			Tags: cloudscaleclient.ToTagMap(tags),
+		},
+		GatewayAddress: params.GatewayAddress,
+		DNSServers:     params.DNSServers,
+	})
+	if err != nil {
+		return err
+	}
+	log.V(1).Info("Updated subnet in cloudscale", "uuid", uuid, "gateway", params.GatewayAddress, "dnsServers", params.DNSServers, "tags", tags)
+	return nil
+}
@@ -0,0 +1,40 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// Reconciler is a reconcile.Reconciler that records a span for each reconciliation.
+// The span is stored in the context passed to the wrapped reconciler, so that nested spans become children of it.
+type Reconciler struct {
+	kind       string
+	reconciler reconcile.Reconciler
+}
+
+// NewReconciler returns a new Reconciler that wraps the given reconciler for the given kind of resource.
+func NewReconciler(kind string, r reconcile.Reconciler) *Reconciler {
+	return &Reconciler{kind: kind, reconciler: r}
+}
+
+// Reconcile implements reconcile.Reconciler.
+func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	ctx, span := otel.Tracer(TracerName).Start(ctx, "reconcile "+r.kind, trace.WithAttributes(
+		attribute.String("kind", r.kind),
+		attribute.String("name", req.Name),
+		attribute.String("namespace", req.Namespace),
+	))
+	defer span.End()
+
+	result, err := r.reconciler.Reconcile(ctx, req)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return result, err
+}
@@ -0,0 +1,37 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Context is a context whose parent can be replaced, like the contexts passed through the reconcile pipelines.
+type Context interface {
+	context.Context
+	// ParentContext returns the parent context.
+	ParentContext() context.Context
+	// SetParentContext replaces the parent context.
+	SetParentContext(ctx context.Context)
+}
+
+// RunInSpan records a span with the given name while invoking fn.
+// The context of the span is set on ctx until fn returns, so that the spans started within fn, e.g. by API calls, become children of it.
+// An error returned by fn is recorded on the span and returned unchanged.
+func RunInSpan[T Context](ctx T, name string, fn func(T) error, opts ...trace.SpanStartOption) error {
+	parent := ctx.ParentContext()
+	spanCtx, span := otel.Tracer(TracerName).Start(parent, name, opts...)
+	defer span.End()
+
+	ctx.SetParentContext(spanCtx)
+	defer ctx.SetParentContext(parent)
+
+	err := fn(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return err
+}
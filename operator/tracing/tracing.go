@@ -0,0 +1,60 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// TracerName is the name of the OpenTelemetry tracer used throughout the provider.
+const TracerName = "github.com/vshn/provider-cloudscale"
+
+// Options configure the trace provider.
+type Options struct {
+	// Endpoint is the URL of the OTLP/HTTP collector, e.g. `http://localhost:4318`.
+	// If empty, tracing is disabled.
+	Endpoint string
+	// SamplingRatio is the ratio of reconciliations that are traced, between 0 and 1.
+	SamplingRatio float64
+	// ServiceName is the name under which the spans are reported.
+	ServiceName string
+	// ServiceVersion is the version of the service.
+	ServiceVersion string
+}
+
+// Setup configures the global trace provider to export spans to an OTLP collector.
+// The returned function flushes the remaining spans and stops the trace provider.
+// If no endpoint is configured, the global no-op trace provider remains in place and the returned function does nothing.
+func Setup(ctx context.Context, opts Options) (func(context.Context) error, error) {
+	if opts.Endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+	if opts.SamplingRatio < 0 || opts.SamplingRatio > 1 {
+		return nil, fmt.Errorf("sampling ratio must be between 0 and 1: %v", opts.SamplingRatio)
+	}
+	exporter, err := otlptracehttp.New(ctx, otlptracehttp.WithEndpointURL(opts.Endpoint))
+	if err != nil {
+		return nil, fmt.Errorf("cannot create OTLP exporter: %w", err)
+	}
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(semconv.SchemaURL,
+		semconv.ServiceName(opts.ServiceName),
+		semconv.ServiceVersion(opts.ServiceVersion),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("cannot create trace resource: %w", err)
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(opts.SamplingRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	return provider.Shutdown, nil
+}
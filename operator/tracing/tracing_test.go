@@ -0,0 +1,131 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestSetup(t *testing.T) {
+	tests := map[string]struct {
+		givenOptions  Options
+		expectedError string
+	}{
+		"GivenNoEndpoint_ThenExpectNoop": {
+			givenOptions: Options{SamplingRatio: 5},
+		},
+		"GivenEndpoint_ThenExpectProvider": {
+			givenOptions: Options{Endpoint: "http://localhost:4318", SamplingRatio: 0.5, ServiceName: "test"},
+		},
+		"GivenEndpoint_WhenInvalidRatio_ThenExpectError": {
+			givenOptions:  Options{Endpoint: "http://localhost:4318", SamplingRatio: 1.5},
+			expectedError: "sampling ratio must be between 0 and 1: 1.5",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer otel.SetTracerProvider(otel.GetTracerProvider())
+			shutdown, err := Setup(context.Background(), tc.givenOptions)
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.NoError(t, shutdown(context.Background()))
+		})
+	}
+}
+
+type reconcilerFunc func(ctx context.Context, req reconcile.Request) (reconcile.Result, error)
+
+func (f reconcilerFunc) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	return f(ctx, req)
+}
+
+func TestReconciler_Reconcile(t *testing.T) {
+	tests := map[string]struct {
+		givenError     error
+		expectedStatus codes.Code
+	}{
+		"GivenSuccessfulReconcile_ThenExpectUnsetStatus": {
+			expectedStatus: codes.Unset,
+		},
+		"GivenFailedReconcile_ThenExpectErrorStatus": {
+			givenError:     errors.New("error"),
+			expectedStatus: codes.Error,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			recorder := tracetest.NewSpanRecorder()
+			defer otel.SetTracerProvider(otel.GetTracerProvider())
+			otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+			var spanInReconcile trace.SpanContext
+			r := NewReconciler("Kind", reconcilerFunc(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+				spanInReconcile = trace.SpanContextFromContext(ctx)
+				return reconcile.Result{}, tc.givenError
+			}))
+			_, _ = r.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "name"}})
+
+			spans := recorder.Ended()
+			require.Len(t, spans, 1)
+			assert.Equal(t, "reconcile Kind", spans[0].Name())
+			assert.Equal(t, tc.expectedStatus, spans[0].Status().Code)
+			assert.Equal(t, spans[0].SpanContext().SpanID(), spanInReconcile.SpanID(), "span propagated in context")
+		})
+	}
+}
+
+type pipelineContext struct {
+	context.Context
+}
+
+func (c *pipelineContext) ParentContext() context.Context {
+	return c.Context
+}
+
+func (c *pipelineContext) SetParentContext(ctx context.Context) {
+	c.Context = ctx
+}
+
+func TestRunInSpan(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	defer otel.SetTracerProvider(otel.GetTracerProvider())
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	r := NewReconciler("Kind", reconcilerFunc(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+		pctx := &pipelineContext{Context: ctx}
+		err := RunInSpan(pctx, "step", func(ctx *pipelineContext) error {
+			return RunInSpan(ctx, "nested step", func(ctx *pipelineContext) error {
+				_, span := otel.Tracer(TracerName).Start(ctx, "API call")
+				span.End()
+				return errors.New("error")
+			})
+		})
+		assert.Same(t, ctx, pctx.Context, "parent context restored")
+		return reconcile.Result{}, err
+	}))
+	_, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "name"}})
+	require.EqualError(t, err, "error")
+
+	spans := map[string]sdktrace.ReadOnlySpan{}
+	for _, span := range recorder.Ended() {
+		spans[span.Name()] = span
+	}
+	require.Len(t, spans, 4)
+	assert.Equal(t, spans["reconcile Kind"].SpanContext().SpanID(), spans["step"].Parent().SpanID(), "step is child of reconciliation")
+	assert.Equal(t, spans["step"].SpanContext().SpanID(), spans["nested step"].Parent().SpanID(), "nested step is child of step")
+	assert.Equal(t, spans["nested step"].SpanContext().SpanID(), spans["API call"].Parent().SpanID(), "API call is child of nested step")
+	assert.Equal(t, codes.Error, spans["nested step"].Status().Code)
+}
@@ -0,0 +1,130 @@
+package volumecontroller
+
+import (
+	"context"
+	"fmt"
+
+	pipeline "github.com/ccremer/go-command-pipeline"
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	"github.com/vshn/provider-cloudscale/operator/pipelineutil"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Create implements managed.ExternalClient.
+func (p *VolumePipeline) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.Info("Creating resource")
+
+	volume := fromManaged(mg)
+	if volume.Status.AtProvider.VolumeUUID != "" {
+		// Volume already exists
+		return managed.ExternalCreation{}, nil
+	}
+
+	pctx := &pipelineContext{Context: ctx, volume: volume}
+	pipe := pipeline.NewPipeline[*pipelineContext]()
+	stepMetrics := pipelineutil.NewStepMetrics[*pipelineContext](cloudscalev1.VolumeKind)
+	pipe.WithBeforeHooks(pipelineutil.DebugLogger(pctx)).
+		WithSteps(stepMetrics.Steps(
+			pipe.NewStep("find volume by owner tag", p.findVolumeByOwnerTag),
+			pipe.When(isVolumeMissing, "create volume", p.createVolume),
+			pipe.NewStep("set volume UUID annotation", p.setVolumeUUIDAnnotation),
+			pipe.NewStep("emit event", p.emitCreationEvent),
+		)...)
+	err := pipe.RunWithContext(pctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, "cannot create volume")
+	}
+
+	return managed.ExternalCreation{}, nil
+}
+
+// findVolumeByOwnerTag searches for a volume that has been created by a previous reconciliation of this resource.
+// See servercontroller for why this is needed.
+// If exactly one volume is found, it is adopted.
+func (p *VolumePipeline) findVolumeByOwnerTag(ctx *pipelineContext) error {
+	csClient := p.csClient
+	log := controllerruntime.LoggerFrom(ctx)
+	volume := ctx.volume
+
+	if volume.UID == "" {
+		return nil
+	}
+	csVolumes, err := csClient.Volumes.List(ctx, cloudscalesdk.WithTagFilter(cloudscalesdk.TagMap{cloudscaleclient.OwnerTagKey: string(volume.UID)}))
+	if err != nil {
+		return errors.Wrap(err, "cannot list volumes by owner tag")
+	}
+	switch len(csVolumes) {
+	case 0:
+		return nil
+	case 1:
+		ctx.csVolume = &csVolumes[0]
+		log.V(1).Info("Adopting existing volume in cloudscale", "uuid", ctx.csVolume.UUID, "name", ctx.csVolume.Name)
+		return nil
+	default:
+		return fmt.Errorf("found %d volumes with tag %s=%s, expected at most one", len(csVolumes), cloudscaleclient.OwnerTagKey, volume.UID)
+	}
+}
+
+// createVolume creates a new volume in the project associated with the API token.
+// If a source snapshot is given, the volume is restored from the snapshot instead.
+// The restored volume is grown to the desired size by the next update, if necessary.
+func (p *VolumePipeline) createVolume(ctx *pipelineContext) error {
+	csClient := p.csClient
+	log := controllerruntime.LoggerFrom(ctx)
+	volume := ctx.volume
+	params := volume.Spec.ForProvider
+
+	req := &cloudscalesdk.VolumeRequest{
+		Name: volume.GetVolumeName(),
+		TaggedResourceRequest: cloudscalesdk.TaggedResourceRequest{
+			Tags: cloudscaleclient.ToTagMap(cloudscaleclient.DesiredTags(params.Tags, volume.UID)),
+		},
+	}
+	if len(params.ServerUUIDs) > 0 {
+		req.ServerUUIDs = &params.ServerUUIDs
+	}
+
+	if snapshotUUID := params.SourceSnapshotUUID; snapshotUUID != "" {
+		// Size, type and zone are given by the snapshot.
+		csVolume, err := p.snapshots.Restore(ctx, snapshotUUID, req)
+		if err != nil {
+			return err
+		}
+		log.V(1).Info("Restored volume from snapshot in cloudscale", "uuid", csVolume.UUID, "name", csVolume.Name, "snapshot", snapshotUUID)
+		ctx.csVolume = csVolume
+		return nil
+	}
+
+	req.SizeGB = params.SizeGB
+	req.Type = params.Type
+	req.Zone = params.Zone
+	csVolume, err := csClient.Volumes.Create(ctx, req)
+	if err != nil {
+		return err
+	}
+	log.V(1).Info("Created volume in cloudscale", "uuid", csVolume.UUID, "name", csVolume.Name)
+	ctx.csVolume = csVolume
+	return nil
+}
+
+// setVolumeUUIDAnnotation stores the UUID of the created or adopted volume in an annotation.
+func (p *VolumePipeline) setVolumeUUIDAnnotation(ctx *pipelineContext) error {
+	cloudscaleclient.SetIDAnnotation(&ctx.volume.ObjectMeta, VolumeUUIDAnnotationKey, ctx.csVolume.UUID)
+	return nil
+}
+
+func (p *VolumePipeline) emitCreationEvent(ctx *pipelineContext) error {
+	p.recorder.Event(ctx.volume, event.Event{
+		Type:    event.TypeNormal,
+		Reason:  "Created",
+		Message: "Volume successfully created",
+	})
+	return nil
+}
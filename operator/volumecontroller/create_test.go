@@ -0,0 +1,91 @@
+package volumecontroller
+
+import (
+	"context"
+	"testing"
+
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	"github.com/vshn/provider-cloudscale/operator/operatortest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Create implements cloudscalesdk.VolumeService.
+func (f *fakeVolumeService) Create(_ context.Context, createRequest *cloudscalesdk.VolumeRequest) (*cloudscalesdk.Volume, error) {
+	f.givenRequests = append(f.givenRequests, createRequest)
+	return &cloudscalesdk.Volume{UUID: "created"}, nil
+}
+
+type fakeVolumeSnapshotService struct {
+	cloudscaleclient.VolumeSnapshotService
+	givenSnapshotUUID string
+	givenRequest      *cloudscalesdk.VolumeRequest
+}
+
+// Restore implements cloudscaleclient.VolumeSnapshotService.
+func (f *fakeVolumeSnapshotService) Restore(_ context.Context, snapshotID string, createRequest *cloudscalesdk.VolumeRequest) (*cloudscalesdk.Volume, error) {
+	f.givenSnapshotUUID = snapshotID
+	f.givenRequest = createRequest
+	return &cloudscalesdk.Volume{UUID: "restored"}, nil
+}
+
+func TestVolumePipeline_createVolume(t *testing.T) {
+	tests := map[string]struct {
+		givenParams cloudscalev1.VolumeParameters
+
+		expectedVolumeUUID   string
+		expectedCreate       *cloudscalesdk.VolumeRequest
+		expectedSnapshotUUID string
+	}{
+		"GivenNoSnapshot_ThenExpectVolumeCreated": {
+			givenParams:        cloudscalev1.VolumeParameters{SizeGB: 50, Type: "ssd", Zone: "rma1"},
+			expectedVolumeUUID: "created",
+			expectedCreate: &cloudscalesdk.VolumeRequest{
+				Name:                  "my-volume",
+				SizeGB:                50,
+				Type:                  "ssd",
+				ZonalResourceRequest:  cloudscalesdk.ZonalResourceRequest{Zone: "rma1"},
+				TaggedResourceRequest: cloudscalesdk.TaggedResourceRequest{Tags: &cloudscalesdk.TagMap{"cloudscale.crossplane.io/owner-uid": "uid"}},
+			},
+		},
+		"GivenSnapshot_ThenExpectVolumeRestored": {
+			givenParams:          cloudscalev1.VolumeParameters{SizeGB: 50, Type: "ssd", Zone: "rma1", SourceSnapshotUUID: "snapshot"},
+			expectedVolumeUUID:   "restored",
+			expectedSnapshotUUID: "snapshot",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			svc := &fakeVolumeService{}
+			snapshots := &fakeVolumeSnapshotService{}
+			p := NewPipeline(&operatortest.Recorder{}, &cloudscalesdk.Client{Volumes: svc}, snapshots)
+			ctx := &pipelineContext{
+				Context: logr.NewContext(context.Background(), logr.Discard()),
+				volume: &cloudscalev1.Volume{
+					ObjectMeta: metav1.ObjectMeta{Name: "my-volume", UID: "uid"},
+					Spec:       cloudscalev1.VolumeSpec{ForProvider: tc.givenParams},
+				},
+			}
+
+			err := p.createVolume(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedVolumeUUID, ctx.csVolume.UUID)
+			if tc.expectedCreate != nil {
+				require.Len(t, svc.givenRequests, 1)
+				assert.Equal(t, tc.expectedCreate, svc.givenRequests[0])
+			} else {
+				assert.Empty(t, svc.givenRequests)
+			}
+			assert.Equal(t, tc.expectedSnapshotUUID, snapshots.givenSnapshotUUID)
+			if tc.expectedSnapshotUUID != "" {
+				assert.Equal(t, "my-volume", snapshots.givenRequest.Name)
+				assert.Zero(t, snapshots.givenRequest.SizeGB, "size is given by the snapshot")
+				assert.Empty(t, snapshots.givenRequest.Zone, "zone is given by the snapshot")
+			}
+		})
+	}
+}
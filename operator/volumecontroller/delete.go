@@ -0,0 +1,82 @@
+package volumecontroller
+
+import (
+	"context"
+	"fmt"
+
+	pipeline "github.com/ccremer/go-command-pipeline"
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	"github.com/vshn/provider-cloudscale/operator/pipelineutil"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Delete implements managed.ExternalClient.
+func (p *VolumePipeline) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.Info("Deleting resource")
+
+	volume := fromManaged(mg)
+	pctx := &pipelineContext{Context: ctx, volume: volume}
+	pipe := pipeline.NewPipeline[*pipelineContext]()
+	stepMetrics := pipelineutil.NewStepMetrics[*pipelineContext](cloudscalev1.VolumeKind)
+	pipe.WithBeforeHooks(pipelineutil.DebugLogger(pctx)).
+		WithSteps(stepMetrics.Steps(
+			pipe.When(isAttached, "detach volume", p.detachVolume),
+			pipe.NewStep("delete volume", p.deleteVolume),
+			pipe.NewStep("emit event", p.emitDeletionEvent),
+		)...)
+	err := pipe.RunWithContext(pctx)
+	return managed.ExternalDelete{}, errors.Wrap(err, "cannot deprovision volume")
+}
+
+func isAttached(ctx *pipelineContext) bool {
+	return len(ctx.volume.Status.AtProvider.ServerUUIDs) > 0
+}
+
+// detachVolume detaches the volume from all servers if the deletion policy allows it.
+func (p *VolumePipeline) detachVolume(ctx *pipelineContext) error {
+	csClient := p.csClient
+	log := controllerruntime.LoggerFrom(ctx)
+	volume := ctx.volume
+	uuid := volume.Status.AtProvider.VolumeUUID
+
+	if volume.Spec.ForProvider.VolumeDeletionPolicy != cloudscalev1.DetachAndDelete {
+		return fmt.Errorf("volume is attached to servers %v, detach it first or set volumeDeletionPolicy to %s",
+			volume.Status.AtProvider.ServerUUIDs, cloudscalev1.DetachAndDelete)
+	}
+	err := csClient.Volumes.Update(ctx, uuid, &cloudscalesdk.VolumeRequest{ServerUUIDs: &[]string{}})
+	if err != nil {
+		return resource.Ignore(cloudscaleclient.IsNotFound, err)
+	}
+	log.V(1).Info("Detached volume in cloudscale", "uuid", uuid, "serverUUIDs", volume.Status.AtProvider.ServerUUIDs)
+	return nil
+}
+
+// deleteVolume deletes the volume.
+func (p *VolumePipeline) deleteVolume(ctx *pipelineContext) error {
+	csClient := p.csClient
+	log := controllerruntime.LoggerFrom(ctx)
+	uuid := ctx.volume.Status.AtProvider.VolumeUUID
+
+	err := csClient.Volumes.Delete(ctx, uuid)
+	if err != nil {
+		return resource.Ignore(cloudscaleclient.IsNotFound, err)
+	}
+	log.V(1).Info("Deleted volume in cloudscale", "uuid", uuid)
+	return nil
+}
+
+func (p *VolumePipeline) emitDeletionEvent(ctx *pipelineContext) error {
+	p.recorder.Event(ctx.volume, event.Event{
+		Type:    event.TypeNormal,
+		Reason:  "Deleted",
+		Message: "Volume deleted",
+	})
+	return nil
+}
@@ -0,0 +1,64 @@
+package volumecontroller
+
+import (
+	"context"
+	"testing"
+
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/operatortest"
+)
+
+func TestVolumePipeline_Delete(t *testing.T) {
+	tests := map[string]struct {
+		givenPolicy      cloudscalev1.VolumeDeletionPolicy
+		givenServerUUIDs []string
+
+		expectedDetached bool
+		expectedDeleted  bool
+		expectedError    string
+	}{
+		"GivenDetachedVolume_ThenExpectDeleted": {
+			givenPolicy:     cloudscalev1.DeleteIfDetached,
+			expectedDeleted: true,
+		},
+		"GivenAttachedVolume_WhenDeleteIfDetached_ThenExpectError": {
+			givenPolicy:      cloudscalev1.DeleteIfDetached,
+			givenServerUUIDs: []string{"server"},
+			expectedError:    "cannot deprovision volume: step 'detach volume' failed: volume is attached to servers [server], detach it first or set volumeDeletionPolicy to DetachAndDelete",
+		},
+		"GivenAttachedVolume_WhenDetachAndDelete_ThenExpectDetachedAndDeleted": {
+			givenPolicy:      cloudscalev1.DetachAndDelete,
+			givenServerUUIDs: []string{"server"},
+			expectedDetached: true,
+			expectedDeleted:  true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			svc := &fakeVolumeService{}
+			p := NewPipeline(&operatortest.Recorder{}, &cloudscalesdk.Client{Volumes: svc}, nil)
+			volume := &cloudscalev1.Volume{
+				Spec:   cloudscalev1.VolumeSpec{ForProvider: cloudscalev1.VolumeParameters{VolumeDeletionPolicy: tc.givenPolicy}},
+				Status: cloudscalev1.VolumeStatus{AtProvider: cloudscalev1.VolumeObservation{VolumeUUID: "uuid", ServerUUIDs: tc.givenServerUUIDs}},
+			}
+
+			_, err := p.Delete(logr.NewContext(context.Background(), logr.Discard()), volume)
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+			if tc.expectedDetached {
+				require.Len(t, svc.givenRequests, 1)
+				assert.Equal(t, &[]string{}, svc.givenRequests[0].ServerUUIDs)
+			} else {
+				assert.Empty(t, svc.givenRequests)
+			}
+			assert.Equal(t, tc.expectedDeleted, svc.deleted)
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package volumecontroller
+
+import (
+	"context"
+
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Observe implements managed.ExternalClient.
+func (p *VolumePipeline) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.V(1).Info("Observing resource")
+
+	volume := fromManaged(mg)
+	if volume.Status.AtProvider.VolumeUUID == "" {
+		if uuid, exists := cloudscaleclient.IDFromAnnotation(volume, VolumeUUIDAnnotationKey); exists {
+			volume.Status.AtProvider.VolumeUUID = uuid
+		} else {
+			// New resource, create volume first
+			return managed.ExternalObservation{}, nil
+		}
+	}
+
+	pctx := &pipelineContext{Context: ctx, volume: volume}
+	err := p.getVolume(pctx)
+	if err != nil {
+		return managed.ExternalObservation{}, resource.Ignore(cloudscaleclient.IsNotFound, err)
+	}
+
+	csVolume := pctx.csVolume
+	volume.Status.AtProvider = toObservation(csVolume, volume.Status.AtProvider.Project)
+	volume.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: isUpToDate(volume, csVolume),
+	}, nil
+}
+
+// getVolume fetches an existing volume from the project associated with the API token.
+func (p *VolumePipeline) getVolume(ctx *pipelineContext) error {
+	csClient := p.csClient
+	log := controllerruntime.LoggerFrom(ctx)
+
+	csVolume, err := csClient.Volumes.Get(ctx, ctx.volume.Status.AtProvider.VolumeUUID)
+	if err != nil {
+		return err
+	}
+	ctx.csVolume = csVolume
+	log.V(1).Info("Fetched volume in cloudscale", "uuid", csVolume.UUID, "name", csVolume.Name)
+	return nil
+}
+
+// isUpToDate returns true if the updatable fields of the volume match the spec.
+// Fields that can't be changed after creation are ignored.
+func isUpToDate(volume *cloudscalev1.Volume, csVolume *cloudscalesdk.Volume) bool {
+	params := volume.Spec.ForProvider
+	return volume.GetVolumeName() == csVolume.Name &&
+		params.SizeGB == csVolume.SizeGB &&
+		!cloudscaleclient.TagsNeedUpdate(cloudscaleclient.DesiredTags(params.Tags, volume.UID), csVolume.Tags) &&
+		serverUUIDsEqual(params.ServerUUIDs, volume.Status.AtProvider.ServerUUIDs)
+}
+
+// toObservation returns the observed fields of the given volume.
+func toObservation(csVolume *cloudscalesdk.Volume, project string) cloudscalev1.VolumeObservation {
+	obs := cloudscalev1.VolumeObservation{
+		VolumeUUID: csVolume.UUID,
+		VolumeName: csVolume.Name,
+		SizeGB:     csVolume.SizeGB,
+		Type:       csVolume.Type,
+		Zone:       csVolume.Zone.Slug,
+		Tags:       cloudscaleclient.FromTagMap(csVolume.Tags),
+		Project:    project,
+	}
+	if csVolume.ServerUUIDs != nil {
+		obs.ServerUUIDs = *csVolume.ServerUUIDs
+	}
+	return obs
+}
@@ -0,0 +1,70 @@
+package volumecontroller
+
+import (
+	"context"
+	"slices"
+
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+)
+
+const (
+	// VolumeUUIDAnnotationKey is the annotation key where the volume UUID is stored.
+	VolumeUUIDAnnotationKey = "cloudscale.crossplane.io/volume-uuid"
+)
+
+// VolumePipeline provisions Volumes on cloudscale.ch
+type VolumePipeline struct {
+	recorder  event.Recorder
+	csClient  *cloudscalesdk.Client
+	snapshots cloudscaleclient.VolumeSnapshotService
+}
+
+type pipelineContext struct {
+	context.Context
+	volume   *cloudscalev1.Volume
+	csVolume *cloudscalesdk.Volume
+}
+
+// ParentContext implements tracing.Context.
+func (c *pipelineContext) ParentContext() context.Context {
+	return c.Context
+}
+
+// SetParentContext implements tracing.Context.
+func (c *pipelineContext) SetParentContext(ctx context.Context) {
+	c.Context = ctx
+}
+
+// NewPipeline returns a new instance of VolumePipeline.
+func NewPipeline(recorder event.Recorder, csClient *cloudscalesdk.Client, snapshots cloudscaleclient.VolumeSnapshotService) *VolumePipeline {
+	return &VolumePipeline{
+		recorder:  recorder,
+		csClient:  csClient,
+		snapshots: snapshots,
+	}
+}
+
+// Disconnect implements managed.ExternalClient.
+func (p *VolumePipeline) Disconnect(_ context.Context) error {
+	return nil
+}
+
+func isVolumeMissing(ctx *pipelineContext) bool {
+	return ctx.csVolume == nil
+}
+
+// serverUUIDsEqual returns true if both lists contain the same server UUIDs, regardless of the order.
+func serverUUIDsEqual(a, b []string) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(a, b)
+}
+
+func fromManaged(mg resource.Managed) *cloudscalev1.Volume {
+	return mg.(*cloudscalev1.Volume)
+}
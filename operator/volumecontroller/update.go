@@ -0,0 +1,76 @@
+package volumecontroller
+
+import (
+	"context"
+	"fmt"
+
+	pipeline "github.com/ccremer/go-command-pipeline"
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	"github.com/vshn/provider-cloudscale/operator/pipelineutil"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Update implements managed.ExternalClient.
+func (p *VolumePipeline) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.Info("Updating resource")
+	volume := fromManaged(mg)
+
+	pctx := &pipelineContext{Context: ctx, volume: volume}
+	pipe := pipeline.NewPipeline[*pipelineContext]()
+	stepMetrics := pipelineutil.NewStepMetrics[*pipelineContext](cloudscalev1.VolumeKind)
+	pipe.WithBeforeHooks(pipelineutil.DebugLogger(pctx)).
+		WithSteps(stepMetrics.Steps(
+			pipe.NewStep("check size", checkSize),
+			pipe.NewStep("update volume", p.updateVolume),
+		)...)
+	err := pipe.RunWithContext(pctx)
+
+	return managed.ExternalUpdate{}, errors.Wrap(err, "cannot update volume")
+}
+
+// checkSize returns an error if the desired size is smaller than the observed size, since volumes can't be shrunk.
+// The webhook rejects such changes already, but the volume may have been grown outside of Kubernetes.
+func checkSize(ctx *pipelineContext) error {
+	desired, observed := ctx.volume.Spec.ForProvider.SizeGB, ctx.volume.Status.AtProvider.SizeGB
+	if desired < observed {
+		return fmt.Errorf("volume cannot be shrunk from %d GB to %d GB", observed, desired)
+	}
+	return nil
+}
+
+// updateVolume updates the name, size, tags and attached servers of the volume identified by UUID.
+// The size is only sent if it has grown, which resizes the volume online.
+func (p *VolumePipeline) updateVolume(ctx *pipelineContext) error {
+	csClient := p.csClient
+	log := controllerruntime.LoggerFrom(ctx)
+	volume := ctx.volume
+	params := volume.Spec.ForProvider
+	uuid := volume.Status.AtProvider.VolumeUUID
+	tags := cloudscaleclient.DesiredTags(params.Tags, volume.UID)
+
+	req := &cloudscalesdk.VolumeRequest{
+		Name: volume.GetVolumeName(),
+		TaggedResourceRequest: cloudscalesdk.TaggedResourceRequest{
+			Tags: cloudscaleclient.ToTagMap(tags),
+		},
+	}
+	if params.SizeGB > volume.Status.AtProvider.SizeGB {
+		req.SizeGB = params.SizeGB
+	}
+	if !serverUUIDsEqual(params.ServerUUIDs, volume.Status.AtProvider.ServerUUIDs) {
+		// An empty list detaches the volume from all servers, so it must not be null.
+		serverUUIDs := append([]string{}, params.ServerUUIDs...)
+		req.ServerUUIDs = &serverUUIDs
+	}
+	if err := csClient.Volumes.Update(ctx, uuid, req); err != nil {
+		return err
+	}
+	log.V(1).Info("Updated volume in cloudscale", "uuid", uuid, "name", volume.GetVolumeName(), "sizeGB", req.SizeGB, "tags", tags)
+	return nil
+}
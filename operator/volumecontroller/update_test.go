@@ -0,0 +1,92 @@
+package volumecontroller
+
+import (
+	"context"
+	"testing"
+
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/operatortest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type fakeVolumeService struct {
+	cloudscalesdk.VolumeService
+	givenRequests []*cloudscalesdk.VolumeRequest
+	deleted       bool
+}
+
+// Update implements cloudscalesdk.VolumeService.
+func (f *fakeVolumeService) Update(_ context.Context, _ string, updateRequest *cloudscalesdk.VolumeRequest) error {
+	f.givenRequests = append(f.givenRequests, updateRequest)
+	return nil
+}
+
+// Delete implements cloudscalesdk.VolumeService.
+func (f *fakeVolumeService) Delete(_ context.Context, _ string) error {
+	f.deleted = true
+	return nil
+}
+
+func TestVolumePipeline_Update(t *testing.T) {
+	tests := map[string]struct {
+		givenParams cloudscalev1.VolumeParameters
+		givenStatus cloudscalev1.VolumeObservation
+
+		expectedSizeGB      int
+		expectedServerUUIDs *[]string
+		expectedError       string
+	}{
+		"GivenLargerSize_ThenExpectResize": {
+			givenParams:    cloudscalev1.VolumeParameters{SizeGB: 100},
+			givenStatus:    cloudscalev1.VolumeObservation{SizeGB: 50},
+			expectedSizeGB: 100,
+		},
+		"GivenSmallerSize_ThenExpectError": {
+			givenParams:   cloudscalev1.VolumeParameters{SizeGB: 50},
+			givenStatus:   cloudscalev1.VolumeObservation{SizeGB: 100},
+			expectedError: "cannot update volume: step 'check size' failed: volume cannot be shrunk from 100 GB to 50 GB",
+		},
+		"GivenSameServers_ThenExpectNoAttachment": {
+			givenParams: cloudscalev1.VolumeParameters{SizeGB: 50, ServerUUIDs: []string{"server1", "server2"}},
+			givenStatus: cloudscalev1.VolumeObservation{SizeGB: 50, ServerUUIDs: []string{"server2", "server1"}},
+		},
+		"GivenNewServer_ThenExpectAttached": {
+			givenParams:         cloudscalev1.VolumeParameters{SizeGB: 50, ServerUUIDs: []string{"server"}},
+			givenStatus:         cloudscalev1.VolumeObservation{SizeGB: 50},
+			expectedServerUUIDs: &[]string{"server"},
+		},
+		"GivenNoServers_WhenAttached_ThenExpectDetached": {
+			givenParams:         cloudscalev1.VolumeParameters{SizeGB: 50},
+			givenStatus:         cloudscalev1.VolumeObservation{SizeGB: 50, ServerUUIDs: []string{"server"}},
+			expectedServerUUIDs: &[]string{},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			svc := &fakeVolumeService{}
+			p := NewPipeline(&operatortest.Recorder{}, &cloudscalesdk.Client{Volumes: svc}, nil)
+			tc.givenStatus.VolumeUUID = "uuid"
+			volume := &cloudscalev1.Volume{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-volume"},
+				Spec:       cloudscalev1.VolumeSpec{ForProvider: tc.givenParams},
+				Status:     cloudscalev1.VolumeStatus{AtProvider: tc.givenStatus},
+			}
+
+			_, err := p.Update(logr.NewContext(context.Background(), logr.Discard()), volume)
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				assert.Empty(t, svc.givenRequests)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, svc.givenRequests, 1)
+			assert.Equal(t, "my-volume", svc.givenRequests[0].Name)
+			assert.Equal(t, tc.expectedSizeGB, svc.givenRequests[0].SizeGB)
+			assert.Equal(t, tc.expectedServerUUIDs, svc.givenRequests[0].ServerUUIDs)
+		})
+	}
+}
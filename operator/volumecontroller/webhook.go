@@ -0,0 +1,54 @@
+package volumecontroller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// VolumeValidator validates admission requests.
+type VolumeValidator struct {
+	log logr.Logger
+}
+
+// ValidateCreate implements admission.CustomValidator.
+func (v *VolumeValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	res := obj.(*cloudscalev1.Volume)
+	v.log.V(1).Info("Validate create (noop)", "name", res.Name)
+	return nil, nil
+}
+
+// ValidateUpdate implements admission.CustomValidator.
+func (v *VolumeValidator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	newVolume := newObj.(*cloudscalev1.Volume)
+	oldVolume := oldObj.(*cloudscalev1.Volume)
+	v.log.V(1).Info("Validate update")
+
+	newSize := newVolume.Spec.ForProvider.SizeGB
+	if oldSize := max(oldVolume.Spec.ForProvider.SizeGB, oldVolume.Status.AtProvider.SizeGB); newSize < oldSize {
+		return nil, fmt.Errorf("volume cannot be shrunk from %d GB to %d GB", oldSize, newSize)
+	}
+	if oldVolume.Status.AtProvider.VolumeUUID != "" {
+		if newVolume.Spec.ForProvider.Type != oldVolume.Spec.ForProvider.Type {
+			return nil, fmt.Errorf("volume %q has been created already, you cannot change the type", oldVolume.Status.AtProvider.VolumeUUID)
+		}
+		if newVolume.Spec.ForProvider.Zone != oldVolume.Spec.ForProvider.Zone {
+			return nil, fmt.Errorf("volume %q has been created already, you cannot change the zone", oldVolume.Status.AtProvider.VolumeUUID)
+		}
+		if newVolume.Spec.ForProvider.SourceSnapshotUUID != oldVolume.Spec.ForProvider.SourceSnapshotUUID {
+			return nil, fmt.Errorf("volume %q has been created already, you cannot change the source snapshot", oldVolume.Status.AtProvider.VolumeUUID)
+		}
+	}
+	return nil, nil
+}
+
+// ValidateDelete implements admission.CustomValidator.
+func (v *VolumeValidator) ValidateDelete(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	res := obj.(*cloudscalev1.Volume)
+	v.log.V(1).Info("Validate delete (noop)", "name", res.Name)
+	return nil, nil
+}
@@ -0,0 +1,84 @@
+package volumecontroller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestVolumeValidator_ValidateUpdate(t *testing.T) {
+	tests := map[string]struct {
+		oldParams     cloudscalev1.VolumeParameters
+		oldStatus     cloudscalev1.VolumeObservation
+		newParams     cloudscalev1.VolumeParameters
+		expectedError string
+	}{
+		"GivenSameSize_ThenExpectNil": {
+			oldParams: cloudscalev1.VolumeParameters{SizeGB: 50},
+			newParams: cloudscalev1.VolumeParameters{SizeGB: 50},
+		},
+		"GivenLargerSize_ThenExpectNil": {
+			oldParams: cloudscalev1.VolumeParameters{SizeGB: 50},
+			oldStatus: cloudscalev1.VolumeObservation{VolumeUUID: "uuid", SizeGB: 50},
+			newParams: cloudscalev1.VolumeParameters{SizeGB: 100},
+		},
+		"GivenSmallerSize_ThenExpectError": {
+			oldParams:     cloudscalev1.VolumeParameters{SizeGB: 100},
+			newParams:     cloudscalev1.VolumeParameters{SizeGB: 50},
+			expectedError: "volume cannot be shrunk from 100 GB to 50 GB",
+		},
+		"GivenSizeGrownOutsideOfKubernetes_WhenSmallerThanObserved_ThenExpectError": {
+			oldParams:     cloudscalev1.VolumeParameters{SizeGB: 50},
+			oldStatus:     cloudscalev1.VolumeObservation{VolumeUUID: "uuid", SizeGB: 200},
+			newParams:     cloudscalev1.VolumeParameters{SizeGB: 100},
+			expectedError: "volume cannot be shrunk from 200 GB to 100 GB",
+		},
+		"GivenNotCreated_WhenTypeChanged_ThenExpectNil": {
+			oldParams: cloudscalev1.VolumeParameters{SizeGB: 100, Type: "ssd"},
+			newParams: cloudscalev1.VolumeParameters{SizeGB: 100, Type: "bulk"},
+		},
+		"GivenCreated_WhenTypeChanged_ThenExpectError": {
+			oldParams:     cloudscalev1.VolumeParameters{SizeGB: 100, Type: "ssd"},
+			oldStatus:     cloudscalev1.VolumeObservation{VolumeUUID: "uuid", SizeGB: 100},
+			newParams:     cloudscalev1.VolumeParameters{SizeGB: 100, Type: "bulk"},
+			expectedError: `volume "uuid" has been created already, you cannot change the type`,
+		},
+		"GivenCreated_WhenZoneChanged_ThenExpectError": {
+			oldParams:     cloudscalev1.VolumeParameters{SizeGB: 100, Zone: "rma1"},
+			oldStatus:     cloudscalev1.VolumeObservation{VolumeUUID: "uuid", SizeGB: 100},
+			newParams:     cloudscalev1.VolumeParameters{SizeGB: 100, Zone: "lpg1"},
+			expectedError: `volume "uuid" has been created already, you cannot change the zone`,
+		},
+		"GivenCreated_WhenSourceSnapshotChanged_ThenExpectError": {
+			oldParams:     cloudscalev1.VolumeParameters{SizeGB: 100, SourceSnapshotUUID: "snapshot"},
+			oldStatus:     cloudscalev1.VolumeObservation{VolumeUUID: "uuid", SizeGB: 100},
+			newParams:     cloudscalev1.VolumeParameters{SizeGB: 100, SourceSnapshotUUID: "other"},
+			expectedError: `volume "uuid" has been created already, you cannot change the source snapshot`,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			oldVolume := &cloudscalev1.Volume{
+				ObjectMeta: metav1.ObjectMeta{Name: "volume"},
+				Spec:       cloudscalev1.VolumeSpec{ForProvider: tc.oldParams},
+				Status:     cloudscalev1.VolumeStatus{AtProvider: tc.oldStatus},
+			}
+			newVolume := &cloudscalev1.Volume{
+				ObjectMeta: metav1.ObjectMeta{Name: "volume"},
+				Spec:       cloudscalev1.VolumeSpec{ForProvider: tc.newParams},
+			}
+			v := &VolumeValidator{log: logr.Discard()}
+			_, err := v.ValidateUpdate(context.TODO(), oldVolume, newVolume)
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package volumesnapshotcontroller
+
+import (
+	"context"
+	"fmt"
+
+	pipeline "github.com/ccremer/go-command-pipeline"
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	"github.com/vshn/provider-cloudscale/operator/pipelineutil"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Create implements managed.ExternalClient.
+func (p *SnapshotPipeline) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.Info("Creating resource")
+
+	snapshot := fromManaged(mg)
+	if snapshot.Status.AtProvider.SnapshotUUID != "" {
+		// Snapshot already exists
+		return managed.ExternalCreation{}, nil
+	}
+
+	pctx := &pipelineContext{Context: ctx, snapshot: snapshot}
+	pipe := pipeline.NewPipeline[*pipelineContext]()
+	stepMetrics := pipelineutil.NewStepMetrics[*pipelineContext](cloudscalev1.VolumeSnapshotKind)
+	pipe.WithBeforeHooks(pipelineutil.DebugLogger(pctx)).
+		WithSteps(stepMetrics.Steps(
+			pipe.NewStep("find snapshot by owner tag", p.findSnapshotByOwnerTag),
+			pipe.When(isSnapshotMissing, "create snapshot", p.createSnapshot),
+			pipe.NewStep("set snapshot UUID annotation", p.setSnapshotUUIDAnnotation),
+			pipe.NewStep("emit event", p.emitCreationEvent),
+		)...)
+	err := pipe.RunWithContext(pctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, "cannot create snapshot")
+	}
+
+	return managed.ExternalCreation{}, nil
+}
+
+// findSnapshotByOwnerTag searches for a snapshot that has been created by a previous reconciliation of this resource.
+// See servercontroller for why this is needed.
+// If exactly one snapshot is found, it is adopted.
+func (p *SnapshotPipeline) findSnapshotByOwnerTag(ctx *pipelineContext) error {
+	log := controllerruntime.LoggerFrom(ctx)
+	snapshot := ctx.snapshot
+
+	if snapshot.UID == "" {
+		return nil
+	}
+	csSnapshots, err := p.snapshots.List(ctx, cloudscalesdk.WithTagFilter(cloudscalesdk.TagMap{cloudscaleclient.OwnerTagKey: string(snapshot.UID)}))
+	if err != nil {
+		return errors.Wrap(err, "cannot list snapshots by owner tag")
+	}
+	switch len(csSnapshots) {
+	case 0:
+		return nil
+	case 1:
+		ctx.csSnapshot = &csSnapshots[0]
+		log.V(1).Info("Adopting existing snapshot in cloudscale", "uuid", ctx.csSnapshot.UUID, "name", ctx.csSnapshot.Name)
+		return nil
+	default:
+		return fmt.Errorf("found %d snapshots with tag %s=%s, expected at most one", len(csSnapshots), cloudscaleclient.OwnerTagKey, snapshot.UID)
+	}
+}
+
+// createSnapshot takes a new snapshot of the source volume.
+func (p *SnapshotPipeline) createSnapshot(ctx *pipelineContext) error {
+	log := controllerruntime.LoggerFrom(ctx)
+	snapshot := ctx.snapshot
+	params := snapshot.Spec.ForProvider
+
+	if params.SourceVolumeUUID == "" {
+		return fmt.Errorf("source volume is required, set either sourceVolumeUUID, sourceVolumeRef or sourceVolumeSelector")
+	}
+	csSnapshot, err := p.snapshots.Create(ctx, &cloudscaleclient.VolumeSnapshotRequest{
+		Name:         snapshot.GetSnapshotName(),
+		SourceVolume: params.SourceVolumeUUID,
+		TaggedResourceRequest: cloudscalesdk.TaggedResourceRequest{
+			Tags: cloudscaleclient.ToTagMap(cloudscaleclient.DesiredTags(params.Tags, snapshot.UID)),
+		},
+	})
+	if err != nil {
+		return err
+	}
+	log.V(1).Info("Created snapshot in cloudscale", "uuid", csSnapshot.UUID, "name", csSnapshot.Name, "volume", params.SourceVolumeUUID)
+	ctx.csSnapshot = csSnapshot
+	return nil
+}
+
+// setSnapshotUUIDAnnotation stores the UUID of the created or adopted snapshot in an annotation.
+func (p *SnapshotPipeline) setSnapshotUUIDAnnotation(ctx *pipelineContext) error {
+	cloudscaleclient.SetIDAnnotation(&ctx.snapshot.ObjectMeta, SnapshotUUIDAnnotationKey, ctx.csSnapshot.UUID)
+	return nil
+}
+
+func (p *SnapshotPipeline) emitCreationEvent(ctx *pipelineContext) error {
+	p.recorder.Event(ctx.snapshot, event.Event{
+		Type:    event.TypeNormal,
+		Reason:  "Created",
+		Message: "Snapshot successfully created",
+	})
+	return nil
+}
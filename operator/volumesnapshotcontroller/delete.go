@@ -0,0 +1,55 @@
+package volumesnapshotcontroller
+
+import (
+	"context"
+
+	pipeline "github.com/ccremer/go-command-pipeline"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	"github.com/vshn/provider-cloudscale/operator/pipelineutil"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Delete implements managed.ExternalClient.
+func (p *SnapshotPipeline) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.Info("Deleting resource")
+
+	snapshot := fromManaged(mg)
+	pctx := &pipelineContext{Context: ctx, snapshot: snapshot}
+	pipe := pipeline.NewPipeline[*pipelineContext]()
+	stepMetrics := pipelineutil.NewStepMetrics[*pipelineContext](cloudscalev1.VolumeSnapshotKind)
+	pipe.WithBeforeHooks(pipelineutil.DebugLogger(pctx)).
+		WithSteps(stepMetrics.Steps(
+			pipe.NewStep("delete snapshot", p.deleteSnapshot),
+			pipe.NewStep("emit event", p.emitDeletionEvent),
+		)...)
+	err := pipe.RunWithContext(pctx)
+	return managed.ExternalDelete{}, errors.Wrap(err, "cannot deprovision snapshot")
+}
+
+// deleteSnapshot deletes the snapshot.
+func (p *SnapshotPipeline) deleteSnapshot(ctx *pipelineContext) error {
+	log := controllerruntime.LoggerFrom(ctx)
+	uuid := ctx.snapshot.Status.AtProvider.SnapshotUUID
+
+	err := p.snapshots.Delete(ctx, uuid)
+	if err != nil {
+		return resource.Ignore(cloudscaleclient.IsNotFound, err)
+	}
+	log.V(1).Info("Deleted snapshot in cloudscale", "uuid", uuid)
+	return nil
+}
+
+func (p *SnapshotPipeline) emitDeletionEvent(ctx *pipelineContext) error {
+	p.recorder.Event(ctx.snapshot, event.Event{
+		Type:    event.TypeNormal,
+		Reason:  "Deleted",
+		Message: "Snapshot deleted",
+	})
+	return nil
+}
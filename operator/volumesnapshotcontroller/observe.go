@@ -0,0 +1,81 @@
+package volumesnapshotcontroller
+
+import (
+	"context"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Observe implements managed.ExternalClient.
+func (p *SnapshotPipeline) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.V(1).Info("Observing resource")
+
+	snapshot := fromManaged(mg)
+	if snapshot.Status.AtProvider.SnapshotUUID == "" {
+		if uuid, exists := cloudscaleclient.IDFromAnnotation(snapshot, SnapshotUUIDAnnotationKey); exists {
+			snapshot.Status.AtProvider.SnapshotUUID = uuid
+		} else {
+			// New resource, create snapshot first
+			return managed.ExternalObservation{}, nil
+		}
+	}
+
+	pctx := &pipelineContext{Context: ctx, snapshot: snapshot}
+	err := p.getSnapshot(pctx)
+	if err != nil {
+		return managed.ExternalObservation{}, resource.Ignore(cloudscaleclient.IsNotFound, err)
+	}
+
+	csSnapshot := pctx.csSnapshot
+	snapshot.Status.AtProvider = toObservation(csSnapshot, snapshot.Status.AtProvider.Project)
+	if csSnapshot.Status == SnapshotAvailable {
+		snapshot.SetConditions(xpv1.Available())
+	} else {
+		snapshot.SetConditions(xpv1.Unavailable().WithMessage("snapshot is " + csSnapshot.Status))
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: isUpToDate(snapshot, csSnapshot),
+	}, nil
+}
+
+// getSnapshot fetches an existing snapshot from the project associated with the API token.
+func (p *SnapshotPipeline) getSnapshot(ctx *pipelineContext) error {
+	log := controllerruntime.LoggerFrom(ctx)
+
+	csSnapshot, err := p.snapshots.Get(ctx, ctx.snapshot.Status.AtProvider.SnapshotUUID)
+	if err != nil {
+		return err
+	}
+	ctx.csSnapshot = csSnapshot
+	log.V(1).Info("Fetched snapshot in cloudscale", "uuid", csSnapshot.UUID, "name", csSnapshot.Name, "status", csSnapshot.Status)
+	return nil
+}
+
+// isUpToDate returns true if the name and tags of the snapshot match the spec.
+// The source volume can't be changed after creation and is ignored.
+func isUpToDate(snapshot *cloudscalev1.VolumeSnapshot, csSnapshot *cloudscaleclient.VolumeSnapshot) bool {
+	return snapshot.GetSnapshotName() == csSnapshot.Name &&
+		!cloudscaleclient.TagsNeedUpdate(cloudscaleclient.DesiredTags(snapshot.Spec.ForProvider.Tags, snapshot.UID), csSnapshot.Tags)
+}
+
+// toObservation returns the observed fields of the given snapshot.
+func toObservation(csSnapshot *cloudscaleclient.VolumeSnapshot, project string) cloudscalev1.VolumeSnapshotObservation {
+	return cloudscalev1.VolumeSnapshotObservation{
+		SnapshotUUID:     csSnapshot.UUID,
+		SnapshotName:     csSnapshot.Name,
+		State:            csSnapshot.Status,
+		SizeGB:           csSnapshot.SizeGB,
+		SourceVolumeUUID: csSnapshot.SourceVolume.UUID,
+		Zone:             csSnapshot.Zone.Slug,
+		Tags:             cloudscaleclient.FromTagMap(csSnapshot.Tags),
+		Project:          project,
+	}
+}
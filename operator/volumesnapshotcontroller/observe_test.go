@@ -0,0 +1,88 @@
+package volumesnapshotcontroller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type fakeVolumeSnapshotService struct {
+	cloudscaleclient.VolumeSnapshotService
+	snapshot *cloudscaleclient.VolumeSnapshot
+}
+
+// Get implements cloudscaleclient.VolumeSnapshotService.
+func (f *fakeVolumeSnapshotService) Get(_ context.Context, _ string) (*cloudscaleclient.VolumeSnapshot, error) {
+	return f.snapshot, nil
+}
+
+func TestSnapshotPipeline_Observe(t *testing.T) {
+	tests := map[string]struct {
+		givenAnnotations map[string]string
+		givenSnapshot    *cloudscaleclient.VolumeSnapshot
+
+		expectedResult      managed.ExternalObservation
+		expectedReadyStatus corev1.ConditionStatus
+		expectedObservation cloudscalev1.VolumeSnapshotObservation
+	}{
+		"GivenNewSnapshot_ThenExpectNotExisting": {
+			expectedResult:      managed.ExternalObservation{},
+			expectedReadyStatus: corev1.ConditionUnknown,
+		},
+		"GivenCreatingSnapshot_ThenExpectUnavailable": {
+			givenAnnotations: map[string]string{SnapshotUUIDAnnotationKey: "uuid"},
+			givenSnapshot: &cloudscaleclient.VolumeSnapshot{
+				UUID: "uuid", Name: "my-snapshot", Status: "creating", SizeGB: 50,
+				SourceVolume: cloudscaleclient.VolumeSnapshotSourceVolume{UUID: "volume"},
+			},
+			expectedResult:      managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			expectedReadyStatus: corev1.ConditionFalse,
+			expectedObservation: cloudscalev1.VolumeSnapshotObservation{
+				SnapshotUUID: "uuid", SnapshotName: "my-snapshot", State: "creating", SizeGB: 50, SourceVolumeUUID: "volume", Tags: cloudscalev1.Tags{},
+			},
+		},
+		"GivenAvailableSnapshot_WhenRenamed_ThenExpectAvailableAndNotUpToDate": {
+			givenAnnotations: map[string]string{SnapshotUUIDAnnotationKey: "uuid"},
+			givenSnapshot: &cloudscaleclient.VolumeSnapshot{
+				UUID: "uuid", Name: "old-name", Status: SnapshotAvailable, SizeGB: 50,
+				SourceVolume: cloudscaleclient.VolumeSnapshotSourceVolume{UUID: "volume"},
+			},
+			expectedResult:      managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			expectedReadyStatus: corev1.ConditionTrue,
+			expectedObservation: cloudscalev1.VolumeSnapshotObservation{
+				SnapshotUUID: "uuid", SnapshotName: "old-name", State: SnapshotAvailable, SizeGB: 50, SourceVolumeUUID: "volume", Tags: cloudscalev1.Tags{},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			p := NewPipeline(nil, &fakeVolumeSnapshotService{snapshot: tc.givenSnapshot})
+			snapshot := &cloudscalev1.VolumeSnapshot{ObjectMeta: metav1.ObjectMeta{Name: "my-snapshot", Annotations: tc.givenAnnotations}}
+
+			result, err := p.Observe(logr.NewContext(context.Background(), logr.Discard()), snapshot)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, result)
+			assert.Equal(t, tc.expectedReadyStatus, snapshot.GetCondition(xpv1.TypeReady).Status)
+			assert.Equal(t, tc.expectedObservation, snapshot.Status.AtProvider)
+		})
+	}
+}
+
+func TestPendingPollInterval(t *testing.T) {
+	snapshot := &cloudscalev1.VolumeSnapshot{}
+	snapshot.Status.AtProvider.State = "creating"
+	assert.Equal(t, PendingPollInterval, pendingPollInterval(snapshot, time.Hour))
+
+	snapshot.Status.AtProvider.State = SnapshotAvailable
+	assert.Equal(t, time.Hour, pendingPollInterval(snapshot, time.Hour))
+}
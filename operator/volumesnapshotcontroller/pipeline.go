@@ -0,0 +1,60 @@
+package volumesnapshotcontroller
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+)
+
+const (
+	// SnapshotUUIDAnnotationKey is the annotation key where the snapshot UUID is stored.
+	SnapshotUUIDAnnotationKey = "cloudscale.crossplane.io/snapshot-uuid"
+	// SnapshotAvailable is the state of a snapshot that can be restored.
+	SnapshotAvailable = "available"
+)
+
+// SnapshotPipeline provisions VolumeSnapshots on cloudscale.ch
+type SnapshotPipeline struct {
+	recorder  event.Recorder
+	snapshots cloudscaleclient.VolumeSnapshotService
+}
+
+type pipelineContext struct {
+	context.Context
+	snapshot   *cloudscalev1.VolumeSnapshot
+	csSnapshot *cloudscaleclient.VolumeSnapshot
+}
+
+// ParentContext implements tracing.Context.
+func (c *pipelineContext) ParentContext() context.Context {
+	return c.Context
+}
+
+// SetParentContext implements tracing.Context.
+func (c *pipelineContext) SetParentContext(ctx context.Context) {
+	c.Context = ctx
+}
+
+// NewPipeline returns a new instance of SnapshotPipeline.
+func NewPipeline(recorder event.Recorder, snapshots cloudscaleclient.VolumeSnapshotService) *SnapshotPipeline {
+	return &SnapshotPipeline{
+		recorder:  recorder,
+		snapshots: snapshots,
+	}
+}
+
+// Disconnect implements managed.ExternalClient.
+func (p *SnapshotPipeline) Disconnect(_ context.Context) error {
+	return nil
+}
+
+func isSnapshotMissing(ctx *pipelineContext) bool {
+	return ctx.csSnapshot == nil
+}
+
+func fromManaged(mg resource.Managed) *cloudscalev1.VolumeSnapshot {
+	return mg.(*cloudscalev1.VolumeSnapshot)
+}
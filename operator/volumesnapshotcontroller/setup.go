@@ -0,0 +1,59 @@
+package volumesnapshotcontroller
+
+import (
+	"strings"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	"github.com/vshn/provider-cloudscale/operator/controlleropts"
+	"github.com/vshn/provider-cloudscale/operator/ratelimit"
+	"github.com/vshn/provider-cloudscale/operator/tracing"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+)
+
+// DefaultPollInterval is the default interval in which snapshots are observed again.
+// Snapshots don't change once they're available.
+const DefaultPollInterval = 1 * time.Hour
+
+// PendingPollInterval is the interval in which snapshots are observed again until they're available.
+const PendingPollInterval = 30 * time.Second
+
+// SetupController adds a controller that reconciles cloudscalev1.VolumeSnapshot managed resources.
+func SetupController(mgr ctrl.Manager, opts controlleropts.Options) error {
+	name := strings.ToLower(cloudscalev1.VolumeSnapshotGroupKind)
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+	throttler := ratelimit.NewThrottler()
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(cloudscalev1.VolumeSnapshotGroupVersionKind),
+		managed.WithExternalConnecter(throttler.WrapConnecter(&snapshotConnector{
+			recorder:  recorder,
+			connector: cloudscaleclient.NewConnector(mgr.GetClient(), cloudscalev1.VolumeSnapshotKind),
+		})),
+		managed.WithLogger(logging.NewLogrLogger(mgr.GetLogger().WithValues("controller", name))),
+		managed.WithRecorder(recorder),
+		managed.WithPollIntervalHook(opts.PollIntervalHook(pendingPollInterval)),
+		managed.WithPollInterval(opts.PollInterval),
+		managed.WithConnectionPublishers(opts.ConnectionPublishers(mgr)...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&cloudscalev1.VolumeSnapshot{}, builder.WithPredicates(opts.Predicates...)).
+		WithOptions(opts.ForControllerRuntime()).
+		Complete(tracing.NewReconciler(cloudscalev1.VolumeSnapshotKind, throttler.WrapReconciler(r)))
+}
+
+// pendingPollInterval returns a shorter interval for snapshots that aren't available yet, so that they become ready soon after they've been taken.
+func pendingPollInterval(mg resource.Managed, pollInterval time.Duration) time.Duration {
+	if fromManaged(mg).Status.AtProvider.State != SnapshotAvailable {
+		return min(PendingPollInterval, pollInterval)
+	}
+	return pollInterval
+}
@@ -0,0 +1,53 @@
+package volumesnapshotcontroller
+
+import (
+	"context"
+
+	pipeline "github.com/ccremer/go-command-pipeline"
+	cloudscalesdk "github.com/cloudscale-ch/cloudscale-go-sdk/v2"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	cloudscalev1 "github.com/vshn/provider-cloudscale/apis/cloudscale/v1"
+	"github.com/vshn/provider-cloudscale/operator/cloudscaleclient"
+	"github.com/vshn/provider-cloudscale/operator/pipelineutil"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Update implements managed.ExternalClient.
+func (p *SnapshotPipeline) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.Info("Updating resource")
+	snapshot := fromManaged(mg)
+
+	pctx := &pipelineContext{Context: ctx, snapshot: snapshot}
+	pipe := pipeline.NewPipeline[*pipelineContext]()
+	stepMetrics := pipelineutil.NewStepMetrics[*pipelineContext](cloudscalev1.VolumeSnapshotKind)
+	pipe.WithBeforeHooks(pipelineutil.DebugLogger(pctx)).
+		WithSteps(stepMetrics.Steps(
+			pipe.NewStep("update snapshot", p.updateSnapshot),
+		)...)
+	err := pipe.RunWithContext(pctx)
+
+	return managed.ExternalUpdate{}, errors.Wrap(err, "cannot update snapshot")
+}
+
+// updateSnapshot updates the name and tags of the snapshot identified by UUID.
+func (p *SnapshotPipeline) updateSnapshot(ctx *pipelineContext) error {
+	log := controllerruntime.LoggerFrom(ctx)
+	snapshot := ctx.snapshot
+	uuid := snapshot.Status.AtProvider.SnapshotUUID
+	tags := cloudscaleclient.DesiredTags(snapshot.Spec.ForProvider.Tags, snapshot.UID)
+
+	err := p.snapshots.Update(ctx, uuid, &cloudscaleclient.VolumeSnapshotRequest{
+		Name: snapshot.GetSnapshotName(),
+		TaggedResourceRequest: cloudscalesdk.TaggedResourceRequest{
+			Tags: cloudscaleclient.ToTagMap(tags),
+		},
+	})
+	if err != nil {
+		return err
+	}
+	log.V(1).Info("Updated snapshot in cloudscale", "uuid", uuid, "name", snapshot.GetSnapshotName(), "tags", tags)
+	return nil
+}
@@ -3,15 +3,47 @@ package main
 import (
 	"context"
 	"crypto/tls"
+	"fmt"
+	"path/filepath"
 	"time"
 
 	pipeline "github.com/ccremer/go-command-pipeline"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/certificates"
 	"github.com/go-logr/logr"
 	"github.com/vshn/provider-cloudscale/apis"
+	providerv1 "github.com/vshn/provider-cloudscale/apis/provider/v1"
 	"github.com/vshn/provider-cloudscale/operator"
+	"github.com/vshn/provider-cloudscale/operator/bucketcontroller"
+	"github.com/vshn/provider-cloudscale/operator/catalogcontroller"
+	"github.com/vshn/provider-cloudscale/operator/configcontroller"
+	"github.com/vshn/provider-cloudscale/operator/controlleropts"
+	"github.com/vshn/provider-cloudscale/operator/customimagecontroller"
+	"github.com/vshn/provider-cloudscale/operator/floatingipcontroller"
+	"github.com/vshn/provider-cloudscale/operator/healthmonitorcontroller"
+	"github.com/vshn/provider-cloudscale/operator/listenercontroller"
+	"github.com/vshn/provider-cloudscale/operator/loadbalancercontroller"
+	"github.com/vshn/provider-cloudscale/operator/networkcontroller"
+	"github.com/vshn/provider-cloudscale/operator/objectsusercontroller"
+	"github.com/vshn/provider-cloudscale/operator/poolcontroller"
+	"github.com/vshn/provider-cloudscale/operator/poolmembercontroller"
+	"github.com/vshn/provider-cloudscale/operator/probes"
+	"github.com/vshn/provider-cloudscale/operator/ratelimit"
+	"github.com/vshn/provider-cloudscale/operator/servercontroller"
+	"github.com/vshn/provider-cloudscale/operator/servergroupcontroller"
+	"github.com/vshn/provider-cloudscale/operator/sharding"
+	"github.com/vshn/provider-cloudscale/operator/subnetcontroller"
+	"github.com/vshn/provider-cloudscale/operator/tracing"
+	"github.com/vshn/provider-cloudscale/operator/volumecontroller"
+	"github.com/vshn/provider-cloudscale/operator/volumesnapshotcontroller"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/leaderelection/resourcelock"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	"github.com/urfave/cli/v2"
@@ -21,6 +53,23 @@ import (
 type operatorCommand struct {
 	LeaderElectionEnabled bool
 	WebhookCertDir        string
+	HealthProbeAddress    string
+	ReadinessAPIURL       string
+	MetricsAddress        string
+	TracingEndpoint       string
+	TracingSamplingRatio  float64
+	APIQPS                float64
+	APIBurst              int
+	APIMaxRetries         int
+	KubeClientQPS         float64
+	KubeClientBurst       int
+	SyncPeriod            time.Duration
+	ShardSelector         string
+	ShardProviderConfigs  []string
+	EnableESS             bool
+	ESSTLSCertDir         string
+	Namespace             string
+	Controllers           operator.Options
 
 	manager    manager.Manager
 	kubeconfig *rest.Config
@@ -35,22 +84,130 @@ func newOperatorCommand() *cli.Command {
 		Flags: []cli.Flag{
 			newLeaderElectionEnabledFlag(&command.LeaderElectionEnabled),
 			newWebhookTLSCertDirFlag(&command.WebhookCertDir),
+			newHealthProbeBindAddressFlag(&command.HealthProbeAddress),
+			newReadinessAPIURLFlag(&command.ReadinessAPIURL),
+			newMetricsBindAddressFlag(&command.MetricsAddress),
+			newTracingEndpointFlag(&command.TracingEndpoint),
+			newTracingSamplingRatioFlag(&command.TracingSamplingRatio),
+			newAPIQPSFlag(&command.APIQPS),
+			newAPIBurstFlag(&command.APIBurst),
+			newAPIMaxRetriesFlag(&command.APIMaxRetries),
+			newKubeClientQPSFlag(&command.KubeClientQPS),
+			newKubeClientBurstFlag(&command.KubeClientBurst),
+			newSyncPeriodFlag(&command.SyncPeriod),
+			newShardSelectorFlag(&command.ShardSelector),
+			newShardProviderConfigsFlag(),
+			newEnableExternalSecretStoresFlag(&command.EnableESS),
+			newESSTLSCertDirFlag(&command.ESSTLSCertDir),
+			newNamespaceFlag(&command.Namespace),
+			newPollIntervalFlag("objectsuser", &command.Controllers.ObjectsUser.PollInterval, objectsusercontroller.DefaultPollInterval),
+			newPollJitterFlag("objectsuser", &command.Controllers.ObjectsUser.PollJitter),
+			newMaxReconcilesFlag("objectsuser", &command.Controllers.ObjectsUser.MaxConcurrentReconciles),
+			newPollIntervalFlag("bucket", &command.Controllers.Bucket.PollInterval, bucketcontroller.DefaultPollInterval),
+			newPollJitterFlag("bucket", &command.Controllers.Bucket.PollJitter),
+			newMaxReconcilesFlag("bucket", &command.Controllers.Bucket.MaxConcurrentReconciles),
+			newPollIntervalFlag("server", &command.Controllers.Server.PollInterval, servercontroller.DefaultPollInterval),
+			newPollJitterFlag("server", &command.Controllers.Server.PollJitter),
+			newMaxReconcilesFlag("server", &command.Controllers.Server.MaxConcurrentReconciles),
+			newPollIntervalFlag("volume", &command.Controllers.Volume.PollInterval, volumecontroller.DefaultPollInterval),
+			newPollJitterFlag("volume", &command.Controllers.Volume.PollJitter),
+			newMaxReconcilesFlag("volume", &command.Controllers.Volume.MaxConcurrentReconciles),
+			newPollIntervalFlag("volumesnapshot", &command.Controllers.VolumeSnapshot.PollInterval, volumesnapshotcontroller.DefaultPollInterval),
+			newPollJitterFlag("volumesnapshot", &command.Controllers.VolumeSnapshot.PollJitter),
+			newMaxReconcilesFlag("volumesnapshot", &command.Controllers.VolumeSnapshot.MaxConcurrentReconciles),
+			newPollIntervalFlag("network", &command.Controllers.Network.PollInterval, networkcontroller.DefaultPollInterval),
+			newPollJitterFlag("network", &command.Controllers.Network.PollJitter),
+			newMaxReconcilesFlag("network", &command.Controllers.Network.MaxConcurrentReconciles),
+			newPollIntervalFlag("subnet", &command.Controllers.Subnet.PollInterval, subnetcontroller.DefaultPollInterval),
+			newPollJitterFlag("subnet", &command.Controllers.Subnet.PollJitter),
+			newMaxReconcilesFlag("subnet", &command.Controllers.Subnet.MaxConcurrentReconciles),
+			newPollIntervalFlag("floatingip", &command.Controllers.FloatingIP.PollInterval, floatingipcontroller.DefaultPollInterval),
+			newPollJitterFlag("floatingip", &command.Controllers.FloatingIP.PollJitter),
+			newMaxReconcilesFlag("floatingip", &command.Controllers.FloatingIP.MaxConcurrentReconciles),
+			newPollIntervalFlag("loadbalancer", &command.Controllers.LoadBalancer.PollInterval, loadbalancercontroller.DefaultPollInterval),
+			newPollJitterFlag("loadbalancer", &command.Controllers.LoadBalancer.PollJitter),
+			newMaxReconcilesFlag("loadbalancer", &command.Controllers.LoadBalancer.MaxConcurrentReconciles),
+			newPollIntervalFlag("pool", &command.Controllers.Pool.PollInterval, poolcontroller.DefaultPollInterval),
+			newPollJitterFlag("pool", &command.Controllers.Pool.PollJitter),
+			newMaxReconcilesFlag("pool", &command.Controllers.Pool.MaxConcurrentReconciles),
+			newPollIntervalFlag("poolmember", &command.Controllers.PoolMember.PollInterval, poolmembercontroller.DefaultPollInterval),
+			newPollJitterFlag("poolmember", &command.Controllers.PoolMember.PollJitter),
+			newMaxReconcilesFlag("poolmember", &command.Controllers.PoolMember.MaxConcurrentReconciles),
+			newPollIntervalFlag("listener", &command.Controllers.Listener.PollInterval, listenercontroller.DefaultPollInterval),
+			newPollJitterFlag("listener", &command.Controllers.Listener.PollJitter),
+			newMaxReconcilesFlag("listener", &command.Controllers.Listener.MaxConcurrentReconciles),
+			newPollIntervalFlag("healthmonitor", &command.Controllers.HealthMonitor.PollInterval, healthmonitorcontroller.DefaultPollInterval),
+			newPollJitterFlag("healthmonitor", &command.Controllers.HealthMonitor.PollJitter),
+			newMaxReconcilesFlag("healthmonitor", &command.Controllers.HealthMonitor.MaxConcurrentReconciles),
+			newPollIntervalFlag("servergroup", &command.Controllers.ServerGroup.PollInterval, servergroupcontroller.DefaultPollInterval),
+			newPollJitterFlag("servergroup", &command.Controllers.ServerGroup.PollJitter),
+			newMaxReconcilesFlag("servergroup", &command.Controllers.ServerGroup.MaxConcurrentReconciles),
+			newPollIntervalFlag("customimage", &command.Controllers.CustomImage.PollInterval, customimagecontroller.DefaultPollInterval),
+			newPollJitterFlag("customimage", &command.Controllers.CustomImage.PollJitter),
+			newMaxReconcilesFlag("customimage", &command.Controllers.CustomImage.MaxConcurrentReconciles),
+			newPollIntervalFlag("catalog", &command.Controllers.Catalog.PollInterval, catalogcontroller.DefaultPollInterval),
+			newPollJitterFlag("catalog", &command.Controllers.Catalog.PollJitter),
+			newMaxReconcilesFlag("catalog", &command.Controllers.Catalog.MaxConcurrentReconciles),
+			newPollIntervalFlag("providerconfig", &command.Controllers.ProviderConfig.PollInterval, configcontroller.DefaultPollInterval),
+			newPollJitterFlag("providerconfig", &command.Controllers.ProviderConfig.PollJitter),
+			newMaxReconcilesFlag("providerconfig", &command.Controllers.ProviderConfig.MaxConcurrentReconciles),
 		},
 	}
 }
 
 func (c *operatorCommand) execute(ctx *cli.Context) error {
 	_ = LogMetadata(ctx)
+	c.ShardProviderConfigs = ctx.StringSlice(newShardProviderConfigsFlag().Name)
 	log := logr.FromContextOrDiscard(ctx.Context).WithName(ctx.Command.Name)
 	log.Info("Setting up controllers", "config", c)
 	ctrl.SetLogger(log)
 
+	var shutdownTracing func(context.Context) error
 	p := pipeline.NewPipeline[context.Context]()
 	p.WithBeforeHooks(
 		func(step pipeline.Step[context.Context]) {
 			log.V(1).Info(step.Name)
 		},
 	)
+	p.AddStepFromFunc("setup tracing", func(ctx context.Context) error {
+		shutdown, err := tracing.Setup(ctx, tracing.Options{
+			Endpoint:       c.TracingEndpoint,
+			SamplingRatio:  c.TracingSamplingRatio,
+			ServiceName:    appName,
+			ServiceVersion: version,
+		})
+		shutdownTracing = shutdown
+		return err
+	})
+	p.AddStepFromFunc("configure rate limiting", func(ctx context.Context) error {
+		ratelimit.Configure(ratelimit.Options{
+			QPS:        c.APIQPS,
+			Burst:      c.APIBurst,
+			MaxRetries: c.APIMaxRetries,
+		})
+		return nil
+	})
+	p.AddStepFromFunc("configure sharding", func(ctx context.Context) error {
+		shard, err := sharding.NewOptions(c.ShardSelector, c.ShardProviderConfigs)
+		c.Controllers.Shard = shard
+		return err
+	})
+	p.AddStep(p.When(pipeline.Bool[context.Context](c.EnableESS), "configure external secret stores", func(ctx context.Context) error {
+		ess := &controlleropts.ESSOptions{}
+		if c.ESSTLSCertDir != "" {
+			tlsConfig, err := certificates.LoadMTLSConfig(
+				filepath.Join(c.ESSTLSCertDir, "ca.crt"),
+				filepath.Join(c.ESSTLSCertDir, "tls.crt"),
+				filepath.Join(c.ESSTLSCertDir, "tls.key"),
+				false)
+			if err != nil {
+				return fmt.Errorf("cannot load TLS certificates for external secret stores: %w", err)
+			}
+			ess.TLSConfig = tlsConfig
+		}
+		c.Controllers.ESS = ess
+		return nil
+	}))
 	p.AddStepFromFunc("get config", func(ctx context.Context) error {
 		cfg, err := ctrl.GetConfig()
 		c.kubeconfig = cfg
@@ -58,8 +215,8 @@ func (c *operatorCommand) execute(ctx *cli.Context) error {
 	})
 	p.AddStepFromFunc("create manager", func(ctx context.Context) error {
 		// configure client-side throttling
-		c.kubeconfig.QPS = 100
-		c.kubeconfig.Burst = 150 // more Openshift friendly
+		c.kubeconfig.QPS = float32(c.KubeClientQPS)
+		c.kubeconfig.Burst = c.KubeClientBurst
 
 		webhookOpts := webhook.Options{
 			Port: 9443,
@@ -78,11 +235,17 @@ func (c *operatorCommand) execute(ctx *cli.Context) error {
 			//  hundreds of reconciles per second and ~200rps to the API server.
 			// Switching to Leases only and longer leases appears to alleviate this.
 			LeaderElection:             c.LeaderElectionEnabled,
-			LeaderElectionID:           "leader-election-provider-cloudscale",
+			LeaderElectionID:           c.Controllers.Shard.LeaderElectionID("leader-election-provider-cloudscale"),
 			LeaderElectionResourceLock: resourcelock.LeasesResourceLock,
 			LeaseDuration:              func() *time.Duration { d := 60 * time.Second; return &d }(),
 			RenewDeadline:              func() *time.Duration { d := 50 * time.Second; return &d }(),
 			WebhookServer:              webhook.NewServer(webhookOpts),
+			HealthProbeBindAddress:     c.HealthProbeAddress,
+			Metrics:                    metricsserver.Options{BindAddress: c.MetricsAddress},
+			Cache: cache.Options{
+				SyncPeriod: &c.SyncPeriod,
+				ByObject:   c.Controllers.Shard.CacheByObject(),
+			},
 		})
 		c.manager = mgr
 		return err
@@ -92,8 +255,18 @@ func (c *operatorCommand) execute(ctx *cli.Context) error {
 			return apis.AddToScheme(c.manager.GetScheme())
 		}),
 	))
+	p.AddStep(p.When(pipeline.Bool[context.Context](c.EnableESS), "ensure default store config", func(ctx context.Context) error {
+		// Only required fields are set, the others are defaulted by the CRD.
+		storeConfig := &providerv1.StoreConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			Spec: providerv1.StoreConfigSpec{
+				SecretStoreConfig: xpv1.SecretStoreConfig{DefaultScope: c.Namespace},
+			},
+		}
+		return client.IgnoreAlreadyExists(c.manager.GetClient().Create(ctx, storeConfig))
+	}))
 	p.AddStepFromFunc("setup controllers", func(ctx context.Context) error {
-		return operator.SetupControllers(c.manager)
+		return operator.SetupControllers(c.manager, c.Controllers)
 	})
 	p.AddStepFromFunc("setup webhooks", func(ctx context.Context) error {
 		if c.WebhookCertDir != "" {
@@ -101,9 +274,37 @@ func (c *operatorCommand) execute(ctx *cli.Context) error {
 		}
 		return nil
 	})
+	p.AddStepFromFunc("setup probes", func(ctx context.Context) error {
+		if err := c.manager.AddHealthzCheck("ping", healthz.Ping); err != nil {
+			return err
+		}
+		if c.WebhookCertDir != "" {
+			// The webhook server is only started once its certificate has been loaded.
+			if err := c.manager.AddReadyzCheck("webhook", c.manager.GetWebhookServer().StartedChecker()); err != nil {
+				return err
+			}
+		}
+		if c.ReadinessAPIURL == "" {
+			// ProviderConfigs may point to other API endpoints, e.g. local stand-ins, whose reachability isn't checked.
+			return nil
+		}
+		return c.manager.AddReadyzCheck("cloudscale-api", probes.NewAPIReachabilityChecker(c.ReadinessAPIURL).Check)
+	})
 	p.AddStepFromFunc("run manager", func(ctx context.Context) error {
 		log.Info("Starting manager")
 		return c.manager.Start(ctx)
 	})
+	p.WithFinalizer(func(_ context.Context, err error) error {
+		if shutdownTracing == nil {
+			return err
+		}
+		// The context is already canceled when the manager stops, but the remaining spans should still be flushed.
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if shutdownErr := shutdownTracing(shutdownCtx); shutdownErr != nil {
+			log.Error(shutdownErr, "Cannot shut down tracing")
+		}
+		return err
+	})
 	return p.RunWithContext(ctx.Context)
 }